@@ -21,6 +21,10 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_EpochInfos_FullMethodName   = "/canto.epochs.v1.Query/EpochInfos"
 	Query_CurrentEpoch_FullMethodName = "/canto.epochs.v1.Query/CurrentEpoch"
+	Query_HookFailures_FullMethodName = "/canto.epochs.v1.Query/HookFailures"
+	Query_EpochHistory_FullMethodName = "/canto.epochs.v1.Query/EpochHistory"
+	Query_NextEpochEnd_FullMethodName = "/canto.epochs.v1.Query/NextEpochEnd"
+	Query_Params_FullMethodName       = "/canto.epochs.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +35,17 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions of each
+	// hook receiver
+	HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error)
+	// EpochHistory provide the boundaries of the recently ended epochs of
+	// specified identifier
+	EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error)
+	// NextEpochEnd provide the expected end of the current epoch of specified
+	// identifier
+	NextEpochEnd(ctx context.Context, in *QueryNextEpochEndRequest, opts ...grpc.CallOption) (*QueryNextEpochEndResponse, error)
+	// Params retrieves the epochs module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +74,42 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error) {
+	out := new(QueryHookFailuresResponse)
+	err := c.cc.Invoke(ctx, Query_HookFailures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error) {
+	out := new(QueryEpochHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_EpochHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextEpochEnd(ctx context.Context, in *QueryNextEpochEndRequest, opts ...grpc.CallOption) (*QueryNextEpochEndResponse, error) {
+	out := new(QueryNextEpochEndResponse)
+	err := c.cc.Invoke(ctx, Query_NextEpochEnd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +118,17 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions of each
+	// hook receiver
+	HookFailures(context.Context, *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error)
+	// EpochHistory provide the boundaries of the recently ended epochs of
+	// specified identifier
+	EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error)
+	// NextEpochEnd provide the expected end of the current epoch of specified
+	// identifier
+	NextEpochEnd(context.Context, *QueryNextEpochEndRequest) (*QueryNextEpochEndResponse, error)
+	// Params retrieves the epochs module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +142,18 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochsInfoRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) HookFailures(context.Context, *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookFailures not implemented")
+}
+func (UnimplementedQueryServer) EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochHistory not implemented")
+}
+func (UnimplementedQueryServer) NextEpochEnd(context.Context, *QueryNextEpochEndRequest) (*QueryNextEpochEndResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochEnd not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +203,78 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HookFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HookFailures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookFailures(ctx, req.(*QueryHookFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochHistory(ctx, req.(*QueryEpochHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextEpochEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochEndRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextEpochEnd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochEnd(ctx, req.(*QueryNextEpochEndRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +290,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "HookFailures",
+			Handler:    _Query_HookFailures_Handler,
+		},
+		{
+			MethodName: "EpochHistory",
+			Handler:    _Query_EpochHistory_Handler,
+		},
+		{
+			MethodName: "NextEpochEnd",
+			Handler:    _Query_NextEpochEnd_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/epochs/v1/query.proto",
@@ -3,12 +3,14 @@ package erc20v1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -167,12 +169,220 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*RateLimit
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(RateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*PendingRegistration
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRegistration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRegistration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(PendingRegistration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(PendingRegistration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*AutoDisabledTokenPair
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoDisabledTokenPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoDisabledTokenPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(AutoDisabledTokenPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(AutoDisabledTokenPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*PendingRegistration
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRegistration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRegistration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(PendingRegistration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(PendingRegistration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_params                protoreflect.FieldDescriptor
-	fd_GenesisState_token_pairs           protoreflect.FieldDescriptor
-	fd_GenesisState_denom_indexes         protoreflect.FieldDescriptor
-	fd_GenesisState_erc20_address_indexes protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_token_pairs               protoreflect.FieldDescriptor
+	fd_GenesisState_denom_indexes             protoreflect.FieldDescriptor
+	fd_GenesisState_erc20_address_indexes     protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limits               protoreflect.FieldDescriptor
+	fd_GenesisState_pending_registrations     protoreflect.FieldDescriptor
+	fd_GenesisState_auto_disabled_token_pairs protoreflect.FieldDescriptor
+	fd_GenesisState_unrefunded_deposits       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -182,6 +392,10 @@ func init() {
 	fd_GenesisState_token_pairs = md_GenesisState.Fields().ByName("token_pairs")
 	fd_GenesisState_denom_indexes = md_GenesisState.Fields().ByName("denom_indexes")
 	fd_GenesisState_erc20_address_indexes = md_GenesisState.Fields().ByName("erc20_address_indexes")
+	fd_GenesisState_rate_limits = md_GenesisState.Fields().ByName("rate_limits")
+	fd_GenesisState_pending_registrations = md_GenesisState.Fields().ByName("pending_registrations")
+	fd_GenesisState_auto_disabled_token_pairs = md_GenesisState.Fields().ByName("auto_disabled_token_pairs")
+	fd_GenesisState_unrefunded_deposits = md_GenesisState.Fields().ByName("unrefunded_deposits")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -273,6 +487,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.RateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.RateLimits})
+		if !f(fd_GenesisState_rate_limits, value) {
+			return
+		}
+	}
+	if len(x.PendingRegistrations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.PendingRegistrations})
+		if !f(fd_GenesisState_pending_registrations, value) {
+			return
+		}
+	}
+	if len(x.AutoDisabledTokenPairs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.AutoDisabledTokenPairs})
+		if !f(fd_GenesisState_auto_disabled_token_pairs, value) {
+			return
+		}
+	}
+	if len(x.UnrefundedDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.UnrefundedDeposits})
+		if !f(fd_GenesisState_unrefunded_deposits, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -296,6 +534,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.DenomIndexes) != 0
 	case "canto.erc20.v1.GenesisState.erc20_address_indexes":
 		return len(x.Erc20AddressIndexes) != 0
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		return len(x.RateLimits) != 0
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		return len(x.PendingRegistrations) != 0
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		return len(x.AutoDisabledTokenPairs) != 0
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		return len(x.UnrefundedDeposits) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -320,6 +566,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DenomIndexes = nil
 	case "canto.erc20.v1.GenesisState.erc20_address_indexes":
 		x.Erc20AddressIndexes = nil
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		x.RateLimits = nil
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		x.PendingRegistrations = nil
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		x.AutoDisabledTokenPairs = nil
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		x.UnrefundedDeposits = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -357,6 +611,30 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_4_list{list: &x.Erc20AddressIndexes}
 		return protoreflect.ValueOfList(listValue)
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		if len(x.RateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.RateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		if len(x.PendingRegistrations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.PendingRegistrations}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		if len(x.AutoDisabledTokenPairs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.AutoDisabledTokenPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		if len(x.UnrefundedDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.UnrefundedDeposits}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -391,6 +669,22 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_4_list)
 		x.Erc20AddressIndexes = *clv.list
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.RateLimits = *clv.list
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.PendingRegistrations = *clv.list
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.AutoDisabledTokenPairs = *clv.list
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.UnrefundedDeposits = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -434,6 +728,30 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.Erc20AddressIndexes}
 		return protoreflect.ValueOfList(value)
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		if x.RateLimits == nil {
+			x.RateLimits = []*RateLimit{}
+		}
+		value := &_GenesisState_5_list{list: &x.RateLimits}
+		return protoreflect.ValueOfList(value)
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		if x.PendingRegistrations == nil {
+			x.PendingRegistrations = []*PendingRegistration{}
+		}
+		value := &_GenesisState_6_list{list: &x.PendingRegistrations}
+		return protoreflect.ValueOfList(value)
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		if x.AutoDisabledTokenPairs == nil {
+			x.AutoDisabledTokenPairs = []*AutoDisabledTokenPair{}
+		}
+		value := &_GenesisState_7_list{list: &x.AutoDisabledTokenPairs}
+		return protoreflect.ValueOfList(value)
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		if x.UnrefundedDeposits == nil {
+			x.UnrefundedDeposits = []*PendingRegistration{}
+		}
+		value := &_GenesisState_8_list{list: &x.UnrefundedDeposits}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -459,6 +777,18 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "canto.erc20.v1.GenesisState.erc20_address_indexes":
 		list := []*TokenPairERC20AddressIndex{}
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "canto.erc20.v1.GenesisState.rate_limits":
+		list := []*RateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "canto.erc20.v1.GenesisState.pending_registrations":
+		list := []*PendingRegistration{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "canto.erc20.v1.GenesisState.auto_disabled_token_pairs":
+		list := []*AutoDisabledTokenPair{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "canto.erc20.v1.GenesisState.unrefunded_deposits":
+		list := []*PendingRegistration{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.GenesisState"))
@@ -550,6 +880,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.RateLimits) > 0 {
+			for _, e := range x.RateLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingRegistrations) > 0 {
+			for _, e := range x.PendingRegistrations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AutoDisabledTokenPairs) > 0 {
+			for _, e := range x.AutoDisabledTokenPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnrefundedDeposits) > 0 {
+			for _, e := range x.UnrefundedDeposits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -579,6 +933,70 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.UnrefundedDeposits) > 0 {
+			for iNdEx := len(x.UnrefundedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnrefundedDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.AutoDisabledTokenPairs) > 0 {
+			for iNdEx := len(x.AutoDisabledTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoDisabledTokenPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.PendingRegistrations) > 0 {
+			for iNdEx := len(x.PendingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingRegistrations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.RateLimits) > 0 {
+			for iNdEx := len(x.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if len(x.Erc20AddressIndexes) > 0 {
 			for iNdEx := len(x.Erc20AddressIndexes) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Erc20AddressIndexes[iNdEx])
@@ -671,28 +1089,166 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenPairs = append(x.TokenPairs, &TokenPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenPairs[len(x.TokenPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomIndexes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomIndexes = append(x.DenomIndexes, &TokenPairDenomIndex{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomIndexes[len(x.DenomIndexes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20AddressIndexes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Erc20AddressIndexes = append(x.Erc20AddressIndexes, &TokenPairERC20AddressIndex{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20AddressIndexes[len(x.Erc20AddressIndexes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -719,16 +1275,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.RateLimits = append(x.RateLimits, &RateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimits[len(x.RateLimits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingRegistrations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -755,14 +1309,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TokenPairs = append(x.TokenPairs, &TokenPair{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenPairs[len(x.TokenPairs)-1]); err != nil {
+				x.PendingRegistrations = append(x.PendingRegistrations, &PendingRegistration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingRegistrations[len(x.PendingRegistrations)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomIndexes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoDisabledTokenPairs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -789,14 +1343,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DenomIndexes = append(x.DenomIndexes, &TokenPairDenomIndex{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomIndexes[len(x.DenomIndexes)-1]); err != nil {
+				x.AutoDisabledTokenPairs = append(x.AutoDisabledTokenPairs, &AutoDisabledTokenPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoDisabledTokenPairs[len(x.AutoDisabledTokenPairs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20AddressIndexes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnrefundedDeposits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -823,8 +1377,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Erc20AddressIndexes = append(x.Erc20AddressIndexes, &TokenPairERC20AddressIndex{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20AddressIndexes[len(x.Erc20AddressIndexes)-1]); err != nil {
+				x.UnrefundedDeposits = append(x.UnrefundedDeposits, &PendingRegistration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnrefundedDeposits[len(x.UnrefundedDeposits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -863,10 +1417,64 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                 protoreflect.MessageDescriptor
-	fd_Params_enable_erc20    protoreflect.FieldDescriptor
-	fd_Params_enable_evm_hook protoreflect.FieldDescriptor
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_enable_erc20                       protoreflect.FieldDescriptor
+	fd_Params_enable_evm_hook                    protoreflect.FieldDescriptor
+	fd_Params_enable_permissionless_registration protoreflect.FieldDescriptor
+	fd_Params_registration_deposit               protoreflect.FieldDescriptor
+	fd_Params_registration_challenge_period      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -874,6 +1482,9 @@ func init() {
 	md_Params = File_canto_erc20_v1_genesis_proto.Messages().ByName("Params")
 	fd_Params_enable_erc20 = md_Params.Fields().ByName("enable_erc20")
 	fd_Params_enable_evm_hook = md_Params.Fields().ByName("enable_evm_hook")
+	fd_Params_enable_permissionless_registration = md_Params.Fields().ByName("enable_permissionless_registration")
+	fd_Params_registration_deposit = md_Params.Fields().ByName("registration_deposit")
+	fd_Params_registration_challenge_period = md_Params.Fields().ByName("registration_challenge_period")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -953,6 +1564,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EnablePermissionlessRegistration != false {
+		value := protoreflect.ValueOfBool(x.EnablePermissionlessRegistration)
+		if !f(fd_Params_enable_permissionless_registration, value) {
+			return
+		}
+	}
+	if len(x.RegistrationDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.RegistrationDeposit})
+		if !f(fd_Params_registration_deposit, value) {
+			return
+		}
+	}
+	if x.RegistrationChallengePeriod != nil {
+		value := protoreflect.ValueOfMessage(x.RegistrationChallengePeriod.ProtoReflect())
+		if !f(fd_Params_registration_challenge_period, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -972,6 +1601,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EnableErc20 != false
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		return x.EnableEvmHook != false
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		return x.EnablePermissionlessRegistration != false
+	case "canto.erc20.v1.Params.registration_deposit":
+		return len(x.RegistrationDeposit) != 0
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		return x.RegistrationChallengePeriod != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -992,6 +1627,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EnableErc20 = false
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		x.EnableEvmHook = false
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		x.EnablePermissionlessRegistration = false
+	case "canto.erc20.v1.Params.registration_deposit":
+		x.RegistrationDeposit = nil
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		x.RegistrationChallengePeriod = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -1014,6 +1655,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		value := x.EnableEvmHook
 		return protoreflect.ValueOfBool(value)
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		value := x.EnablePermissionlessRegistration
+		return protoreflect.ValueOfBool(value)
+	case "canto.erc20.v1.Params.registration_deposit":
+		if len(x.RegistrationDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.RegistrationDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		value := x.RegistrationChallengePeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -1038,6 +1691,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EnableErc20 = value.Bool()
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		x.EnableEvmHook = value.Bool()
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		x.EnablePermissionlessRegistration = value.Bool()
+	case "canto.erc20.v1.Params.registration_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.RegistrationDeposit = *clv.list
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		x.RegistrationChallengePeriod = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -1058,10 +1719,23 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "canto.erc20.v1.Params.registration_deposit":
+		if x.RegistrationDeposit == nil {
+			x.RegistrationDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_4_list{list: &x.RegistrationDeposit}
+		return protoreflect.ValueOfList(value)
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		if x.RegistrationChallengePeriod == nil {
+			x.RegistrationChallengePeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RegistrationChallengePeriod.ProtoReflect())
 	case "canto.erc20.v1.Params.enable_erc20":
 		panic(fmt.Errorf("field enable_erc20 of message canto.erc20.v1.Params is not mutable"))
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		panic(fmt.Errorf("field enable_evm_hook of message canto.erc20.v1.Params is not mutable"))
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		panic(fmt.Errorf("field enable_permissionless_registration of message canto.erc20.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -1079,6 +1753,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "canto.erc20.v1.Params.enable_evm_hook":
 		return protoreflect.ValueOfBool(false)
+	case "canto.erc20.v1.Params.enable_permissionless_registration":
+		return protoreflect.ValueOfBool(false)
+	case "canto.erc20.v1.Params.registration_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "canto.erc20.v1.Params.registration_challenge_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.erc20.v1.Params"))
@@ -1154,6 +1836,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableEvmHook {
 			n += 2
 		}
+		if x.EnablePermissionlessRegistration {
+			n += 2
+		}
+		if len(x.RegistrationDeposit) > 0 {
+			for _, e := range x.RegistrationDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RegistrationChallengePeriod != nil {
+			l = options.Size(x.RegistrationChallengePeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1183,6 +1878,46 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RegistrationChallengePeriod != nil {
+			encoded, err := options.Marshal(x.RegistrationChallengePeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RegistrationDeposit) > 0 {
+			for iNdEx := len(x.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RegistrationDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.EnablePermissionlessRegistration {
+			i--
+			if x.EnablePermissionlessRegistration {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.EnableEvmHook {
 			i--
 			if x.EnableEvmHook {
@@ -1292,6 +2027,96 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableEvmHook = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnablePermissionlessRegistration = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationDeposit = append(x.RegistrationDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RegistrationDeposit[len(x.RegistrationDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationChallengePeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RegistrationChallengePeriod == nil {
+					x.RegistrationChallengePeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RegistrationChallengePeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1356,6 +2181,16 @@ type GenesisState struct {
 	// list of mappings from ERC20 addresses to token pair IDs, used for indexing
 	// token pairs by their ERC20 address
 	Erc20AddressIndexes []*TokenPairERC20AddressIndex `protobuf:"bytes,4,rep,name=erc20_address_indexes,json=erc20AddressIndexes,proto3" json:"erc20_address_indexes,omitempty"`
+	// conversion rate limits of the registered token pairs
+	RateLimits []*RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// ERC20 token pairs registered without governance that are waiting for the
+	// end of their challenge period
+	PendingRegistrations []*PendingRegistration `protobuf:"bytes,6,rep,name=pending_registrations,json=pendingRegistrations,proto3" json:"pending_registrations,omitempty"`
+	// native ERC20 token pairs disabled after an escrow balance mismatch
+	AutoDisabledTokenPairs []*AutoDisabledTokenPair `protobuf:"bytes,7,rep,name=auto_disabled_token_pairs,json=autoDisabledTokenPairs,proto3" json:"auto_disabled_token_pairs,omitempty"`
+	// activated registrations whose deposit could not be refunded and is held
+	// on the module account until governance recovers it
+	UnrefundedDeposits []*PendingRegistration `protobuf:"bytes,8,rep,name=unrefunded_deposits,json=unrefundedDeposits,proto3" json:"unrefunded_deposits,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1406,6 +2241,34 @@ func (x *GenesisState) GetErc20AddressIndexes() []*TokenPairERC20AddressIndex {
 	return nil
 }
 
+func (x *GenesisState) GetRateLimits() []*RateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingRegistrations() []*PendingRegistration {
+	if x != nil {
+		return x.PendingRegistrations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoDisabledTokenPairs() []*AutoDisabledTokenPair {
+	if x != nil {
+		return x.AutoDisabledTokenPairs
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnrefundedDeposits() []*PendingRegistration {
+	if x != nil {
+		return x.UnrefundedDeposits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	state         protoimpl.MessageState
@@ -1418,6 +2281,15 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the
 	// ModuleAddress Ethereum address.
 	EnableEvmHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// parameter to enable the registration of ERC20 tokens without a
+	// governance proposal.
+	EnablePermissionlessRegistration bool `protobuf:"varint,3,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// deposit escrowed when registering an ERC20 token without a governance
+	// proposal. It is refunded once the token pair is activated.
+	RegistrationDeposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=registration_deposit,json=registrationDeposit,proto3" json:"registration_deposit,omitempty"`
+	// period during which governance can disable a token pair registered
+	// without a governance proposal before it is activated.
+	RegistrationChallengePeriod *durationpb.Duration `protobuf:"bytes,5,opt,name=registration_challenge_period,json=registrationChallengePeriod,proto3" json:"registration_challenge_period,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1454,6 +2326,27 @@ func (x *Params) GetEnableEvmHook() bool {
 	return false
 }
 
+func (x *Params) GetEnablePermissionlessRegistration() bool {
+	if x != nil {
+		return x.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (x *Params) GetRegistrationDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.RegistrationDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetRegistrationChallengePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.RegistrationChallengePeriod
+	}
+	return nil
+}
+
 var File_canto_erc20_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_canto_erc20_v1_genesis_proto_rawDesc = []byte{
@@ -1464,7 +2357,11 @@ var file_canto_erc20_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x63, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x65, 0x72, 0x63,
 	0x32, 0x30, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
@@ -1484,26 +2381,68 @@ var file_canto_erc20_v1_genesis_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x65,
 	0x72, 0x63, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x63, 0x32, 0x30,
-	0x12, 0x39, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x68,
-	0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x56, 0x4d, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0d, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x6d, 0x48, 0x6f, 0x6f, 0x6b, 0x3a, 0x19, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x14, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x72, 0x63, 0x32, 0x30, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x43,
-	0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e,
-	0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1a, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x61,
-	0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x13,
+	0x75, 0x6e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0xb8, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x72, 0x63, 0x32, 0x30, 0x12, 0x39, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x65, 0x76, 0x6d, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x56, 0x4d, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x6d, 0x48, 0x6f, 0x6f,
+	0x6b, 0x12, 0x4c, 0x0a, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x7e, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x67, 0x0a, 0x1d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x1b, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x19, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x63,
+	0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0xa5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e,
+	0x74, 0x6f, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x43, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x43, 0x61, 0x6e,
+	0x74, 0x6f, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43, 0x61,
+	0x6e, 0x74, 0x6f, 0x5c, 0x45, 0x72, 0x63, 0x32, 0x30, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x61, 0x6e, 0x74, 0x6f,
+	0x3a, 0x3a, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1525,17 +2464,28 @@ var file_canto_erc20_v1_genesis_proto_goTypes = []interface{}{
 	(*TokenPair)(nil),                  // 2: canto.erc20.v1.TokenPair
 	(*TokenPairDenomIndex)(nil),        // 3: canto.erc20.v1.TokenPairDenomIndex
 	(*TokenPairERC20AddressIndex)(nil), // 4: canto.erc20.v1.TokenPairERC20AddressIndex
+	(*RateLimit)(nil),                  // 5: canto.erc20.v1.RateLimit
+	(*PendingRegistration)(nil),        // 6: canto.erc20.v1.PendingRegistration
+	(*AutoDisabledTokenPair)(nil),      // 7: canto.erc20.v1.AutoDisabledTokenPair
+	(*v1beta1.Coin)(nil),               // 8: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),        // 9: google.protobuf.Duration
 }
 var file_canto_erc20_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: canto.erc20.v1.GenesisState.params:type_name -> canto.erc20.v1.Params
-	2, // 1: canto.erc20.v1.GenesisState.token_pairs:type_name -> canto.erc20.v1.TokenPair
-	3, // 2: canto.erc20.v1.GenesisState.denom_indexes:type_name -> canto.erc20.v1.TokenPairDenomIndex
-	4, // 3: canto.erc20.v1.GenesisState.erc20_address_indexes:type_name -> canto.erc20.v1.TokenPairERC20AddressIndex
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: canto.erc20.v1.GenesisState.params:type_name -> canto.erc20.v1.Params
+	2,  // 1: canto.erc20.v1.GenesisState.token_pairs:type_name -> canto.erc20.v1.TokenPair
+	3,  // 2: canto.erc20.v1.GenesisState.denom_indexes:type_name -> canto.erc20.v1.TokenPairDenomIndex
+	4,  // 3: canto.erc20.v1.GenesisState.erc20_address_indexes:type_name -> canto.erc20.v1.TokenPairERC20AddressIndex
+	5,  // 4: canto.erc20.v1.GenesisState.rate_limits:type_name -> canto.erc20.v1.RateLimit
+	6,  // 5: canto.erc20.v1.GenesisState.pending_registrations:type_name -> canto.erc20.v1.PendingRegistration
+	7,  // 6: canto.erc20.v1.GenesisState.auto_disabled_token_pairs:type_name -> canto.erc20.v1.AutoDisabledTokenPair
+	6,  // 7: canto.erc20.v1.GenesisState.unrefunded_deposits:type_name -> canto.erc20.v1.PendingRegistration
+	8,  // 8: canto.erc20.v1.Params.registration_deposit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 9: canto.erc20.v1.Params.registration_challenge_period:type_name -> google.protobuf.Duration
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_canto_erc20_v1_genesis_proto_init() }
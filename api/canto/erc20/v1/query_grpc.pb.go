@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/canto.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/canto.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/canto.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName             = "/canto.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName              = "/canto.erc20.v1.Query/TokenPair"
+	Query_Params_FullMethodName                 = "/canto.erc20.v1.Query/Params"
+	Query_RateLimits_FullMethodName             = "/canto.erc20.v1.Query/RateLimits"
+	Query_RateLimit_FullMethodName              = "/canto.erc20.v1.Query/RateLimit"
+	Query_PendingRegistrations_FullMethodName   = "/canto.erc20.v1.Query/PendingRegistrations"
+	Query_TokenPairDrifts_FullMethodName        = "/canto.erc20.v1.Query/TokenPairDrifts"
+	Query_Balances_FullMethodName               = "/canto.erc20.v1.Query/Balances"
+	Query_AutoDisabledTokenPairs_FullMethodName = "/canto.erc20.v1.Query/AutoDisabledTokenPairs"
+	Query_UnrefundedDeposits_FullMethodName     = "/canto.erc20.v1.Query/UnrefundedDeposits"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +41,26 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RateLimits retrieves the conversion rate limits of all token pairs
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimit retrieves the conversion rate limit of a token pair and the
+	// remaining quota of the current epoch
+	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
+	// PendingRegistrations retrieves the ERC20 token pairs registered without a
+	// governance proposal that are waiting for activation
+	PendingRegistrations(ctx context.Context, in *QueryPendingRegistrationsRequest, opts ...grpc.CallOption) (*QueryPendingRegistrationsResponse, error)
+	// TokenPairDrifts retrieves the difference between the ERC20 and the Cosmos
+	// coin representations of the registered token pairs
+	TokenPairDrifts(ctx context.Context, in *QueryTokenPairDriftsRequest, opts ...grpc.CallOption) (*QueryTokenPairDriftsResponse, error)
+	// Balances retrieves the coin and ERC20 balances of an account for every
+	// registered token pair
+	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// AutoDisabledTokenPairs retrieves the native ERC20 token pairs disabled
+	// after a conversion escrowed a different amount of tokens than requested
+	AutoDisabledTokenPairs(ctx context.Context, in *QueryAutoDisabledTokenPairsRequest, opts ...grpc.CallOption) (*QueryAutoDisabledTokenPairsResponse, error)
+	// UnrefundedDeposits retrieves the activated registrations whose deposit
+	// could not be refunded to the depositor
+	UnrefundedDeposits(ctx context.Context, in *QueryUnrefundedDepositsRequest, opts ...grpc.CallOption) (*QueryUnrefundedDepositsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +98,69 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRegistrations(ctx context.Context, in *QueryPendingRegistrationsRequest, opts ...grpc.CallOption) (*QueryPendingRegistrationsResponse, error) {
+	out := new(QueryPendingRegistrationsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRegistrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPairDrifts(ctx context.Context, in *QueryTokenPairDriftsRequest, opts ...grpc.CallOption) (*QueryTokenPairDriftsResponse, error) {
+	out := new(QueryTokenPairDriftsResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairDrifts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
+	out := new(QueryBalancesResponse)
+	err := c.cc.Invoke(ctx, Query_Balances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoDisabledTokenPairs(ctx context.Context, in *QueryAutoDisabledTokenPairsRequest, opts ...grpc.CallOption) (*QueryAutoDisabledTokenPairsResponse, error) {
+	out := new(QueryAutoDisabledTokenPairsResponse)
+	err := c.cc.Invoke(ctx, Query_AutoDisabledTokenPairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnrefundedDeposits(ctx context.Context, in *QueryUnrefundedDepositsRequest, opts ...grpc.CallOption) (*QueryUnrefundedDepositsResponse, error) {
+	out := new(QueryUnrefundedDepositsResponse)
+	err := c.cc.Invoke(ctx, Query_UnrefundedDeposits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +171,26 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RateLimits retrieves the conversion rate limits of all token pairs
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimit retrieves the conversion rate limit of a token pair and the
+	// remaining quota of the current epoch
+	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
+	// PendingRegistrations retrieves the ERC20 token pairs registered without a
+	// governance proposal that are waiting for activation
+	PendingRegistrations(context.Context, *QueryPendingRegistrationsRequest) (*QueryPendingRegistrationsResponse, error)
+	// TokenPairDrifts retrieves the difference between the ERC20 and the Cosmos
+	// coin representations of the registered token pairs
+	TokenPairDrifts(context.Context, *QueryTokenPairDriftsRequest) (*QueryTokenPairDriftsResponse, error)
+	// Balances retrieves the coin and ERC20 balances of an account for every
+	// registered token pair
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// AutoDisabledTokenPairs retrieves the native ERC20 token pairs disabled
+	// after a conversion escrowed a different amount of tokens than requested
+	AutoDisabledTokenPairs(context.Context, *QueryAutoDisabledTokenPairsRequest) (*QueryAutoDisabledTokenPairsResponse, error)
+	// UnrefundedDeposits retrieves the activated registrations whose deposit
+	// could not be refunded to the depositor
+	UnrefundedDeposits(context.Context, *QueryUnrefundedDepositsRequest) (*QueryUnrefundedDepositsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +207,27 @@ func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairReques
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (UnimplementedQueryServer) PendingRegistrations(context.Context, *QueryPendingRegistrationsRequest) (*QueryPendingRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRegistrations not implemented")
+}
+func (UnimplementedQueryServer) TokenPairDrifts(context.Context, *QueryTokenPairDriftsRequest) (*QueryTokenPairDriftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairDrifts not implemented")
+}
+func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (UnimplementedQueryServer) AutoDisabledTokenPairs(context.Context, *QueryAutoDisabledTokenPairsRequest) (*QueryAutoDisabledTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoDisabledTokenPairs not implemented")
+}
+func (UnimplementedQueryServer) UnrefundedDeposits(context.Context, *QueryUnrefundedDepositsRequest) (*QueryUnrefundedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrefundedDeposits not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +295,132 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRegistrations(ctx, req.(*QueryPendingRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPairDrifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairDriftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairDrifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairDrifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairDrifts(ctx, req.(*QueryTokenPairDriftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Balances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoDisabledTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoDisabledTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoDisabledTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoDisabledTokenPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoDisabledTokenPairs(ctx, req.(*QueryAutoDisabledTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnrefundedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnrefundedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnrefundedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnrefundedDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnrefundedDeposits(ctx, req.(*QueryUnrefundedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +440,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "PendingRegistrations",
+			Handler:    _Query_PendingRegistrations_Handler,
+		},
+		{
+			MethodName: "TokenPairDrifts",
+			Handler:    _Query_TokenPairDrifts_Handler,
+		},
+		{
+			MethodName: "Balances",
+			Handler:    _Query_Balances_Handler,
+		},
+		{
+			MethodName: "AutoDisabledTokenPairs",
+			Handler:    _Query_AutoDisabledTokenPairs_Handler,
+		},
+		{
+			MethodName: "UnrefundedDeposits",
+			Handler:    _Query_UnrefundedDeposits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/erc20/v1/query.proto",
@@ -19,12 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertCoin_FullMethodName                   = "/canto.erc20.v1.Msg/ConvertCoin"
-	Msg_ConvertERC20_FullMethodName                  = "/canto.erc20.v1.Msg/ConvertERC20"
-	Msg_UpdateParams_FullMethodName                  = "/canto.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterCoinProposal_FullMethodName          = "/canto.erc20.v1.Msg/RegisterCoinProposal"
-	Msg_RegisterERC20Proposal_FullMethodName         = "/canto.erc20.v1.Msg/RegisterERC20Proposal"
-	Msg_ToggleTokenConversionProposal_FullMethodName = "/canto.erc20.v1.Msg/ToggleTokenConversionProposal"
+	Msg_ConvertCoin_FullMethodName                      = "/canto.erc20.v1.Msg/ConvertCoin"
+	Msg_ConvertERC20_FullMethodName                     = "/canto.erc20.v1.Msg/ConvertERC20"
+	Msg_ConvertCoins_FullMethodName                     = "/canto.erc20.v1.Msg/ConvertCoins"
+	Msg_ConvertERC20S_FullMethodName                    = "/canto.erc20.v1.Msg/ConvertERC20s"
+	Msg_UpdateParams_FullMethodName                     = "/canto.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterCoinProposal_FullMethodName             = "/canto.erc20.v1.Msg/RegisterCoinProposal"
+	Msg_RegisterERC20Proposal_FullMethodName            = "/canto.erc20.v1.Msg/RegisterERC20Proposal"
+	Msg_ToggleTokenConversionProposal_FullMethodName    = "/canto.erc20.v1.Msg/ToggleTokenConversionProposal"
+	Msg_SetRateLimitProposal_FullMethodName             = "/canto.erc20.v1.Msg/SetRateLimitProposal"
+	Msg_RemoveRateLimitProposal_FullMethodName          = "/canto.erc20.v1.Msg/RemoveRateLimitProposal"
+	Msg_RegisterERC20Permissionless_FullMethodName      = "/canto.erc20.v1.Msg/RegisterERC20Permissionless"
+	Msg_MigrateTokenPairProposal_FullMethodName         = "/canto.erc20.v1.Msg/MigrateTokenPairProposal"
+	Msg_RefreshTokenPairMetadataProposal_FullMethodName = "/canto.erc20.v1.Msg/RefreshTokenPairMetadataProposal"
+	Msg_RecoverDepositProposal_FullMethodName           = "/canto.erc20.v1.Msg/RecoverDepositProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +45,12 @@ type MsgClient interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
 	// contract that is registered on the token mapping.
 	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// ConvertCoins converts a batch of native Cosmos coins into their ERC20
+	// representations. The conversions are atomic.
+	ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error)
+	// ConvertERC20s converts a batch of ERC20 tokens into their native Cosmos
+	// coin representations. The conversions are atomic.
+	ConvertERC20S(ctx context.Context, in *MsgConvertERC20S, opts ...grpc.CallOption) (*MsgConvertERC20SResponse, error)
 	// UpdateParams updates the parameters of the x/erc20 module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// RegisterCoinProposal defines a method to create a proposal to register a
@@ -48,6 +62,25 @@ type MsgClient interface {
 	// ToggleTokenConversionProposal defines a method to create a proposal to
 	// toggle the conversion of a token pair.
 	ToggleTokenConversionProposal(ctx context.Context, in *MsgToggleTokenConversion, opts ...grpc.CallOption) (*MsgToggleTokenConversionResponse, error)
+	// SetRateLimitProposal defines a method to create a proposal to set the
+	// conversion rate limit of a token pair.
+	SetRateLimitProposal(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimitProposal defines a method to create a proposal to remove
+	// the conversion rate limit of a token pair.
+	RemoveRateLimitProposal(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 token
+	// without a governance proposal. The token pair is activated after the
+	// challenge period unless governance disables it.
+	RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20Permissionless, opts ...grpc.CallOption) (*MsgRegisterERC20PermissionlessResponse, error)
+	// MigrateTokenPairProposal defines a method to create a proposal to point
+	// the denom of a native ERC20 token pair at a redeployed ERC20 contract.
+	MigrateTokenPairProposal(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
+	// RefreshTokenPairMetadataProposal defines a method to create a proposal to
+	// resync the bank metadata of a native ERC20 token pair with its contract.
+	RefreshTokenPairMetadataProposal(ctx context.Context, in *MsgRefreshTokenPairMetadata, opts ...grpc.CallOption) (*MsgRefreshTokenPairMetadataResponse, error)
+	// RecoverDepositProposal defines a method to create a proposal to send a
+	// registration deposit that could not be refunded to a recipient.
+	RecoverDepositProposal(ctx context.Context, in *MsgRecoverDeposit, opts ...grpc.CallOption) (*MsgRecoverDepositResponse, error)
 }
 
 type msgClient struct {
@@ -76,6 +109,24 @@ func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts
 	return out, nil
 }
 
+func (c *msgClient) ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error) {
+	out := new(MsgConvertCoinsResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertCoins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC20S(ctx context.Context, in *MsgConvertERC20S, opts ...grpc.CallOption) (*MsgConvertERC20SResponse, error) {
+	out := new(MsgConvertERC20SResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertERC20S_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -112,6 +163,60 @@ func (c *msgClient) ToggleTokenConversionProposal(ctx context.Context, in *MsgTo
 	return out, nil
 }
 
+func (c *msgClient) SetRateLimitProposal(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimitProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimitProposal(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRateLimitProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20Permissionless, opts ...grpc.CallOption) (*MsgRegisterERC20PermissionlessResponse, error) {
+	out := new(MsgRegisterERC20PermissionlessResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterERC20Permissionless_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateTokenPairProposal(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateTokenPairProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefreshTokenPairMetadataProposal(ctx context.Context, in *MsgRefreshTokenPairMetadata, opts ...grpc.CallOption) (*MsgRefreshTokenPairMetadataResponse, error) {
+	out := new(MsgRefreshTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_RefreshTokenPairMetadataProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverDepositProposal(ctx context.Context, in *MsgRecoverDeposit, opts ...grpc.CallOption) (*MsgRecoverDepositResponse, error) {
+	out := new(MsgRecoverDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverDepositProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -122,6 +227,12 @@ type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
 	// contract that is registered on the token mapping.
 	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// ConvertCoins converts a batch of native Cosmos coins into their ERC20
+	// representations. The conversions are atomic.
+	ConvertCoins(context.Context, *MsgConvertCoins) (*MsgConvertCoinsResponse, error)
+	// ConvertERC20s converts a batch of ERC20 tokens into their native Cosmos
+	// coin representations. The conversions are atomic.
+	ConvertERC20S(context.Context, *MsgConvertERC20S) (*MsgConvertERC20SResponse, error)
 	// UpdateParams updates the parameters of the x/erc20 module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// RegisterCoinProposal defines a method to create a proposal to register a
@@ -133,6 +244,25 @@ type MsgServer interface {
 	// ToggleTokenConversionProposal defines a method to create a proposal to
 	// toggle the conversion of a token pair.
 	ToggleTokenConversionProposal(context.Context, *MsgToggleTokenConversion) (*MsgToggleTokenConversionResponse, error)
+	// SetRateLimitProposal defines a method to create a proposal to set the
+	// conversion rate limit of a token pair.
+	SetRateLimitProposal(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimitProposal defines a method to create a proposal to remove
+	// the conversion rate limit of a token pair.
+	RemoveRateLimitProposal(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 token
+	// without a governance proposal. The token pair is activated after the
+	// challenge period unless governance disables it.
+	RegisterERC20Permissionless(context.Context, *MsgRegisterERC20Permissionless) (*MsgRegisterERC20PermissionlessResponse, error)
+	// MigrateTokenPairProposal defines a method to create a proposal to point
+	// the denom of a native ERC20 token pair at a redeployed ERC20 contract.
+	MigrateTokenPairProposal(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
+	// RefreshTokenPairMetadataProposal defines a method to create a proposal to
+	// resync the bank metadata of a native ERC20 token pair with its contract.
+	RefreshTokenPairMetadataProposal(context.Context, *MsgRefreshTokenPairMetadata) (*MsgRefreshTokenPairMetadataResponse, error)
+	// RecoverDepositProposal defines a method to create a proposal to send a
+	// registration deposit that could not be refunded to a recipient.
+	RecoverDepositProposal(context.Context, *MsgRecoverDeposit) (*MsgRecoverDepositResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -146,6 +276,12 @@ func (UnimplementedMsgServer) ConvertCoin(context.Context, *MsgConvertCoin) (*Ms
 func (UnimplementedMsgServer) ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
 }
+func (UnimplementedMsgServer) ConvertCoins(context.Context, *MsgConvertCoins) (*MsgConvertCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoins not implemented")
+}
+func (UnimplementedMsgServer) ConvertERC20S(context.Context, *MsgConvertERC20S) (*MsgConvertERC20SResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20S not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -158,6 +294,24 @@ func (UnimplementedMsgServer) RegisterERC20Proposal(context.Context, *MsgRegiste
 func (UnimplementedMsgServer) ToggleTokenConversionProposal(context.Context, *MsgToggleTokenConversion) (*MsgToggleTokenConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleTokenConversionProposal not implemented")
 }
+func (UnimplementedMsgServer) SetRateLimitProposal(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimitProposal not implemented")
+}
+func (UnimplementedMsgServer) RemoveRateLimitProposal(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimitProposal not implemented")
+}
+func (UnimplementedMsgServer) RegisterERC20Permissionless(context.Context, *MsgRegisterERC20Permissionless) (*MsgRegisterERC20PermissionlessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20Permissionless not implemented")
+}
+func (UnimplementedMsgServer) MigrateTokenPairProposal(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPairProposal not implemented")
+}
+func (UnimplementedMsgServer) RefreshTokenPairMetadataProposal(context.Context, *MsgRefreshTokenPairMetadata) (*MsgRefreshTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTokenPairMetadataProposal not implemented")
+}
+func (UnimplementedMsgServer) RecoverDepositProposal(context.Context, *MsgRecoverDeposit) (*MsgRecoverDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverDepositProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -207,6 +361,42 @@ func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoins(ctx, req.(*MsgConvertCoins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20S_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20S)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20S(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertERC20S_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20S(ctx, req.(*MsgConvertERC20S))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -279,6 +469,114 @@ func _Msg_ToggleTokenConversionProposal_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRateLimitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimitProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimitProposal(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRateLimitProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimitProposal(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterERC20Permissionless_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20Permissionless)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterERC20Permissionless_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, req.(*MsgRegisterERC20Permissionless))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPairProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPairProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateTokenPairProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPairProposal(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefreshTokenPairMetadataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshTokenPairMetadataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefreshTokenPairMetadataProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshTokenPairMetadataProposal(ctx, req.(*MsgRefreshTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverDepositProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverDepositProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverDepositProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverDepositProposal(ctx, req.(*MsgRecoverDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -294,6 +592,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertERC20",
 			Handler:    _Msg_ConvertERC20_Handler,
 		},
+		{
+			MethodName: "ConvertCoins",
+			Handler:    _Msg_ConvertCoins_Handler,
+		},
+		{
+			MethodName: "ConvertERC20s",
+			Handler:    _Msg_ConvertERC20S_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -310,6 +616,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleTokenConversionProposal",
 			Handler:    _Msg_ToggleTokenConversionProposal_Handler,
 		},
+		{
+			MethodName: "SetRateLimitProposal",
+			Handler:    _Msg_SetRateLimitProposal_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimitProposal",
+			Handler:    _Msg_RemoveRateLimitProposal_Handler,
+		},
+		{
+			MethodName: "RegisterERC20Permissionless",
+			Handler:    _Msg_RegisterERC20Permissionless_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPairProposal",
+			Handler:    _Msg_MigrateTokenPairProposal_Handler,
+		},
+		{
+			MethodName: "RefreshTokenPairMetadataProposal",
+			Handler:    _Msg_RefreshTokenPairMetadataProposal_Handler,
+		},
+		{
+			MethodName: "RecoverDepositProposal",
+			Handler:    _Msg_RecoverDepositProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/erc20/v1/tx.proto",
@@ -13,10 +13,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field ProposalIds as it is not of Message kind"))
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                    protoreflect.MessageDescriptor
 	fd_GenesisState_params             protoreflect.FieldDescriptor
 	fd_GenesisState_port_contract_addr protoreflect.FieldDescriptor
+	fd_GenesisState_proposal_ids       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +71,7 @@ func init() {
 	md_GenesisState = File_canto_govshuttle_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_port_contract_addr = md_GenesisState.Fields().ByName("port_contract_addr")
+	fd_GenesisState_proposal_ids = md_GenesisState.Fields().ByName("proposal_ids")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -103,6 +151,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ProposalIds) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ProposalIds})
+		if !f(fd_GenesisState_proposal_ids, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +176,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		return x.PortContractAddr != ""
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		return len(x.ProposalIds) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.GenesisState"))
@@ -142,6 +198,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		x.PortContractAddr = ""
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		x.ProposalIds = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.GenesisState"))
@@ -164,6 +222,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		value := x.PortContractAddr
 		return protoreflect.ValueOfString(value)
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		if len(x.ProposalIds) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.ProposalIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.GenesisState"))
@@ -188,6 +252,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Params = value.Message().Interface().(*Params)
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		x.PortContractAddr = value.Interface().(string)
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.ProposalIds = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.GenesisState"))
@@ -213,6 +281,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		if x.ProposalIds == nil {
+			x.ProposalIds = []uint64{}
+		}
+		value := &_GenesisState_3_list{list: &x.ProposalIds}
+		return protoreflect.ValueOfList(value)
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		panic(fmt.Errorf("field port_contract_addr of message canto.govshuttle.v1.GenesisState is not mutable"))
 	default:
@@ -233,6 +307,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "canto.govshuttle.v1.GenesisState.port_contract_addr":
 		return protoreflect.ValueOfString("")
+	case "canto.govshuttle.v1.GenesisState.proposal_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.GenesisState"))
@@ -310,6 +387,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ProposalIds) > 0 {
+			l = 0
+			for _, e := range x.ProposalIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -339,6 +423,26 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ProposalIds) > 0 {
+			var pksize2 int
+			for _, num := range x.ProposalIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ProposalIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.PortContractAddr) > 0 {
 			i -= len(x.PortContractAddr)
 			copy(dAtA[i:], x.PortContractAddr)
@@ -477,6 +581,82 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.PortContractAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ProposalIds = append(x.ProposalIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ProposalIds) == 0 {
+						x.ProposalIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ProposalIds = append(x.ProposalIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -532,7 +712,9 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	Params           *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	PortContractAddr string  `protobuf:"bytes,2,opt,name=port_contract_addr,json=portContractAddr,proto3" json:"port_contract_addr,omitempty"` // this line is used by starport scaffolding # genesis/proto/state
+	PortContractAddr string  `protobuf:"bytes,2,opt,name=port_contract_addr,json=portContractAddr,proto3" json:"port_contract_addr,omitempty"`
+	// proposal_ids are the ids of the proposals stored in the Port contract
+	ProposalIds []uint64 `protobuf:"varint,3,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"` // this line is used by starport scaffolding # genesis/proto/state
 }
 
 func (x *GenesisState) Reset() {
@@ -569,6 +751,13 @@ func (x *GenesisState) GetPortContractAddr() string {
 	return ""
 }
 
+func (x *GenesisState) GetProposalIds() []uint64 {
+	if x != nil {
+		return x.ProposalIds
+	}
+	return nil
+}
+
 var File_canto_govshuttle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_canto_govshuttle_v1_genesis_proto_rawDesc = []byte{
@@ -579,27 +768,30 @@ var file_canto_govshuttle_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
 	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65,
 	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76,
-	0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0xc8, 0x01,
-	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73,
-	0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
-	0x47, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x76, 0x73, 0x68,
-	0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f,
-	0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1f, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c,
-	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75,
-	0x74, 0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f,
+	0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
+	0x73, 0x42, 0xc8, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
+	0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x47,
+	0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43,
+	0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68,
+	0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x47, 0x6f,
+	0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
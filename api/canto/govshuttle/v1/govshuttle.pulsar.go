@@ -3032,6 +3032,1034 @@ func (x *fastReflection_LendingMarketMetadata) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_PortProposal_4_list)(nil)
+
+type _PortProposal_4_list struct {
+	list *[]string
+}
+
+func (x *_PortProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PortProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PortProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PortProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PortProposal_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PortProposal at list field Accounts as it is not of Message kind"))
+}
+
+func (x *_PortProposal_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PortProposal_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PortProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PortProposal_5_list)(nil)
+
+type _PortProposal_5_list struct {
+	list *[]string
+}
+
+func (x *_PortProposal_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PortProposal_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PortProposal_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PortProposal_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PortProposal_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PortProposal at list field Values as it is not of Message kind"))
+}
+
+func (x *_PortProposal_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PortProposal_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PortProposal_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PortProposal_6_list)(nil)
+
+type _PortProposal_6_list struct {
+	list *[]string
+}
+
+func (x *_PortProposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PortProposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PortProposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PortProposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PortProposal_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PortProposal at list field Calldatas as it is not of Message kind"))
+}
+
+func (x *_PortProposal_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PortProposal_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PortProposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PortProposal_7_list)(nil)
+
+type _PortProposal_7_list struct {
+	list *[]string
+}
+
+func (x *_PortProposal_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PortProposal_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PortProposal_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PortProposal_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PortProposal_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PortProposal at list field Signatures as it is not of Message kind"))
+}
+
+func (x *_PortProposal_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PortProposal_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PortProposal_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PortProposal             protoreflect.MessageDescriptor
+	fd_PortProposal_prop_id     protoreflect.FieldDescriptor
+	fd_PortProposal_title       protoreflect.FieldDescriptor
+	fd_PortProposal_description protoreflect.FieldDescriptor
+	fd_PortProposal_accounts    protoreflect.FieldDescriptor
+	fd_PortProposal_values      protoreflect.FieldDescriptor
+	fd_PortProposal_calldatas   protoreflect.FieldDescriptor
+	fd_PortProposal_signatures  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_govshuttle_v1_govshuttle_proto_init()
+	md_PortProposal = File_canto_govshuttle_v1_govshuttle_proto.Messages().ByName("PortProposal")
+	fd_PortProposal_prop_id = md_PortProposal.Fields().ByName("prop_id")
+	fd_PortProposal_title = md_PortProposal.Fields().ByName("title")
+	fd_PortProposal_description = md_PortProposal.Fields().ByName("description")
+	fd_PortProposal_accounts = md_PortProposal.Fields().ByName("accounts")
+	fd_PortProposal_values = md_PortProposal.Fields().ByName("values")
+	fd_PortProposal_calldatas = md_PortProposal.Fields().ByName("calldatas")
+	fd_PortProposal_signatures = md_PortProposal.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_PortProposal)(nil)
+
+type fastReflection_PortProposal PortProposal
+
+func (x *PortProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PortProposal)(x)
+}
+
+func (x *PortProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_govshuttle_v1_govshuttle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PortProposal_messageType fastReflection_PortProposal_messageType
+var _ protoreflect.MessageType = fastReflection_PortProposal_messageType{}
+
+type fastReflection_PortProposal_messageType struct{}
+
+func (x fastReflection_PortProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PortProposal)(nil)
+}
+func (x fastReflection_PortProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_PortProposal)
+}
+func (x fastReflection_PortProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PortProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PortProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_PortProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PortProposal) Type() protoreflect.MessageType {
+	return _fastReflection_PortProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PortProposal) New() protoreflect.Message {
+	return new(fastReflection_PortProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PortProposal) Interface() protoreflect.ProtoMessage {
+	return (*PortProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PortProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PropId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PropId)
+		if !f(fd_PortProposal_prop_id, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_PortProposal_title, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_PortProposal_description, value) {
+			return
+		}
+	}
+	if len(x.Accounts) != 0 {
+		value := protoreflect.ValueOfList(&_PortProposal_4_list{list: &x.Accounts})
+		if !f(fd_PortProposal_accounts, value) {
+			return
+		}
+	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_PortProposal_5_list{list: &x.Values})
+		if !f(fd_PortProposal_values, value) {
+			return
+		}
+	}
+	if len(x.Calldatas) != 0 {
+		value := protoreflect.ValueOfList(&_PortProposal_6_list{list: &x.Calldatas})
+		if !f(fd_PortProposal_calldatas, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_PortProposal_7_list{list: &x.Signatures})
+		if !f(fd_PortProposal_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PortProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		return x.PropId != uint64(0)
+	case "canto.govshuttle.v1.PortProposal.title":
+		return x.Title != ""
+	case "canto.govshuttle.v1.PortProposal.description":
+		return x.Description != ""
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		return len(x.Accounts) != 0
+	case "canto.govshuttle.v1.PortProposal.values":
+		return len(x.Values) != 0
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		return len(x.Calldatas) != 0
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PortProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		x.PropId = uint64(0)
+	case "canto.govshuttle.v1.PortProposal.title":
+		x.Title = ""
+	case "canto.govshuttle.v1.PortProposal.description":
+		x.Description = ""
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		x.Accounts = nil
+	case "canto.govshuttle.v1.PortProposal.values":
+		x.Values = nil
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		x.Calldatas = nil
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PortProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		value := x.PropId
+		return protoreflect.ValueOfUint64(value)
+	case "canto.govshuttle.v1.PortProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "canto.govshuttle.v1.PortProposal.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		if len(x.Accounts) == 0 {
+			return protoreflect.ValueOfList(&_PortProposal_4_list{})
+		}
+		listValue := &_PortProposal_4_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.govshuttle.v1.PortProposal.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_PortProposal_5_list{})
+		}
+		listValue := &_PortProposal_5_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		if len(x.Calldatas) == 0 {
+			return protoreflect.ValueOfList(&_PortProposal_6_list{})
+		}
+		listValue := &_PortProposal_6_list{list: &x.Calldatas}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_PortProposal_7_list{})
+		}
+		listValue := &_PortProposal_7_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PortProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		x.PropId = value.Uint()
+	case "canto.govshuttle.v1.PortProposal.title":
+		x.Title = value.Interface().(string)
+	case "canto.govshuttle.v1.PortProposal.description":
+		x.Description = value.Interface().(string)
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		lv := value.List()
+		clv := lv.(*_PortProposal_4_list)
+		x.Accounts = *clv.list
+	case "canto.govshuttle.v1.PortProposal.values":
+		lv := value.List()
+		clv := lv.(*_PortProposal_5_list)
+		x.Values = *clv.list
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		lv := value.List()
+		clv := lv.(*_PortProposal_6_list)
+		x.Calldatas = *clv.list
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		lv := value.List()
+		clv := lv.(*_PortProposal_7_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PortProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		if x.Accounts == nil {
+			x.Accounts = []string{}
+		}
+		value := &_PortProposal_4_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(value)
+	case "canto.govshuttle.v1.PortProposal.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_PortProposal_5_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		if x.Calldatas == nil {
+			x.Calldatas = []string{}
+		}
+		value := &_PortProposal_6_list{list: &x.Calldatas}
+		return protoreflect.ValueOfList(value)
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []string{}
+		}
+		value := &_PortProposal_7_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		panic(fmt.Errorf("field prop_id of message canto.govshuttle.v1.PortProposal is not mutable"))
+	case "canto.govshuttle.v1.PortProposal.title":
+		panic(fmt.Errorf("field title of message canto.govshuttle.v1.PortProposal is not mutable"))
+	case "canto.govshuttle.v1.PortProposal.description":
+		panic(fmt.Errorf("field description of message canto.govshuttle.v1.PortProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PortProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.govshuttle.v1.PortProposal.prop_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.govshuttle.v1.PortProposal.title":
+		return protoreflect.ValueOfString("")
+	case "canto.govshuttle.v1.PortProposal.description":
+		return protoreflect.ValueOfString("")
+	case "canto.govshuttle.v1.PortProposal.accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PortProposal_4_list{list: &list})
+	case "canto.govshuttle.v1.PortProposal.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PortProposal_5_list{list: &list})
+	case "canto.govshuttle.v1.PortProposal.calldatas":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PortProposal_6_list{list: &list})
+	case "canto.govshuttle.v1.PortProposal.signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PortProposal_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.PortProposal"))
+		}
+		panic(fmt.Errorf("message canto.govshuttle.v1.PortProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PortProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.govshuttle.v1.PortProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PortProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PortProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PortProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PortProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PortProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PropId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PropId))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Accounts) > 0 {
+			for _, s := range x.Accounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Calldatas) > 0 {
+			for _, s := range x.Calldatas {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Signatures) > 0 {
+			for _, s := range x.Signatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PortProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signatures[iNdEx])
+				copy(dAtA[i:], x.Signatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Calldatas) > 0 {
+			for iNdEx := len(x.Calldatas) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Calldatas[iNdEx])
+				copy(dAtA[i:], x.Calldatas[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldatas[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Accounts) > 0 {
+			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Accounts[iNdEx])
+				copy(dAtA[i:], x.Accounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Accounts[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PropId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PropId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PortProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PortProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PortProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PropId", wireType)
+				}
+				x.PropId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PropId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accounts = append(x.Accounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldatas", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldatas = append(x.Calldatas, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -3045,6 +4073,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TreasuryExecutionMode defines how a passed treasury proposal is executed
+type TreasuryExecutionMode int32
+
+const (
+	// TREASURY_EXECUTION_MODE_PORT converts the proposal into a lending market
+	// proposal and stores it in the Port contract
+	TreasuryExecutionMode_TREASURY_EXECUTION_MODE_PORT TreasuryExecutionMode = 0
+	// TREASURY_EXECUTION_MODE_COMMUNITY_POOL spends the amount from the
+	// distribution community pool to the recipient
+	TreasuryExecutionMode_TREASURY_EXECUTION_MODE_COMMUNITY_POOL TreasuryExecutionMode = 1
+)
+
+// Enum value maps for TreasuryExecutionMode.
+var (
+	TreasuryExecutionMode_name = map[int32]string{
+		0: "TREASURY_EXECUTION_MODE_PORT",
+		1: "TREASURY_EXECUTION_MODE_COMMUNITY_POOL",
+	}
+	TreasuryExecutionMode_value = map[string]int32{
+		"TREASURY_EXECUTION_MODE_PORT":           0,
+		"TREASURY_EXECUTION_MODE_COMMUNITY_POOL": 1,
+	}
+)
+
+func (x TreasuryExecutionMode) Enum() *TreasuryExecutionMode {
+	p := new(TreasuryExecutionMode)
+	*p = x
+	return p
+}
+
+func (x TreasuryExecutionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TreasuryExecutionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_canto_govshuttle_v1_govshuttle_proto_enumTypes[0].Descriptor()
+}
+
+func (TreasuryExecutionMode) Type() protoreflect.EnumType {
+	return &file_canto_govshuttle_v1_govshuttle_proto_enumTypes[0]
+}
+
+func (x TreasuryExecutionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TreasuryExecutionMode.Descriptor instead.
+func (TreasuryExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return file_canto_govshuttle_v1_govshuttle_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -3190,7 +4269,7 @@ type TreasuryProposalMetadata struct {
 	PropID    uint64 `protobuf:"varint,1,opt,name=PropID,proto3" json:"PropID,omitempty"`      // proposalID, for querying proposals in EVM side,
 	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"` // bytestring representing account addresses
 	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"` // canto or note
+	Denom     string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"` // denom, display denom or alias registered in bank metadata
 }
 
 func (x *TreasuryProposalMetadata) Reset() {
@@ -3308,6 +4387,96 @@ func (x *LendingMarketMetadata) GetSignatures() []string {
 	return nil
 }
 
+// PortProposal defines a lending market or treasury proposal stored in the
+// ProposalStore (Port) contract
+type PortProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prop_id is the id of the proposal in the Port contract
+	PropId      uint64 `protobuf:"varint,1,opt,name=prop_id,json=propId,proto3" json:"prop_id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// accounts are the hex addresses of the call targets
+	Accounts []string `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// values are the values sent with each call
+	Values []string `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	// calldatas are the hex encoded calldatas of each call
+	Calldatas []string `protobuf:"bytes,6,rep,name=calldatas,proto3" json:"calldatas,omitempty"`
+	// signatures are the function signatures of each call
+	Signatures []string `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *PortProposal) Reset() {
+	*x = PortProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_govshuttle_v1_govshuttle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortProposal) ProtoMessage() {}
+
+// Deprecated: Use PortProposal.ProtoReflect.Descriptor instead.
+func (*PortProposal) Descriptor() ([]byte, []int) {
+	return file_canto_govshuttle_v1_govshuttle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PortProposal) GetPropId() uint64 {
+	if x != nil {
+		return x.PropId
+	}
+	return 0
+}
+
+func (x *PortProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PortProposal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PortProposal) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *PortProposal) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PortProposal) GetCalldatas() []string {
+	if x != nil {
+		return x.Calldatas
+	}
+	return nil
+}
+
+func (x *PortProposal) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 var File_canto_govshuttle_v1_govshuttle_proto protoreflect.FileDescriptor
 
 var file_canto_govshuttle_v1_govshuttle_proto_rawDesc = []byte{
@@ -3364,21 +4533,43 @@ var file_canto_govshuttle_v1_govshuttle_proto_rawDesc = []byte{
 	0x09, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
 	0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
 	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0xcb, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75,
-	0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74,
-	0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x47, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x76, 0x73,
-	0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74,
-	0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1f, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x73, 0x68,
-	0x75, 0x74, 0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x0c,
+	0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x72, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2a, 0x6b, 0x0a, 0x15,
+	0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x45, 0x41, 0x53, 0x55, 0x52,
+	0x59, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x45, 0x41, 0x53,
+	0x55, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f,
+	0x4c, 0x10, 0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xcb, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x67,
+	0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47,
+	0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75,
+	0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c,
+	0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
+	0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74,
+	0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3393,17 +4584,20 @@ func file_canto_govshuttle_v1_govshuttle_proto_rawDescGZIP() []byte {
 	return file_canto_govshuttle_v1_govshuttle_proto_rawDescData
 }
 
-var file_canto_govshuttle_v1_govshuttle_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_canto_govshuttle_v1_govshuttle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_canto_govshuttle_v1_govshuttle_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_canto_govshuttle_v1_govshuttle_proto_goTypes = []interface{}{
-	(*Params)(nil),                   // 0: canto.govshuttle.v1.Params
-	(*LendingMarketProposal)(nil),    // 1: canto.govshuttle.v1.LendingMarketProposal
-	(*TreasuryProposal)(nil),         // 2: canto.govshuttle.v1.TreasuryProposal
-	(*TreasuryProposalMetadata)(nil), // 3: canto.govshuttle.v1.TreasuryProposalMetadata
-	(*LendingMarketMetadata)(nil),    // 4: canto.govshuttle.v1.LendingMarketMetadata
+	(TreasuryExecutionMode)(0),       // 0: canto.govshuttle.v1.TreasuryExecutionMode
+	(*Params)(nil),                   // 1: canto.govshuttle.v1.Params
+	(*LendingMarketProposal)(nil),    // 2: canto.govshuttle.v1.LendingMarketProposal
+	(*TreasuryProposal)(nil),         // 3: canto.govshuttle.v1.TreasuryProposal
+	(*TreasuryProposalMetadata)(nil), // 4: canto.govshuttle.v1.TreasuryProposalMetadata
+	(*LendingMarketMetadata)(nil),    // 5: canto.govshuttle.v1.LendingMarketMetadata
+	(*PortProposal)(nil),             // 6: canto.govshuttle.v1.PortProposal
 }
 var file_canto_govshuttle_v1_govshuttle_proto_depIdxs = []int32{
-	4, // 0: canto.govshuttle.v1.LendingMarketProposal.metadata:type_name -> canto.govshuttle.v1.LendingMarketMetadata
-	3, // 1: canto.govshuttle.v1.TreasuryProposal.metadata:type_name -> canto.govshuttle.v1.TreasuryProposalMetadata
+	5, // 0: canto.govshuttle.v1.LendingMarketProposal.metadata:type_name -> canto.govshuttle.v1.LendingMarketMetadata
+	4, // 1: canto.govshuttle.v1.TreasuryProposal.metadata:type_name -> canto.govshuttle.v1.TreasuryProposalMetadata
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -3477,19 +4671,32 @@ func file_canto_govshuttle_v1_govshuttle_proto_init() {
 				return nil
 			}
 		}
+		file_canto_govshuttle_v1_govshuttle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_canto_govshuttle_v1_govshuttle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_canto_govshuttle_v1_govshuttle_proto_goTypes,
 		DependencyIndexes: file_canto_govshuttle_v1_govshuttle_proto_depIdxs,
+		EnumInfos:         file_canto_govshuttle_v1_govshuttle_proto_enumTypes,
 		MessageInfos:      file_canto_govshuttle_v1_govshuttle_proto_msgTypes,
 	}.Build()
 	File_canto_govshuttle_v1_govshuttle_proto = out.File
@@ -1000,11 +1000,13 @@ func (x *fastReflection_MsgLendingMarketProposalResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_MsgTreasuryProposal             protoreflect.MessageDescriptor
-	fd_MsgTreasuryProposal_authority   protoreflect.FieldDescriptor
-	fd_MsgTreasuryProposal_title       protoreflect.FieldDescriptor
-	fd_MsgTreasuryProposal_description protoreflect.FieldDescriptor
-	fd_MsgTreasuryProposal_metadata    protoreflect.FieldDescriptor
+	md_MsgTreasuryProposal                  protoreflect.MessageDescriptor
+	fd_MsgTreasuryProposal_authority        protoreflect.FieldDescriptor
+	fd_MsgTreasuryProposal_title            protoreflect.FieldDescriptor
+	fd_MsgTreasuryProposal_description      protoreflect.FieldDescriptor
+	fd_MsgTreasuryProposal_metadata         protoreflect.FieldDescriptor
+	fd_MsgTreasuryProposal_execution_mode   protoreflect.FieldDescriptor
+	fd_MsgTreasuryProposal_convert_to_erc20 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1014,6 +1016,8 @@ func init() {
 	fd_MsgTreasuryProposal_title = md_MsgTreasuryProposal.Fields().ByName("title")
 	fd_MsgTreasuryProposal_description = md_MsgTreasuryProposal.Fields().ByName("description")
 	fd_MsgTreasuryProposal_metadata = md_MsgTreasuryProposal.Fields().ByName("metadata")
+	fd_MsgTreasuryProposal_execution_mode = md_MsgTreasuryProposal.Fields().ByName("execution_mode")
+	fd_MsgTreasuryProposal_convert_to_erc20 = md_MsgTreasuryProposal.Fields().ByName("convert_to_erc20")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgTreasuryProposal)(nil)
@@ -1105,6 +1109,18 @@ func (x *fastReflection_MsgTreasuryProposal) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.ExecutionMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExecutionMode))
+		if !f(fd_MsgTreasuryProposal_execution_mode, value) {
+			return
+		}
+	}
+	if x.ConvertToErc20 != false {
+		value := protoreflect.ValueOfBool(x.ConvertToErc20)
+		if !f(fd_MsgTreasuryProposal_convert_to_erc20, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1128,6 +1144,10 @@ func (x *fastReflection_MsgTreasuryProposal) Has(fd protoreflect.FieldDescriptor
 		return x.Description != ""
 	case "canto.govshuttle.v1.MsgTreasuryProposal.metadata":
 		return x.Metadata != nil
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		return x.ExecutionMode != 0
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		return x.ConvertToErc20 != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1152,6 +1172,10 @@ func (x *fastReflection_MsgTreasuryProposal) Clear(fd protoreflect.FieldDescript
 		x.Description = ""
 	case "canto.govshuttle.v1.MsgTreasuryProposal.metadata":
 		x.Metadata = nil
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		x.ExecutionMode = 0
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		x.ConvertToErc20 = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1180,6 +1204,12 @@ func (x *fastReflection_MsgTreasuryProposal) Get(descriptor protoreflect.FieldDe
 	case "canto.govshuttle.v1.MsgTreasuryProposal.metadata":
 		value := x.Metadata
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		value := x.ExecutionMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		value := x.ConvertToErc20
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1208,6 +1238,10 @@ func (x *fastReflection_MsgTreasuryProposal) Set(fd protoreflect.FieldDescriptor
 		x.Description = value.Interface().(string)
 	case "canto.govshuttle.v1.MsgTreasuryProposal.metadata":
 		x.Metadata = value.Message().Interface().(*TreasuryProposalMetadata)
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		x.ExecutionMode = (TreasuryExecutionMode)(value.Enum())
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		x.ConvertToErc20 = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1239,6 +1273,10 @@ func (x *fastReflection_MsgTreasuryProposal) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field title of message canto.govshuttle.v1.MsgTreasuryProposal is not mutable"))
 	case "canto.govshuttle.v1.MsgTreasuryProposal.description":
 		panic(fmt.Errorf("field description of message canto.govshuttle.v1.MsgTreasuryProposal is not mutable"))
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		panic(fmt.Errorf("field execution_mode of message canto.govshuttle.v1.MsgTreasuryProposal is not mutable"))
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		panic(fmt.Errorf("field convert_to_erc20 of message canto.govshuttle.v1.MsgTreasuryProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1261,6 +1299,10 @@ func (x *fastReflection_MsgTreasuryProposal) NewField(fd protoreflect.FieldDescr
 	case "canto.govshuttle.v1.MsgTreasuryProposal.metadata":
 		m := new(TreasuryProposalMetadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "canto.govshuttle.v1.MsgTreasuryProposal.execution_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "canto.govshuttle.v1.MsgTreasuryProposal.convert_to_erc20":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.govshuttle.v1.MsgTreasuryProposal"))
@@ -1346,6 +1388,12 @@ func (x *fastReflection_MsgTreasuryProposal) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Metadata)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExecutionMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionMode))
+		}
+		if x.ConvertToErc20 {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1375,6 +1423,21 @@ func (x *fastReflection_MsgTreasuryProposal) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ConvertToErc20 {
+			i--
+			if x.ConvertToErc20 {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExecutionMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionMode))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Metadata != nil {
 			encoded, err := options.Marshal(x.Metadata)
 			if err != nil {
@@ -1591,6 +1654,45 @@ func (x *fastReflection_MsgTreasuryProposal) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+				}
+				x.ExecutionMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionMode |= TreasuryExecutionMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConvertToErc20", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ConvertToErc20 = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2093,6 +2195,12 @@ type MsgTreasuryProposal struct {
 	Title       string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Metadata    *TreasuryProposalMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // NOTE: All parameters must be supplied.
+	// execution_mode defines how the proposal is executed once passed
+	ExecutionMode TreasuryExecutionMode `protobuf:"varint,5,opt,name=execution_mode,json=executionMode,proto3,enum=canto.govshuttle.v1.TreasuryExecutionMode" json:"execution_mode,omitempty"`
+	// convert_to_erc20 converts the spent coins to their ERC20 token
+	// representation via x/erc20. Only valid in community pool execution mode
+	// with a hex recipient address.
+	ConvertToErc20 bool `protobuf:"varint,6,opt,name=convert_to_erc20,json=convertToErc20,proto3" json:"convert_to_erc20,omitempty"`
 }
 
 func (x *MsgTreasuryProposal) Reset() {
@@ -2143,6 +2251,20 @@ func (x *MsgTreasuryProposal) GetMetadata() *TreasuryProposalMetadata {
 	return nil
 }
 
+func (x *MsgTreasuryProposal) GetExecutionMode() TreasuryExecutionMode {
+	if x != nil {
+		return x.ExecutionMode
+	}
+	return TreasuryExecutionMode_TREASURY_EXECUTION_MODE_PORT
+}
+
+func (x *MsgTreasuryProposal) GetConvertToErc20() bool {
+	if x != nil {
+		return x.ConvertToErc20
+	}
+	return false
+}
+
 type MsgTreasuryProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2202,7 +2324,7 @@ var file_canto_govshuttle_v1_tx_proto_rawDesc = []byte{
 	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
 	0x61, 0x6c, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
 	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x54, 0x72,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x54, 0x72,
 	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x36,
 	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
@@ -2215,41 +2337,49 @@ var file_canto_govshuttle_v1_tx_proto_rawDesc = []byte{
 	0x32, 0x2d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74,
 	0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x30, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x19, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d,
-	0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfb, 0x01, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x12, 0x7d, 0x0a, 0x15, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x63, 0x61,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x0e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75,
+	0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54,
+	0x6f, 0x45, 0x72, 0x63, 0x32, 0x30, 0x3a, 0x30, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x63,
+	0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x54,
+	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfb, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x7d, 0x0a, 0x15, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f,
+	0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x35, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
+	0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x10, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x28, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68,
+	0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x30, 0x2e, 0x63,
+	0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
+	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61,
 	0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x35, 0x2e, 0x63, 0x61, 0x6e,
-	0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x28, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f,
-	0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a,
-	0x30, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f,
-	0x2e, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c,
-	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76,
-	0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a,
-	0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x47, 0x6f,
+	0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x61,
+	0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1f, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x47, 0x6f, 0x76, 0x73, 0x68, 0x75,
+	0x74, 0x74, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x47, 0x6f, 0x76,
+	0x73, 0x68, 0x75, 0x74, 0x74, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2272,19 +2402,21 @@ var file_canto_govshuttle_v1_tx_proto_goTypes = []interface{}{
 	(*MsgTreasuryProposalResponse)(nil),      // 3: canto.govshuttle.v1.MsgTreasuryProposalResponse
 	(*LendingMarketMetadata)(nil),            // 4: canto.govshuttle.v1.LendingMarketMetadata
 	(*TreasuryProposalMetadata)(nil),         // 5: canto.govshuttle.v1.TreasuryProposalMetadata
+	(TreasuryExecutionMode)(0),               // 6: canto.govshuttle.v1.TreasuryExecutionMode
 }
 var file_canto_govshuttle_v1_tx_proto_depIdxs = []int32{
 	4, // 0: canto.govshuttle.v1.MsgLendingMarketProposal.metadata:type_name -> canto.govshuttle.v1.LendingMarketMetadata
 	5, // 1: canto.govshuttle.v1.MsgTreasuryProposal.metadata:type_name -> canto.govshuttle.v1.TreasuryProposalMetadata
-	0, // 2: canto.govshuttle.v1.Msg.LendingMarketProposal:input_type -> canto.govshuttle.v1.MsgLendingMarketProposal
-	2, // 3: canto.govshuttle.v1.Msg.TreasuryProposal:input_type -> canto.govshuttle.v1.MsgTreasuryProposal
-	1, // 4: canto.govshuttle.v1.Msg.LendingMarketProposal:output_type -> canto.govshuttle.v1.MsgLendingMarketProposalResponse
-	3, // 5: canto.govshuttle.v1.Msg.TreasuryProposal:output_type -> canto.govshuttle.v1.MsgTreasuryProposalResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 2: canto.govshuttle.v1.MsgTreasuryProposal.execution_mode:type_name -> canto.govshuttle.v1.TreasuryExecutionMode
+	0, // 3: canto.govshuttle.v1.Msg.LendingMarketProposal:input_type -> canto.govshuttle.v1.MsgLendingMarketProposal
+	2, // 4: canto.govshuttle.v1.Msg.TreasuryProposal:input_type -> canto.govshuttle.v1.MsgTreasuryProposal
+	1, // 5: canto.govshuttle.v1.Msg.LendingMarketProposal:output_type -> canto.govshuttle.v1.MsgLendingMarketProposalResponse
+	3, // 6: canto.govshuttle.v1.Msg.TreasuryProposal:output_type -> canto.govshuttle.v1.MsgTreasuryProposalResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_canto_govshuttle_v1_tx_proto_init() }
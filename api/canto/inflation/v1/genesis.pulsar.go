@@ -4,6 +4,7 @@ package inflationv1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,12 +16,14 @@ import (
 )
 
 var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_params            protoreflect.FieldDescriptor
-	fd_GenesisState_period            protoreflect.FieldDescriptor
-	fd_GenesisState_epoch_identifier  protoreflect.FieldDescriptor
-	fd_GenesisState_epochs_per_period protoreflect.FieldDescriptor
-	fd_GenesisState_skipped_epochs    protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_period             protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_identifier   protoreflect.FieldDescriptor
+	fd_GenesisState_epochs_per_period  protoreflect.FieldDescriptor
+	fd_GenesisState_skipped_epochs     protoreflect.FieldDescriptor
+	fd_GenesisState_max_supply_reached protoreflect.FieldDescriptor
+	fd_GenesisState_inflation_pause    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +34,8 @@ func init() {
 	fd_GenesisState_epoch_identifier = md_GenesisState.Fields().ByName("epoch_identifier")
 	fd_GenesisState_epochs_per_period = md_GenesisState.Fields().ByName("epochs_per_period")
 	fd_GenesisState_skipped_epochs = md_GenesisState.Fields().ByName("skipped_epochs")
+	fd_GenesisState_max_supply_reached = md_GenesisState.Fields().ByName("max_supply_reached")
+	fd_GenesisState_inflation_pause = md_GenesisState.Fields().ByName("inflation_pause")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -128,6 +133,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.MaxSupplyReached != false {
+		value := protoreflect.ValueOfBool(x.MaxSupplyReached)
+		if !f(fd_GenesisState_max_supply_reached, value) {
+			return
+		}
+	}
+	if x.InflationPause != nil {
+		value := protoreflect.ValueOfMessage(x.InflationPause.ProtoReflect())
+		if !f(fd_GenesisState_inflation_pause, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -153,6 +170,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.EpochsPerPeriod != int64(0)
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		return x.SkippedEpochs != uint64(0)
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		return x.MaxSupplyReached != false
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		return x.InflationPause != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -179,6 +200,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.EpochsPerPeriod = int64(0)
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = uint64(0)
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		x.MaxSupplyReached = false
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		x.InflationPause = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -210,6 +235,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		value := x.SkippedEpochs
 		return protoreflect.ValueOfUint64(value)
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		value := x.MaxSupplyReached
+		return protoreflect.ValueOfBool(value)
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		value := x.InflationPause
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -240,6 +271,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.EpochsPerPeriod = value.Int()
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = value.Uint()
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		x.MaxSupplyReached = value.Bool()
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		x.InflationPause = value.Message().Interface().(*InflationPause)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -265,6 +300,11 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		if x.InflationPause == nil {
+			x.InflationPause = new(InflationPause)
+		}
+		return protoreflect.ValueOfMessage(x.InflationPause.ProtoReflect())
 	case "canto.inflation.v1.GenesisState.period":
 		panic(fmt.Errorf("field period of message canto.inflation.v1.GenesisState is not mutable"))
 	case "canto.inflation.v1.GenesisState.epoch_identifier":
@@ -273,6 +313,8 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field epochs_per_period of message canto.inflation.v1.GenesisState is not mutable"))
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		panic(fmt.Errorf("field skipped_epochs of message canto.inflation.v1.GenesisState is not mutable"))
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		panic(fmt.Errorf("field max_supply_reached of message canto.inflation.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -297,6 +339,11 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfInt64(int64(0))
 	case "canto.inflation.v1.GenesisState.skipped_epochs":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.inflation.v1.GenesisState.max_supply_reached":
+		return protoreflect.ValueOfBool(false)
+	case "canto.inflation.v1.GenesisState.inflation_pause":
+		m := new(InflationPause)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.GenesisState"))
@@ -383,6 +430,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.SkippedEpochs != 0 {
 			n += 1 + runtime.Sov(uint64(x.SkippedEpochs))
 		}
+		if x.MaxSupplyReached {
+			n += 2
+		}
+		if x.InflationPause != nil {
+			l = options.Size(x.InflationPause)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -412,6 +466,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.InflationPause != nil {
+			encoded, err := options.Marshal(x.InflationPause)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.MaxSupplyReached {
+			i--
+			if x.MaxSupplyReached {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.SkippedEpochs != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkippedEpochs))
 			i--
@@ -622,6 +700,62 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyReached", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MaxSupplyReached = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationPause", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InflationPause == nil {
+					x.InflationPause = &InflationPause{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InflationPause); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -657,12 +791,62 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]string
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ExcludedAddresses as it is not of Message kind"))
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                         protoreflect.MessageDescriptor
-	fd_Params_mint_denom              protoreflect.FieldDescriptor
-	fd_Params_exponential_calculation protoreflect.FieldDescriptor
-	fd_Params_inflation_distribution  protoreflect.FieldDescriptor
-	fd_Params_enable_inflation        protoreflect.FieldDescriptor
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_mint_denom               protoreflect.FieldDescriptor
+	fd_Params_exponential_calculation  protoreflect.FieldDescriptor
+	fd_Params_inflation_distribution   protoreflect.FieldDescriptor
+	fd_Params_enable_inflation         protoreflect.FieldDescriptor
+	fd_Params_max_supply               protoreflect.FieldDescriptor
+	fd_Params_schedule                 protoreflect.FieldDescriptor
+	fd_Params_excluded_addresses       protoreflect.FieldDescriptor
+	fd_Params_epoch_bonding_adjustment protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -672,6 +856,10 @@ func init() {
 	fd_Params_exponential_calculation = md_Params.Fields().ByName("exponential_calculation")
 	fd_Params_inflation_distribution = md_Params.Fields().ByName("inflation_distribution")
 	fd_Params_enable_inflation = md_Params.Fields().ByName("enable_inflation")
+	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	fd_Params_schedule = md_Params.Fields().ByName("schedule")
+	fd_Params_excluded_addresses = md_Params.Fields().ByName("excluded_addresses")
+	fd_Params_epoch_bonding_adjustment = md_Params.Fields().ByName("epoch_bonding_adjustment")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -763,6 +951,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_Params_max_supply, value) {
+			return
+		}
+	}
+	if x.Schedule != nil {
+		value := protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+		if !f(fd_Params_schedule, value) {
+			return
+		}
+	}
+	if len(x.ExcludedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.ExcludedAddresses})
+		if !f(fd_Params_excluded_addresses, value) {
+			return
+		}
+	}
+	if x.EpochBondingAdjustment != nil {
+		value := protoreflect.ValueOfMessage(x.EpochBondingAdjustment.ProtoReflect())
+		if !f(fd_Params_epoch_bonding_adjustment, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -786,6 +998,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InflationDistribution != nil
 	case "canto.inflation.v1.Params.enable_inflation":
 		return x.EnableInflation != false
+	case "canto.inflation.v1.Params.max_supply":
+		return x.MaxSupply != ""
+	case "canto.inflation.v1.Params.schedule":
+		return x.Schedule != nil
+	case "canto.inflation.v1.Params.excluded_addresses":
+		return len(x.ExcludedAddresses) != 0
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		return x.EpochBondingAdjustment != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -810,6 +1030,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InflationDistribution = nil
 	case "canto.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = false
+	case "canto.inflation.v1.Params.max_supply":
+		x.MaxSupply = ""
+	case "canto.inflation.v1.Params.schedule":
+		x.Schedule = nil
+	case "canto.inflation.v1.Params.excluded_addresses":
+		x.ExcludedAddresses = nil
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		x.EpochBondingAdjustment = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -838,6 +1066,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "canto.inflation.v1.Params.enable_inflation":
 		value := x.EnableInflation
 		return protoreflect.ValueOfBool(value)
+	case "canto.inflation.v1.Params.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.Params.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "canto.inflation.v1.Params.excluded_addresses":
+		if len(x.ExcludedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.ExcludedAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		value := x.EpochBondingAdjustment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -866,6 +1109,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InflationDistribution = value.Message().Interface().(*InflationDistribution)
 	case "canto.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = value.Bool()
+	case "canto.inflation.v1.Params.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	case "canto.inflation.v1.Params.schedule":
+		x.Schedule = value.Message().Interface().(*InflationSchedule)
+	case "canto.inflation.v1.Params.excluded_addresses":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.ExcludedAddresses = *clv.list
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		x.EpochBondingAdjustment = value.Message().Interface().(*EpochBondingAdjustment)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -896,10 +1149,28 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.InflationDistribution = new(InflationDistribution)
 		}
 		return protoreflect.ValueOfMessage(x.InflationDistribution.ProtoReflect())
+	case "canto.inflation.v1.Params.schedule":
+		if x.Schedule == nil {
+			x.Schedule = new(InflationSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+	case "canto.inflation.v1.Params.excluded_addresses":
+		if x.ExcludedAddresses == nil {
+			x.ExcludedAddresses = []string{}
+		}
+		value := &_Params_7_list{list: &x.ExcludedAddresses}
+		return protoreflect.ValueOfList(value)
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		if x.EpochBondingAdjustment == nil {
+			x.EpochBondingAdjustment = new(EpochBondingAdjustment)
+		}
+		return protoreflect.ValueOfMessage(x.EpochBondingAdjustment.ProtoReflect())
 	case "canto.inflation.v1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message canto.inflation.v1.Params is not mutable"))
 	case "canto.inflation.v1.Params.enable_inflation":
 		panic(fmt.Errorf("field enable_inflation of message canto.inflation.v1.Params is not mutable"))
+	case "canto.inflation.v1.Params.max_supply":
+		panic(fmt.Errorf("field max_supply of message canto.inflation.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -923,6 +1194,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "canto.inflation.v1.Params.enable_inflation":
 		return protoreflect.ValueOfBool(false)
+	case "canto.inflation.v1.Params.max_supply":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.Params.schedule":
+		m := new(InflationSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "canto.inflation.v1.Params.excluded_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "canto.inflation.v1.Params.epoch_bonding_adjustment":
+		m := new(EpochBondingAdjustment)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.Params"))
@@ -1007,6 +1289,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableInflation {
 			n += 2
 		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Schedule != nil {
+			l = options.Size(x.Schedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExcludedAddresses) > 0 {
+			for _, s := range x.ExcludedAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EpochBondingAdjustment != nil {
+			l = options.Size(x.EpochBondingAdjustment)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1036,6 +1336,50 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EpochBondingAdjustment != nil {
+			encoded, err := options.Marshal(x.EpochBondingAdjustment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.ExcludedAddresses) > 0 {
+			for iNdEx := len(x.ExcludedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExcludedAddresses[iNdEx])
+				copy(dAtA[i:], x.ExcludedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.Schedule != nil {
+			encoded, err := options.Marshal(x.Schedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.EnableInflation {
 			i--
 			if x.EnableInflation {
@@ -1254,6 +1598,142 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableInflation = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Schedule == nil {
+					x.Schedule = &InflationSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedAddresses = append(x.ExcludedAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochBondingAdjustment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EpochBondingAdjustment == nil {
+					x.EpochBondingAdjustment = &EpochBondingAdjustment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochBondingAdjustment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1318,6 +1798,10 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// true once minting stopped because the supply reached max_supply
+	MaxSupplyReached bool `protobuf:"varint,6,opt,name=max_supply_reached,json=maxSupplyReached,proto3" json:"max_supply_reached,omitempty"`
+	// latest pause and resume of the inflation
+	InflationPause *InflationPause `protobuf:"bytes,7,opt,name=inflation_pause,json=inflationPause,proto3" json:"inflation_pause,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1375,6 +1859,20 @@ func (x *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetMaxSupplyReached() bool {
+	if x != nil {
+		return x.MaxSupplyReached
+	}
+	return false
+}
+
+func (x *GenesisState) GetInflationPause() *InflationPause {
+	if x != nil {
+		return x.InflationPause
+	}
+	return nil
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1384,11 +1882,24 @@ type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// variables to calculate exponential inflation
+	// Deprecated: the exponential calculation is set through the schedule.
+	//
+	// Deprecated: Do not use.
 	ExponentialCalculation *ExponentialCalculation `protobuf:"bytes,2,opt,name=exponential_calculation,json=exponentialCalculation,proto3" json:"exponential_calculation,omitempty"`
 	// inflation distribution of the minted denom
 	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
 	// parameter to enable inflation and halt increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// maximum bank supply of the mint denom. Minting stops permanently once the
+	// supply reaches it. A zero value disables the cap.
+	MaxSupply string `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// schedule that calculates the annual provision of each period
+	Schedule *InflationSchedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// addresses whose mint denom balances are excluded from the circulating
+	// supply, in addition to module accounts and locked vesting coins
+	ExcludedAddresses []string `protobuf:"bytes,7,rep,name=excluded_addresses,json=excludedAddresses,proto3" json:"excluded_addresses,omitempty"`
+	// recalculation of the bonding incentive on every epoch
+	EpochBondingAdjustment *EpochBondingAdjustment `protobuf:"bytes,8,opt,name=epoch_bonding_adjustment,json=epochBondingAdjustment,proto3" json:"epoch_bonding_adjustment,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1418,6 +1929,7 @@ func (x *Params) GetMintDenom() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *Params) GetExponentialCalculation() *ExponentialCalculation {
 	if x != nil {
 		return x.ExponentialCalculation
@@ -1439,6 +1951,34 @@ func (x *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (x *Params) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Params) GetSchedule() *InflationSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Params) GetExcludedAddresses() []string {
+	if x != nil {
+		return x.ExcludedAddresses
+	}
+	return nil
+}
+
+func (x *Params) GetEpochBondingAdjustment() *EpochBondingAdjustment {
+	if x != nil {
+		return x.EpochBondingAdjustment
+	}
+	return nil
+}
+
 var File_canto_inflation_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_canto_inflation_v1_genesis_proto_rawDesc = []byte{
@@ -1448,57 +1988,87 @@ var file_canto_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x22, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x6e,
-	0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61,
-	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b,
-	0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x61, 0x6e,
-	0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf,
+	0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50,
+	0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x51, 0x0a,
+	0x0f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x22, 0xa0, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x67, 0x0a, 0x17, 0x65, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61,
 	0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x43, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43,
-	0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x18, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x6f, 0x0a, 0x18, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x42, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x61, 0x6e, 0x74, 0x6f,
+	0x2f, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0xc1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74,
+	0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x61, 0x6e,
+	0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1517,18 +2087,24 @@ var file_canto_inflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInf
 var file_canto_inflation_v1_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),           // 0: canto.inflation.v1.GenesisState
 	(*Params)(nil),                 // 1: canto.inflation.v1.Params
-	(*ExponentialCalculation)(nil), // 2: canto.inflation.v1.ExponentialCalculation
-	(*InflationDistribution)(nil),  // 3: canto.inflation.v1.InflationDistribution
+	(*InflationPause)(nil),         // 2: canto.inflation.v1.InflationPause
+	(*ExponentialCalculation)(nil), // 3: canto.inflation.v1.ExponentialCalculation
+	(*InflationDistribution)(nil),  // 4: canto.inflation.v1.InflationDistribution
+	(*InflationSchedule)(nil),      // 5: canto.inflation.v1.InflationSchedule
+	(*EpochBondingAdjustment)(nil), // 6: canto.inflation.v1.EpochBondingAdjustment
 }
 var file_canto_inflation_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: canto.inflation.v1.GenesisState.params:type_name -> canto.inflation.v1.Params
-	2, // 1: canto.inflation.v1.Params.exponential_calculation:type_name -> canto.inflation.v1.ExponentialCalculation
-	3, // 2: canto.inflation.v1.Params.inflation_distribution:type_name -> canto.inflation.v1.InflationDistribution
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 1: canto.inflation.v1.GenesisState.inflation_pause:type_name -> canto.inflation.v1.InflationPause
+	3, // 2: canto.inflation.v1.Params.exponential_calculation:type_name -> canto.inflation.v1.ExponentialCalculation
+	4, // 3: canto.inflation.v1.Params.inflation_distribution:type_name -> canto.inflation.v1.InflationDistribution
+	5, // 4: canto.inflation.v1.Params.schedule:type_name -> canto.inflation.v1.InflationSchedule
+	6, // 5: canto.inflation.v1.Params.epoch_bonding_adjustment:type_name -> canto.inflation.v1.EpochBondingAdjustment
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_canto_inflation_v1_genesis_proto_init() }
@@ -16,27 +16,29 @@ import (
 )
 
 var (
-	md_InflationDistribution                 protoreflect.MessageDescriptor
-	fd_InflationDistribution_staking_rewards protoreflect.FieldDescriptor
-	fd_InflationDistribution_community_pool  protoreflect.FieldDescriptor
+	md_InflationRecipient                protoreflect.MessageDescriptor
+	fd_InflationRecipient_recipient_type protoreflect.FieldDescriptor
+	fd_InflationRecipient_address        protoreflect.FieldDescriptor
+	fd_InflationRecipient_weight         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_canto_inflation_v1_inflation_proto_init()
-	md_InflationDistribution = File_canto_inflation_v1_inflation_proto.Messages().ByName("InflationDistribution")
-	fd_InflationDistribution_staking_rewards = md_InflationDistribution.Fields().ByName("staking_rewards")
-	fd_InflationDistribution_community_pool = md_InflationDistribution.Fields().ByName("community_pool")
+	md_InflationRecipient = File_canto_inflation_v1_inflation_proto.Messages().ByName("InflationRecipient")
+	fd_InflationRecipient_recipient_type = md_InflationRecipient.Fields().ByName("recipient_type")
+	fd_InflationRecipient_address = md_InflationRecipient.Fields().ByName("address")
+	fd_InflationRecipient_weight = md_InflationRecipient.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_InflationDistribution)(nil)
+var _ protoreflect.Message = (*fastReflection_InflationRecipient)(nil)
 
-type fastReflection_InflationDistribution InflationDistribution
+type fastReflection_InflationRecipient InflationRecipient
 
-func (x *InflationDistribution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_InflationDistribution)(x)
+func (x *InflationRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(x)
 }
 
-func (x *InflationDistribution) slowProtoReflect() protoreflect.Message {
+func (x *InflationRecipient) slowProtoReflect() protoreflect.Message {
 	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -48,43 +50,43 @@ func (x *InflationDistribution) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_InflationDistribution_messageType fastReflection_InflationDistribution_messageType
-var _ protoreflect.MessageType = fastReflection_InflationDistribution_messageType{}
+var _fastReflection_InflationRecipient_messageType fastReflection_InflationRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_InflationRecipient_messageType{}
 
-type fastReflection_InflationDistribution_messageType struct{}
+type fastReflection_InflationRecipient_messageType struct{}
 
-func (x fastReflection_InflationDistribution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_InflationDistribution)(nil)
+func (x fastReflection_InflationRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(nil)
 }
-func (x fastReflection_InflationDistribution_messageType) New() protoreflect.Message {
-	return new(fastReflection_InflationDistribution)
+func (x fastReflection_InflationRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
 }
-func (x fastReflection_InflationDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_InflationDistribution
+func (x fastReflection_InflationRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_InflationDistribution) Descriptor() protoreflect.MessageDescriptor {
-	return md_InflationDistribution
+func (x *fastReflection_InflationRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_InflationDistribution) Type() protoreflect.MessageType {
-	return _fastReflection_InflationDistribution_messageType
+func (x *fastReflection_InflationRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_InflationRecipient_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_InflationDistribution) New() protoreflect.Message {
-	return new(fastReflection_InflationDistribution)
+func (x *fastReflection_InflationRecipient) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_InflationDistribution) Interface() protoreflect.ProtoMessage {
-	return (*InflationDistribution)(x)
+func (x *fastReflection_InflationRecipient) Interface() protoreflect.ProtoMessage {
+	return (*InflationRecipient)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -92,16 +94,22 @@ func (x *fastReflection_InflationDistribution) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.StakingRewards != "" {
-		value := protoreflect.ValueOfString(x.StakingRewards)
-		if !f(fd_InflationDistribution_staking_rewards, value) {
+func (x *fastReflection_InflationRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecipientType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RecipientType))
+		if !f(fd_InflationRecipient_recipient_type, value) {
 			return
 		}
 	}
-	if x.CommunityPool != "" {
-		value := protoreflect.ValueOfString(x.CommunityPool)
-		if !f(fd_InflationDistribution_community_pool, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_InflationRecipient_address, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_InflationRecipient_weight, value) {
 			return
 		}
 	}
@@ -118,17 +126,19 @@ func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InflationRecipient) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
-		return x.StakingRewards != ""
-	case "canto.inflation.v1.InflationDistribution.community_pool":
-		return x.CommunityPool != ""
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		return x.RecipientType != 0
+	case "canto.inflation.v1.InflationRecipient.address":
+		return x.Address != ""
+	case "canto.inflation.v1.InflationRecipient.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -138,17 +148,19 @@ func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InflationRecipient) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
-		x.StakingRewards = ""
-	case "canto.inflation.v1.InflationDistribution.community_pool":
-		x.CommunityPool = ""
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		x.RecipientType = 0
+	case "canto.inflation.v1.InflationRecipient.address":
+		x.Address = ""
+	case "canto.inflation.v1.InflationRecipient.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -158,19 +170,22 @@ func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
-		value := x.StakingRewards
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		value := x.RecipientType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "canto.inflation.v1.InflationRecipient.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "canto.inflation.v1.InflationDistribution.community_pool":
-		value := x.CommunityPool
+	case "canto.inflation.v1.InflationRecipient.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -184,17 +199,19 @@ func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InflationRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
-		x.StakingRewards = value.Interface().(string)
-	case "canto.inflation.v1.InflationDistribution.community_pool":
-		x.CommunityPool = value.Interface().(string)
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		x.RecipientType = (RecipientType)(value.Enum())
+	case "canto.inflation.v1.InflationRecipient.address":
+		x.Address = value.Interface().(string)
+	case "canto.inflation.v1.InflationRecipient.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -208,44 +225,48 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InflationDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
-		panic(fmt.Errorf("field staking_rewards of message canto.inflation.v1.InflationDistribution is not mutable"))
-	case "canto.inflation.v1.InflationDistribution.community_pool":
-		panic(fmt.Errorf("field community_pool of message canto.inflation.v1.InflationDistribution is not mutable"))
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		panic(fmt.Errorf("field recipient_type of message canto.inflation.v1.InflationRecipient is not mutable"))
+	case "canto.inflation.v1.InflationRecipient.address":
+		panic(fmt.Errorf("field address of message canto.inflation.v1.InflationRecipient is not mutable"))
+	case "canto.inflation.v1.InflationRecipient.weight":
+		panic(fmt.Errorf("field weight of message canto.inflation.v1.InflationRecipient is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_InflationDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+	case "canto.inflation.v1.InflationRecipient.recipient_type":
+		return protoreflect.ValueOfEnum(0)
+	case "canto.inflation.v1.InflationRecipient.address":
 		return protoreflect.ValueOfString("")
-	case "canto.inflation.v1.InflationDistribution.community_pool":
+	case "canto.inflation.v1.InflationRecipient.weight":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationRecipient"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_InflationDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InflationRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.InflationDistribution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.InflationRecipient", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -253,7 +274,7 @@ func (x *fastReflection_InflationDistribution) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_InflationDistribution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InflationRecipient) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -264,7 +285,7 @@ func (x *fastReflection_InflationDistribution) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InflationDistribution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InflationRecipient) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -276,7 +297,7 @@ func (x *fastReflection_InflationDistribution) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_InflationDistribution) IsValid() bool {
+func (x *fastReflection_InflationRecipient) IsValid() bool {
 	return x != nil
 }
 
@@ -286,9 +307,9 @@ func (x *fastReflection_InflationDistribution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InflationRecipient) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*InflationDistribution)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -300,11 +321,14 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.StakingRewards)
+		if x.RecipientType != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecipientType))
+		}
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CommunityPool)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -318,7 +342,7 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*InflationDistribution)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -337,19 +361,24 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CommunityPool) > 0 {
-			i -= len(x.CommunityPool)
-			copy(dAtA[i:], x.CommunityPool)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPool)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.StakingRewards) > 0 {
-			i -= len(x.StakingRewards)
-			copy(dAtA[i:], x.StakingRewards)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingRewards)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.RecipientType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecipientType))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -362,7 +391,7 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*InflationDistribution)
+		x := input.Message.Interface().(*InflationRecipient)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -394,15 +423,34 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+				}
+				x.RecipientType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecipientType |= RecipientType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -430,11 +478,11 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakingRewards = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -462,7 +510,7 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CommunityPool = string(dAtA[iNdEx:postIndex])
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -499,34 +547,81 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_InflationDistribution_4_list)(nil)
+
+type _InflationDistribution_4_list struct {
+	list *[]*InflationRecipient
+}
+
+func (x *_InflationDistribution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InflationDistribution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InflationDistribution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InflationDistribution_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InflationDistribution_4_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ExponentialCalculation                protoreflect.MessageDescriptor
-	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+	md_InflationDistribution                 protoreflect.MessageDescriptor
+	fd_InflationDistribution_staking_rewards protoreflect.FieldDescriptor
+	fd_InflationDistribution_community_pool  protoreflect.FieldDescriptor
+	fd_InflationDistribution_recipients      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_canto_inflation_v1_inflation_proto_init()
-	md_ExponentialCalculation = File_canto_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
-	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
-	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
-	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
-	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
-	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+	md_InflationDistribution = File_canto_inflation_v1_inflation_proto.Messages().ByName("InflationDistribution")
+	fd_InflationDistribution_staking_rewards = md_InflationDistribution.Fields().ByName("staking_rewards")
+	fd_InflationDistribution_community_pool = md_InflationDistribution.Fields().ByName("community_pool")
+	fd_InflationDistribution_recipients = md_InflationDistribution.Fields().ByName("recipients")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+var _ protoreflect.Message = (*fastReflection_InflationDistribution)(nil)
 
-type fastReflection_ExponentialCalculation ExponentialCalculation
+type fastReflection_InflationDistribution InflationDistribution
 
-func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(x)
+func (x *InflationDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationDistribution)(x)
 }
 
-func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+func (x *InflationDistribution) slowProtoReflect() protoreflect.Message {
 	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,43 +633,43 @@ func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
-var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+var _fastReflection_InflationDistribution_messageType fastReflection_InflationDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_InflationDistribution_messageType{}
 
-type fastReflection_ExponentialCalculation_messageType struct{}
+type fastReflection_InflationDistribution_messageType struct{}
 
-func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(nil)
+func (x fastReflection_InflationDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationDistribution)(nil)
 }
-func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x fastReflection_InflationDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationDistribution)
 }
-func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x fastReflection_InflationDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationDistribution
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x *fastReflection_InflationDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationDistribution
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
-	return _fastReflection_ExponentialCalculation_messageType
+func (x *fastReflection_InflationDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_InflationDistribution_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x *fastReflection_InflationDistribution) New() protoreflect.Message {
+	return new(fastReflection_InflationDistribution)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
-	return (*ExponentialCalculation)(x)
+func (x *fastReflection_InflationDistribution) Interface() protoreflect.ProtoMessage {
+	return (*InflationDistribution)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -582,34 +677,22 @@ func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.A != "" {
-		value := protoreflect.ValueOfString(x.A)
-		if !f(fd_ExponentialCalculation_a, value) {
-			return
-		}
-	}
-	if x.R != "" {
-		value := protoreflect.ValueOfString(x.R)
-		if !f(fd_ExponentialCalculation_r, value) {
-			return
-		}
-	}
-	if x.C != "" {
-		value := protoreflect.ValueOfString(x.C)
-		if !f(fd_ExponentialCalculation_c, value) {
+func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StakingRewards != "" {
+		value := protoreflect.ValueOfString(x.StakingRewards)
+		if !f(fd_InflationDistribution_staking_rewards, value) {
 			return
 		}
 	}
-	if x.BondingTarget != "" {
-		value := protoreflect.ValueOfString(x.BondingTarget)
-		if !f(fd_ExponentialCalculation_bonding_target, value) {
+	if x.CommunityPool != "" {
+		value := protoreflect.ValueOfString(x.CommunityPool)
+		if !f(fd_InflationDistribution_community_pool, value) {
 			return
 		}
 	}
-	if x.MaxVariance != "" {
-		value := protoreflect.ValueOfString(x.MaxVariance)
-		if !f(fd_ExponentialCalculation_max_variance, value) {
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &x.Recipients})
+		if !f(fd_InflationDistribution_recipients, value) {
 			return
 		}
 	}
@@ -626,23 +709,19 @@ func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		return x.A != ""
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		return x.R != ""
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		return x.C != ""
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
-		return x.BondingTarget != ""
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
-		return x.MaxVariance != ""
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+		return x.StakingRewards != ""
+	case "canto.inflation.v1.InflationDistribution.community_pool":
+		return x.CommunityPool != ""
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		return len(x.Recipients) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -652,23 +731,19 @@ func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		x.A = ""
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		x.R = ""
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		x.C = ""
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = ""
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = ""
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+		x.StakingRewards = ""
+	case "canto.inflation.v1.InflationDistribution.community_pool":
+		x.CommunityPool = ""
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		x.Recipients = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -678,28 +753,25 @@ func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		value := x.A
-		return protoreflect.ValueOfString(value)
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		value := x.R
-		return protoreflect.ValueOfString(value)
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		value := x.C
-		return protoreflect.ValueOfString(value)
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
-		value := x.BondingTarget
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+		value := x.StakingRewards
 		return protoreflect.ValueOfString(value)
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
-		value := x.MaxVariance
+	case "canto.inflation.v1.InflationDistribution.community_pool":
+		value := x.CommunityPool
 		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_InflationDistribution_4_list{})
+		}
+		listValue := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -713,23 +785,21 @@ func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		x.A = value.Interface().(string)
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		x.R = value.Interface().(string)
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		x.C = value.Interface().(string)
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = value.Interface().(string)
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = value.Interface().(string)
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+		x.StakingRewards = value.Interface().(string)
+	case "canto.inflation.v1.InflationDistribution.community_pool":
+		x.CommunityPool = value.Interface().(string)
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		lv := value.List()
+		clv := lv.(*_InflationDistribution_4_list)
+		x.Recipients = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -743,56 +813,53 @@ func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		panic(fmt.Errorf("field a of message canto.inflation.v1.ExponentialCalculation is not mutable"))
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		panic(fmt.Errorf("field r of message canto.inflation.v1.ExponentialCalculation is not mutable"))
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		panic(fmt.Errorf("field c of message canto.inflation.v1.ExponentialCalculation is not mutable"))
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
-		panic(fmt.Errorf("field bonding_target of message canto.inflation.v1.ExponentialCalculation is not mutable"))
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
-		panic(fmt.Errorf("field max_variance of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []*InflationRecipient{}
+		}
+		value := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
+		panic(fmt.Errorf("field staking_rewards of message canto.inflation.v1.InflationDistribution is not mutable"))
+	case "canto.inflation.v1.InflationDistribution.community_pool":
+		panic(fmt.Errorf("field community_pool of message canto.inflation.v1.InflationDistribution is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InflationDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "canto.inflation.v1.ExponentialCalculation.a":
-		return protoreflect.ValueOfString("")
-	case "canto.inflation.v1.ExponentialCalculation.r":
-		return protoreflect.ValueOfString("")
-	case "canto.inflation.v1.ExponentialCalculation.c":
-		return protoreflect.ValueOfString("")
-	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+	case "canto.inflation.v1.InflationDistribution.staking_rewards":
 		return protoreflect.ValueOfString("")
-	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+	case "canto.inflation.v1.InflationDistribution.community_pool":
 		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.InflationDistribution.recipients":
+		list := []*InflationRecipient{}
+		return protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationDistribution"))
 		}
-		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message canto.inflation.v1.InflationDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InflationDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.ExponentialCalculation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.InflationDistribution", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -800,7 +867,7 @@ func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InflationDistribution) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -811,7 +878,7 @@ func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InflationDistribution) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -823,7 +890,7 @@ func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+func (x *fastReflection_InflationDistribution) IsValid() bool {
 	return x != nil
 }
 
@@ -833,9 +900,9 @@ func (x *fastReflection_ExponentialCalculation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationDistribution)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -847,25 +914,19 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.A)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.R)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.C)
+		l = len(x.StakingRewards)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BondingTarget)
+		l = len(x.CommunityPool)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxVariance)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Recipients) > 0 {
+			for _, e := range x.Recipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -877,7 +938,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationDistribution)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -896,38 +957,33 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxVariance) > 0 {
-			i -= len(x.MaxVariance)
-			copy(dAtA[i:], x.MaxVariance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.BondingTarget) > 0 {
-			i -= len(x.BondingTarget)
-			copy(dAtA[i:], x.BondingTarget)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Recipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.C) > 0 {
-			i -= len(x.C)
-			copy(dAtA[i:], x.C)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
+		if len(x.CommunityPool) > 0 {
+			i -= len(x.CommunityPool)
+			copy(dAtA[i:], x.CommunityPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPool)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.R) > 0 {
-			i -= len(x.R)
-			copy(dAtA[i:], x.R)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.A) > 0 {
-			i -= len(x.A)
-			copy(dAtA[i:], x.A)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+		if len(x.StakingRewards) > 0 {
+			i -= len(x.StakingRewards)
+			copy(dAtA[i:], x.StakingRewards)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingRewards)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -942,7 +998,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*InflationDistribution)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -974,47 +1030,15 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.A = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1042,11 +1066,11 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.R = string(dAtA[iNdEx:postIndex])
+				x.StakingRewards = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1074,13 +1098,13 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.C = string(dAtA[iNdEx:postIndex])
+				x.CommunityPool = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1090,55 +1114,25 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondingTarget = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Recipients = append(x.Recipients, &InflationRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recipients[len(x.Recipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.MaxVariance = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1175,159 +1169,4535 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var (
+	md_ExponentialCalculation                protoreflect.MessageDescriptor
+	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_ExponentialCalculation = File_canto_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
+	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
+	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
+	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
+	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
+	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+
+type fastReflection_ExponentialCalculation ExponentialCalculation
+
+func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(x)
+}
+
+func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+
+type fastReflection_ExponentialCalculation_messageType struct{}
+
+func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(nil)
+}
+func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_ExponentialCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
+	return (*ExponentialCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != "" {
+		value := protoreflect.ValueOfString(x.A)
+		if !f(fd_ExponentialCalculation_a, value) {
+			return
+		}
+	}
+	if x.R != "" {
+		value := protoreflect.ValueOfString(x.R)
+		if !f(fd_ExponentialCalculation_r, value) {
+			return
+		}
+	}
+	if x.C != "" {
+		value := protoreflect.ValueOfString(x.C)
+		if !f(fd_ExponentialCalculation_c, value) {
+			return
+		}
+	}
+	if x.BondingTarget != "" {
+		value := protoreflect.ValueOfString(x.BondingTarget)
+		if !f(fd_ExponentialCalculation_bonding_target, value) {
+			return
+		}
+	}
+	if x.MaxVariance != "" {
+		value := protoreflect.ValueOfString(x.MaxVariance)
+		if !f(fd_ExponentialCalculation_max_variance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		return x.A != ""
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		return x.R != ""
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		return x.C != ""
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		return x.BondingTarget != ""
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		return x.MaxVariance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		x.A = ""
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		x.R = ""
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		x.C = ""
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = ""
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		value := x.A
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		value := x.R
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		value := x.C
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		value := x.BondingTarget
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		value := x.MaxVariance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		x.A = value.Interface().(string)
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		x.R = value.Interface().(string)
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		x.C = value.Interface().(string)
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = value.Interface().(string)
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		panic(fmt.Errorf("field a of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		panic(fmt.Errorf("field r of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		panic(fmt.Errorf("field c of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		panic(fmt.Errorf("field bonding_target of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		panic(fmt.Errorf("field max_variance of message canto.inflation.v1.ExponentialCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ExponentialCalculation.a":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.ExponentialCalculation.r":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.ExponentialCalculation.c":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.ExponentialCalculation.bonding_target":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.ExponentialCalculation.max_variance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.ExponentialCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.A)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.R)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.C)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondingTarget)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxVariance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxVariance) > 0 {
+			i -= len(x.MaxVariance)
+			copy(dAtA[i:], x.MaxVariance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BondingTarget) > 0 {
+			i -= len(x.BondingTarget)
+			copy(dAtA[i:], x.BondingTarget)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.C) > 0 {
+			i -= len(x.C)
+			copy(dAtA[i:], x.C)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.R) > 0 {
+			i -= len(x.R)
+			copy(dAtA[i:], x.R)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.A) > 0 {
+			i -= len(x.A)
+			copy(dAtA[i:], x.A)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.A = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.R = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.C = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondingTarget = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxVariance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationSchedule             protoreflect.MessageDescriptor
+	fd_InflationSchedule_exponential protoreflect.FieldDescriptor
+	fd_InflationSchedule_step        protoreflect.FieldDescriptor
+	fd_InflationSchedule_linear      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_InflationSchedule = File_canto_inflation_v1_inflation_proto.Messages().ByName("InflationSchedule")
+	fd_InflationSchedule_exponential = md_InflationSchedule.Fields().ByName("exponential")
+	fd_InflationSchedule_step = md_InflationSchedule.Fields().ByName("step")
+	fd_InflationSchedule_linear = md_InflationSchedule.Fields().ByName("linear")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationSchedule)(nil)
+
+type fastReflection_InflationSchedule InflationSchedule
+
+func (x *InflationSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationSchedule)(x)
+}
+
+func (x *InflationSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationSchedule_messageType fastReflection_InflationSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_InflationSchedule_messageType{}
+
+type fastReflection_InflationSchedule_messageType struct{}
+
+func (x fastReflection_InflationSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationSchedule)(nil)
+}
+func (x fastReflection_InflationSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationSchedule)
+}
+func (x fastReflection_InflationSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_InflationSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationSchedule) New() protoreflect.Message {
+	return new(fastReflection_InflationSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationSchedule) Interface() protoreflect.ProtoMessage {
+	return (*InflationSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Schedule != nil {
+		switch o := x.Schedule.(type) {
+		case *InflationSchedule_Exponential:
+			v := o.Exponential
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_InflationSchedule_exponential, value) {
+				return
+			}
+		case *InflationSchedule_Step:
+			v := o.Step
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_InflationSchedule_step, value) {
+				return
+			}
+		case *InflationSchedule_Linear:
+			v := o.Linear
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_InflationSchedule_linear, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		if x.Schedule == nil {
+			return false
+		} else if _, ok := x.Schedule.(*InflationSchedule_Exponential); ok {
+			return true
+		} else {
+			return false
+		}
+	case "canto.inflation.v1.InflationSchedule.step":
+		if x.Schedule == nil {
+			return false
+		} else if _, ok := x.Schedule.(*InflationSchedule_Step); ok {
+			return true
+		} else {
+			return false
+		}
+	case "canto.inflation.v1.InflationSchedule.linear":
+		if x.Schedule == nil {
+			return false
+		} else if _, ok := x.Schedule.(*InflationSchedule_Linear); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		x.Schedule = nil
+	case "canto.inflation.v1.InflationSchedule.step":
+		x.Schedule = nil
+	case "canto.inflation.v1.InflationSchedule.linear":
+		x.Schedule = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		if x.Schedule == nil {
+			return protoreflect.ValueOfMessage((*ExponentialCalculation)(nil).ProtoReflect())
+		} else if v, ok := x.Schedule.(*InflationSchedule_Exponential); ok {
+			return protoreflect.ValueOfMessage(v.Exponential.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*ExponentialCalculation)(nil).ProtoReflect())
+		}
+	case "canto.inflation.v1.InflationSchedule.step":
+		if x.Schedule == nil {
+			return protoreflect.ValueOfMessage((*StepSchedule)(nil).ProtoReflect())
+		} else if v, ok := x.Schedule.(*InflationSchedule_Step); ok {
+			return protoreflect.ValueOfMessage(v.Step.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*StepSchedule)(nil).ProtoReflect())
+		}
+	case "canto.inflation.v1.InflationSchedule.linear":
+		if x.Schedule == nil {
+			return protoreflect.ValueOfMessage((*LinearSchedule)(nil).ProtoReflect())
+		} else if v, ok := x.Schedule.(*InflationSchedule_Linear); ok {
+			return protoreflect.ValueOfMessage(v.Linear.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*LinearSchedule)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		cv := value.Message().Interface().(*ExponentialCalculation)
+		x.Schedule = &InflationSchedule_Exponential{Exponential: cv}
+	case "canto.inflation.v1.InflationSchedule.step":
+		cv := value.Message().Interface().(*StepSchedule)
+		x.Schedule = &InflationSchedule_Step{Step: cv}
+	case "canto.inflation.v1.InflationSchedule.linear":
+		cv := value.Message().Interface().(*LinearSchedule)
+		x.Schedule = &InflationSchedule_Linear{Linear: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		if x.Schedule == nil {
+			value := &ExponentialCalculation{}
+			oneofValue := &InflationSchedule_Exponential{Exponential: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Schedule.(type) {
+		case *InflationSchedule_Exponential:
+			return protoreflect.ValueOfMessage(m.Exponential.ProtoReflect())
+		default:
+			value := &ExponentialCalculation{}
+			oneofValue := &InflationSchedule_Exponential{Exponential: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "canto.inflation.v1.InflationSchedule.step":
+		if x.Schedule == nil {
+			value := &StepSchedule{}
+			oneofValue := &InflationSchedule_Step{Step: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Schedule.(type) {
+		case *InflationSchedule_Step:
+			return protoreflect.ValueOfMessage(m.Step.ProtoReflect())
+		default:
+			value := &StepSchedule{}
+			oneofValue := &InflationSchedule_Step{Step: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "canto.inflation.v1.InflationSchedule.linear":
+		if x.Schedule == nil {
+			value := &LinearSchedule{}
+			oneofValue := &InflationSchedule_Linear{Linear: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Schedule.(type) {
+		case *InflationSchedule_Linear:
+			return protoreflect.ValueOfMessage(m.Linear.ProtoReflect())
+		default:
+			value := &LinearSchedule{}
+			oneofValue := &InflationSchedule_Linear{Linear: value}
+			x.Schedule = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationSchedule.exponential":
+		value := &ExponentialCalculation{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "canto.inflation.v1.InflationSchedule.step":
+		value := &StepSchedule{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "canto.inflation.v1.InflationSchedule.linear":
+		value := &LinearSchedule{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "canto.inflation.v1.InflationSchedule.schedule":
+		if x.Schedule == nil {
+			return nil
+		}
+		switch x.Schedule.(type) {
+		case *InflationSchedule_Exponential:
+			return x.Descriptor().Fields().ByName("exponential")
+		case *InflationSchedule_Step:
+			return x.Descriptor().Fields().ByName("step")
+		case *InflationSchedule_Linear:
+			return x.Descriptor().Fields().ByName("linear")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.InflationSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Schedule.(type) {
+		case *InflationSchedule_Exponential:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Exponential)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *InflationSchedule_Step:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Step)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *InflationSchedule_Linear:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Linear)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Schedule.(type) {
+		case *InflationSchedule_Exponential:
+			encoded, err := options.Marshal(x.Exponential)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *InflationSchedule_Step:
+			encoded, err := options.Marshal(x.Step)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *InflationSchedule_Linear:
+			encoded, err := options.Marshal(x.Linear)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponential", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &ExponentialCalculation{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Schedule = &InflationSchedule_Exponential{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &StepSchedule{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Schedule = &InflationSchedule_Step{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Linear", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &LinearSchedule{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Schedule = &InflationSchedule_Linear{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StepSchedule_1_list)(nil)
+
+type _StepSchedule_1_list struct {
+	list *[]*ProvisionStep
+}
+
+func (x *_StepSchedule_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StepSchedule_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StepSchedule_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProvisionStep)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StepSchedule_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProvisionStep)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StepSchedule_1_list) AppendMutable() protoreflect.Value {
+	v := new(ProvisionStep)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StepSchedule_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StepSchedule_1_list) NewElement() protoreflect.Value {
+	v := new(ProvisionStep)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StepSchedule_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StepSchedule       protoreflect.MessageDescriptor
+	fd_StepSchedule_steps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_StepSchedule = File_canto_inflation_v1_inflation_proto.Messages().ByName("StepSchedule")
+	fd_StepSchedule_steps = md_StepSchedule.Fields().ByName("steps")
+}
+
+var _ protoreflect.Message = (*fastReflection_StepSchedule)(nil)
+
+type fastReflection_StepSchedule StepSchedule
+
+func (x *StepSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StepSchedule)(x)
+}
+
+func (x *StepSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StepSchedule_messageType fastReflection_StepSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_StepSchedule_messageType{}
+
+type fastReflection_StepSchedule_messageType struct{}
+
+func (x fastReflection_StepSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StepSchedule)(nil)
+}
+func (x fastReflection_StepSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_StepSchedule)
+}
+func (x fastReflection_StepSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StepSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StepSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_StepSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StepSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_StepSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StepSchedule) New() protoreflect.Message {
+	return new(fastReflection_StepSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StepSchedule) Interface() protoreflect.ProtoMessage {
+	return (*StepSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StepSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Steps) != 0 {
+		value := protoreflect.ValueOfList(&_StepSchedule_1_list{list: &x.Steps})
+		if !f(fd_StepSchedule_steps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StepSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		return len(x.Steps) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StepSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		x.Steps = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StepSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		if len(x.Steps) == 0 {
+			return protoreflect.ValueOfList(&_StepSchedule_1_list{})
+		}
+		listValue := &_StepSchedule_1_list{list: &x.Steps}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StepSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		lv := value.List()
+		clv := lv.(*_StepSchedule_1_list)
+		x.Steps = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StepSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		if x.Steps == nil {
+			x.Steps = []*ProvisionStep{}
+		}
+		value := &_StepSchedule_1_list{list: &x.Steps}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StepSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.StepSchedule.steps":
+		list := []*ProvisionStep{}
+		return protoreflect.ValueOfList(&_StepSchedule_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.StepSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.StepSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StepSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.StepSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StepSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StepSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StepSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StepSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StepSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Steps) > 0 {
+			for _, e := range x.Steps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StepSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Steps) > 0 {
+			for iNdEx := len(x.Steps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Steps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StepSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StepSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StepSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Steps = append(x.Steps, &ProvisionStep{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Steps[len(x.Steps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProvisionStep                  protoreflect.MessageDescriptor
+	fd_ProvisionStep_start_period     protoreflect.FieldDescriptor
+	fd_ProvisionStep_annual_provision protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_ProvisionStep = File_canto_inflation_v1_inflation_proto.Messages().ByName("ProvisionStep")
+	fd_ProvisionStep_start_period = md_ProvisionStep.Fields().ByName("start_period")
+	fd_ProvisionStep_annual_provision = md_ProvisionStep.Fields().ByName("annual_provision")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProvisionStep)(nil)
+
+type fastReflection_ProvisionStep ProvisionStep
+
+func (x *ProvisionStep) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProvisionStep)(x)
+}
+
+func (x *ProvisionStep) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProvisionStep_messageType fastReflection_ProvisionStep_messageType
+var _ protoreflect.MessageType = fastReflection_ProvisionStep_messageType{}
+
+type fastReflection_ProvisionStep_messageType struct{}
+
+func (x fastReflection_ProvisionStep_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProvisionStep)(nil)
+}
+func (x fastReflection_ProvisionStep_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProvisionStep)
+}
+func (x fastReflection_ProvisionStep_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvisionStep
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProvisionStep) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvisionStep
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProvisionStep) Type() protoreflect.MessageType {
+	return _fastReflection_ProvisionStep_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProvisionStep) New() protoreflect.Message {
+	return new(fastReflection_ProvisionStep)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProvisionStep) Interface() protoreflect.ProtoMessage {
+	return (*ProvisionStep)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProvisionStep) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartPeriod)
+		if !f(fd_ProvisionStep_start_period, value) {
+			return
+		}
+	}
+	if x.AnnualProvision != "" {
+		value := protoreflect.ValueOfString(x.AnnualProvision)
+		if !f(fd_ProvisionStep_annual_provision, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProvisionStep) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		return x.StartPeriod != uint64(0)
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		return x.AnnualProvision != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvisionStep) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		x.StartPeriod = uint64(0)
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		x.AnnualProvision = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProvisionStep) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		value := x.StartPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		value := x.AnnualProvision
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvisionStep) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		x.StartPeriod = value.Uint()
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		x.AnnualProvision = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvisionStep) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		panic(fmt.Errorf("field start_period of message canto.inflation.v1.ProvisionStep is not mutable"))
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		panic(fmt.Errorf("field annual_provision of message canto.inflation.v1.ProvisionStep is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProvisionStep) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.ProvisionStep.start_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "canto.inflation.v1.ProvisionStep.annual_provision":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.ProvisionStep"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.ProvisionStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProvisionStep) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.ProvisionStep", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProvisionStep) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvisionStep) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProvisionStep) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProvisionStep) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProvisionStep)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartPeriod))
+		}
+		l = len(x.AnnualProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProvisionStep)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AnnualProvision) > 0 {
+			i -= len(x.AnnualProvision)
+			copy(dAtA[i:], x.AnnualProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualProvision)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartPeriod))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProvisionStep)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvisionStep: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvisionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+				}
+				x.StartPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LinearSchedule                   protoreflect.MessageDescriptor
+	fd_LinearSchedule_initial_provision protoreflect.FieldDescriptor
+	fd_LinearSchedule_decrease          protoreflect.FieldDescriptor
+	fd_LinearSchedule_min_provision     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_LinearSchedule = File_canto_inflation_v1_inflation_proto.Messages().ByName("LinearSchedule")
+	fd_LinearSchedule_initial_provision = md_LinearSchedule.Fields().ByName("initial_provision")
+	fd_LinearSchedule_decrease = md_LinearSchedule.Fields().ByName("decrease")
+	fd_LinearSchedule_min_provision = md_LinearSchedule.Fields().ByName("min_provision")
+}
+
+var _ protoreflect.Message = (*fastReflection_LinearSchedule)(nil)
+
+type fastReflection_LinearSchedule LinearSchedule
+
+func (x *LinearSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinearSchedule)(x)
+}
+
+func (x *LinearSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinearSchedule_messageType fastReflection_LinearSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_LinearSchedule_messageType{}
+
+type fastReflection_LinearSchedule_messageType struct{}
+
+func (x fastReflection_LinearSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinearSchedule)(nil)
+}
+func (x fastReflection_LinearSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinearSchedule)
+}
+func (x fastReflection_LinearSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinearSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LinearSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinearSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LinearSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_LinearSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LinearSchedule) New() protoreflect.Message {
+	return new(fastReflection_LinearSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LinearSchedule) Interface() protoreflect.ProtoMessage {
+	return (*LinearSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LinearSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InitialProvision != "" {
+		value := protoreflect.ValueOfString(x.InitialProvision)
+		if !f(fd_LinearSchedule_initial_provision, value) {
+			return
+		}
+	}
+	if x.Decrease != "" {
+		value := protoreflect.ValueOfString(x.Decrease)
+		if !f(fd_LinearSchedule_decrease, value) {
+			return
+		}
+	}
+	if x.MinProvision != "" {
+		value := protoreflect.ValueOfString(x.MinProvision)
+		if !f(fd_LinearSchedule_min_provision, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LinearSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		return x.InitialProvision != ""
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		return x.Decrease != ""
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		return x.MinProvision != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinearSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		x.InitialProvision = ""
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		x.Decrease = ""
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		x.MinProvision = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LinearSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		value := x.InitialProvision
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		value := x.Decrease
+		return protoreflect.ValueOfString(value)
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		value := x.MinProvision
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinearSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		x.InitialProvision = value.Interface().(string)
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		x.Decrease = value.Interface().(string)
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		x.MinProvision = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinearSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		panic(fmt.Errorf("field initial_provision of message canto.inflation.v1.LinearSchedule is not mutable"))
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		panic(fmt.Errorf("field decrease of message canto.inflation.v1.LinearSchedule is not mutable"))
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		panic(fmt.Errorf("field min_provision of message canto.inflation.v1.LinearSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LinearSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.LinearSchedule.initial_provision":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.LinearSchedule.decrease":
+		return protoreflect.ValueOfString("")
+	case "canto.inflation.v1.LinearSchedule.min_provision":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.LinearSchedule"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.LinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LinearSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.LinearSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LinearSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LinearSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LinearSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LinearSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LinearSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InitialProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Decrease)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LinearSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinProvision) > 0 {
+			i -= len(x.MinProvision)
+			copy(dAtA[i:], x.MinProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinProvision)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Decrease) > 0 {
+			i -= len(x.Decrease)
+			copy(dAtA[i:], x.Decrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Decrease)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InitialProvision) > 0 {
+			i -= len(x.InitialProvision)
+			copy(dAtA[i:], x.InitialProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialProvision)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LinearSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinearSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinearSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decrease", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Decrease = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EpochBondingAdjustment                      protoreflect.MessageDescriptor
+	fd_EpochBondingAdjustment_enabled              protoreflect.FieldDescriptor
+	fd_EpochBondingAdjustment_max_change_per_epoch protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_EpochBondingAdjustment = File_canto_inflation_v1_inflation_proto.Messages().ByName("EpochBondingAdjustment")
+	fd_EpochBondingAdjustment_enabled = md_EpochBondingAdjustment.Fields().ByName("enabled")
+	fd_EpochBondingAdjustment_max_change_per_epoch = md_EpochBondingAdjustment.Fields().ByName("max_change_per_epoch")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochBondingAdjustment)(nil)
+
+type fastReflection_EpochBondingAdjustment EpochBondingAdjustment
+
+func (x *EpochBondingAdjustment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochBondingAdjustment)(x)
+}
+
+func (x *EpochBondingAdjustment) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochBondingAdjustment_messageType fastReflection_EpochBondingAdjustment_messageType
+var _ protoreflect.MessageType = fastReflection_EpochBondingAdjustment_messageType{}
+
+type fastReflection_EpochBondingAdjustment_messageType struct{}
+
+func (x fastReflection_EpochBondingAdjustment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochBondingAdjustment)(nil)
+}
+func (x fastReflection_EpochBondingAdjustment_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochBondingAdjustment)
+}
+func (x fastReflection_EpochBondingAdjustment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochBondingAdjustment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochBondingAdjustment) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochBondingAdjustment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochBondingAdjustment) Type() protoreflect.MessageType {
+	return _fastReflection_EpochBondingAdjustment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochBondingAdjustment) New() protoreflect.Message {
+	return new(fastReflection_EpochBondingAdjustment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochBondingAdjustment) Interface() protoreflect.ProtoMessage {
+	return (*EpochBondingAdjustment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochBondingAdjustment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_EpochBondingAdjustment_enabled, value) {
+			return
+		}
+	}
+	if x.MaxChangePerEpoch != "" {
+		value := protoreflect.ValueOfString(x.MaxChangePerEpoch)
+		if !f(fd_EpochBondingAdjustment_max_change_per_epoch, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochBondingAdjustment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		return x.Enabled != false
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		return x.MaxChangePerEpoch != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochBondingAdjustment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		x.Enabled = false
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		x.MaxChangePerEpoch = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochBondingAdjustment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		value := x.MaxChangePerEpoch
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochBondingAdjustment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		x.Enabled = value.Bool()
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		x.MaxChangePerEpoch = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochBondingAdjustment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		panic(fmt.Errorf("field enabled of message canto.inflation.v1.EpochBondingAdjustment is not mutable"))
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		panic(fmt.Errorf("field max_change_per_epoch of message canto.inflation.v1.EpochBondingAdjustment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochBondingAdjustment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.EpochBondingAdjustment.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "canto.inflation.v1.EpochBondingAdjustment.max_change_per_epoch":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.EpochBondingAdjustment"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.EpochBondingAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochBondingAdjustment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.EpochBondingAdjustment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochBondingAdjustment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochBondingAdjustment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochBondingAdjustment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochBondingAdjustment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochBondingAdjustment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.MaxChangePerEpoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochBondingAdjustment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxChangePerEpoch) > 0 {
+			i -= len(x.MaxChangePerEpoch)
+			copy(dAtA[i:], x.MaxChangePerEpoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangePerEpoch)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochBondingAdjustment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochBondingAdjustment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochBondingAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxChangePerEpoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationPause                         protoreflect.MessageDescriptor
+	fd_InflationPause_paused                  protoreflect.FieldDescriptor
+	fd_InflationPause_pause_height            protoreflect.FieldDescriptor
+	fd_InflationPause_resume_height           protoreflect.FieldDescriptor
+	fd_InflationPause_skipped_epochs_at_pause protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_canto_inflation_v1_inflation_proto_init()
+	md_InflationPause = File_canto_inflation_v1_inflation_proto.Messages().ByName("InflationPause")
+	fd_InflationPause_paused = md_InflationPause.Fields().ByName("paused")
+	fd_InflationPause_pause_height = md_InflationPause.Fields().ByName("pause_height")
+	fd_InflationPause_resume_height = md_InflationPause.Fields().ByName("resume_height")
+	fd_InflationPause_skipped_epochs_at_pause = md_InflationPause.Fields().ByName("skipped_epochs_at_pause")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationPause)(nil)
+
+type fastReflection_InflationPause InflationPause
+
+func (x *InflationPause) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationPause)(x)
+}
+
+func (x *InflationPause) slowProtoReflect() protoreflect.Message {
+	mi := &file_canto_inflation_v1_inflation_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationPause_messageType fastReflection_InflationPause_messageType
+var _ protoreflect.MessageType = fastReflection_InflationPause_messageType{}
+
+type fastReflection_InflationPause_messageType struct{}
+
+func (x fastReflection_InflationPause_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationPause)(nil)
+}
+func (x fastReflection_InflationPause_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationPause)
+}
+func (x fastReflection_InflationPause_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPause
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationPause) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPause
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationPause) Type() protoreflect.MessageType {
+	return _fastReflection_InflationPause_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationPause) New() protoreflect.Message {
+	return new(fastReflection_InflationPause)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationPause) Interface() protoreflect.ProtoMessage {
+	return (*InflationPause)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationPause) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_InflationPause_paused, value) {
+			return
+		}
+	}
+	if x.PauseHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PauseHeight)
+		if !f(fd_InflationPause_pause_height, value) {
+			return
+		}
+	}
+	if x.ResumeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResumeHeight)
+		if !f(fd_InflationPause_resume_height, value) {
+			return
+		}
+	}
+	if x.SkippedEpochsAtPause != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SkippedEpochsAtPause)
+		if !f(fd_InflationPause_skipped_epochs_at_pause, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationPause) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		return x.Paused != false
+	case "canto.inflation.v1.InflationPause.pause_height":
+		return x.PauseHeight != int64(0)
+	case "canto.inflation.v1.InflationPause.resume_height":
+		return x.ResumeHeight != int64(0)
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		return x.SkippedEpochsAtPause != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPause) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		x.Paused = false
+	case "canto.inflation.v1.InflationPause.pause_height":
+		x.PauseHeight = int64(0)
+	case "canto.inflation.v1.InflationPause.resume_height":
+		x.ResumeHeight = int64(0)
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		x.SkippedEpochsAtPause = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationPause) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "canto.inflation.v1.InflationPause.pause_height":
+		value := x.PauseHeight
+		return protoreflect.ValueOfInt64(value)
+	case "canto.inflation.v1.InflationPause.resume_height":
+		value := x.ResumeHeight
+		return protoreflect.ValueOfInt64(value)
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		value := x.SkippedEpochsAtPause
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPause) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		x.Paused = value.Bool()
+	case "canto.inflation.v1.InflationPause.pause_height":
+		x.PauseHeight = value.Int()
+	case "canto.inflation.v1.InflationPause.resume_height":
+		x.ResumeHeight = value.Int()
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		x.SkippedEpochsAtPause = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPause) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		panic(fmt.Errorf("field paused of message canto.inflation.v1.InflationPause is not mutable"))
+	case "canto.inflation.v1.InflationPause.pause_height":
+		panic(fmt.Errorf("field pause_height of message canto.inflation.v1.InflationPause is not mutable"))
+	case "canto.inflation.v1.InflationPause.resume_height":
+		panic(fmt.Errorf("field resume_height of message canto.inflation.v1.InflationPause is not mutable"))
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		panic(fmt.Errorf("field skipped_epochs_at_pause of message canto.inflation.v1.InflationPause is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationPause) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "canto.inflation.v1.InflationPause.paused":
+		return protoreflect.ValueOfBool(false)
+	case "canto.inflation.v1.InflationPause.pause_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "canto.inflation.v1.InflationPause.resume_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "canto.inflation.v1.InflationPause.skipped_epochs_at_pause":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: canto.inflation.v1.InflationPause"))
+		}
+		panic(fmt.Errorf("message canto.inflation.v1.InflationPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationPause) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in canto.inflation.v1.InflationPause", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationPause) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPause) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationPause) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationPause) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationPause)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Paused {
+			n += 2
+		}
+		if x.PauseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PauseHeight))
+		}
+		if x.ResumeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResumeHeight))
+		}
+		if x.SkippedEpochsAtPause != 0 {
+			n += 1 + runtime.Sov(uint64(x.SkippedEpochsAtPause))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPause)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SkippedEpochsAtPause != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkippedEpochsAtPause))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ResumeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResumeHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PauseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PauseHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPause)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPause: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseHeight", wireType)
+				}
+				x.PauseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PauseHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
+				}
+				x.ResumeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResumeHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochsAtPause", wireType)
+				}
+				x.SkippedEpochsAtPause = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SkippedEpochsAtPause |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: canto/inflation/v1/inflation.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecipientType enumerates the destinations of minted inflation.
+type RecipientType int32
+
+const (
+	// RECIPIENT_TYPE_UNSPECIFIED defines an invalid/undefined recipient.
+	RecipientType_RECIPIENT_TYPE_UNSPECIFIED RecipientType = 0
+	// RECIPIENT_TYPE_COMMUNITY_POOL funds the distribution community pool.
+	RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL RecipientType = 1
+	// RECIPIENT_TYPE_MODULE sends coins to a module account, e.g. the fee
+	// collector for staking rewards.
+	RecipientType_RECIPIENT_TYPE_MODULE RecipientType = 2
+	// RECIPIENT_TYPE_ADDRESS sends coins to a bech32 account address.
+	RecipientType_RECIPIENT_TYPE_ADDRESS RecipientType = 3
+	// RECIPIENT_TYPE_CONTRACT sends coins to a hex EVM contract address.
+	RecipientType_RECIPIENT_TYPE_CONTRACT RecipientType = 4
+)
+
+// Enum value maps for RecipientType.
+var (
+	RecipientType_name = map[int32]string{
+		0: "RECIPIENT_TYPE_UNSPECIFIED",
+		1: "RECIPIENT_TYPE_COMMUNITY_POOL",
+		2: "RECIPIENT_TYPE_MODULE",
+		3: "RECIPIENT_TYPE_ADDRESS",
+		4: "RECIPIENT_TYPE_CONTRACT",
+	}
+	RecipientType_value = map[string]int32{
+		"RECIPIENT_TYPE_UNSPECIFIED":    0,
+		"RECIPIENT_TYPE_COMMUNITY_POOL": 1,
+		"RECIPIENT_TYPE_MODULE":         2,
+		"RECIPIENT_TYPE_ADDRESS":        3,
+		"RECIPIENT_TYPE_CONTRACT":       4,
+	}
+)
+
+func (x RecipientType) Enum() *RecipientType {
+	p := new(RecipientType)
+	*p = x
+	return p
+}
+
+func (x RecipientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canto_inflation_v1_inflation_proto_enumTypes[0].Descriptor()
+}
+
+func (RecipientType) Type() protoreflect.EnumType {
+	return &file_canto_inflation_v1_inflation_proto_enumTypes[0]
+}
+
+func (x RecipientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipientType.Descriptor instead.
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+}
+
+// InflationRecipient defines a destination of minted inflation and the
+// proportion of each epoch mint allocated to it.
+type InflationRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of the recipient
+	RecipientType RecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=canto.inflation.v1.RecipientType" json:"recipient_type,omitempty"`
+	// module name for module recipients, bech32 address for address recipients
+	// and hex address for contract recipients. It is empty for the community
+	// pool.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted mint_denom that is allocated
+	// to the recipient
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *InflationRecipient) Reset() {
+	*x = InflationRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRecipient) ProtoMessage() {}
+
+// Deprecated: Use InflationRecipient.ProtoReflect.Descriptor instead.
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InflationRecipient) GetRecipientType() RecipientType {
+	if x != nil {
+		return x.RecipientType
+	}
+	return RecipientType_RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (x *InflationRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InflationRecipient) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// InflationDistribution defines the distribution in which inflation is
+// allocated through minting on each epoch. It excludes the team vesting
+// distribution, as this is minted once at genesis.
+type InflationDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// staking_rewards defines the proportion of the minted minted_denom that is
+	// to be allocated as staking rewards.
+	// Deprecated: staking rewards are allocated through a module recipient
+	// for the fee collector.
+	//
+	// Deprecated: Do not use.
+	StakingRewards string `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	// // usage_incentives defines the proportion of the minted minted_denom that
+	// is
+	// // to be allocated to the incentives module address
+	// string usage_incentives = 2 [
+	//   (gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec",
+	//   (gogoproto.nullable) = false
+	// ];
+	// community_pool defines the proportion of the minted minted_denom that is to
+	// be allocated to the community pool.
+	// Deprecated: the community pool is allocated through a community pool
+	// recipient.
+	//
+	// Deprecated: Do not use.
+	CommunityPool string `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// recipients of the minted mint_denom. The weights of the recipients must
+	// sum to 1.
+	Recipients []*InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *InflationDistribution) Reset() {
+	*x = InflationDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationDistribution) ProtoMessage() {}
+
+// Deprecated: Use InflationDistribution.ProtoReflect.Descriptor instead.
+func (*InflationDistribution) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+}
+
+// Deprecated: Do not use.
+func (x *InflationDistribution) GetStakingRewards() string {
+	if x != nil {
+		return x.StakingRewards
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *InflationDistribution) GetCommunityPool() string {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return ""
+}
+
+func (x *InflationDistribution) GetRecipients() []*InflationRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// ExponentialCalculation holds factors to calculate exponential inflation on
+// each period. Calculation reference:
+// periodProvision = exponentialDecay       *  bondingIncentive
+// f(x)            = (a * (1 - r) ^ x + c)  *  (1 + max_variance - bondedRatio *
+// (max_variance / bonding_target))
+type ExponentialCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// initial value
+	A string `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	// reduction factor
+	R string `protobuf:"bytes,2,opt,name=r,proto3" json:"r,omitempty"`
+	// long term inflation
+	C string `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
+	// bonding target
+	BondingTarget string `protobuf:"bytes,4,opt,name=bonding_target,json=bondingTarget,proto3" json:"bonding_target,omitempty"`
+	// max variance
+	MaxVariance string `protobuf:"bytes,5,opt,name=max_variance,json=maxVariance,proto3" json:"max_variance,omitempty"`
+}
+
+func (x *ExponentialCalculation) Reset() {
+	*x = ExponentialCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExponentialCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExponentialCalculation) ProtoMessage() {}
+
+// Deprecated: Use ExponentialCalculation.ProtoReflect.Descriptor instead.
+func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExponentialCalculation) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *ExponentialCalculation) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *ExponentialCalculation) GetC() string {
+	if x != nil {
+		return x.C
+	}
+	return ""
+}
+
+func (x *ExponentialCalculation) GetBondingTarget() string {
+	if x != nil {
+		return x.BondingTarget
+	}
+	return ""
+}
+
+func (x *ExponentialCalculation) GetMaxVariance() string {
+	if x != nil {
+		return x.MaxVariance
+	}
+	return ""
+}
+
+// InflationSchedule defines the curve that calculates the annual provision of
+// each period. Exactly one schedule must be set.
+type InflationSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Schedule:
+	//	*InflationSchedule_Exponential
+	//	*InflationSchedule_Step
+	//	*InflationSchedule_Linear
+	Schedule isInflationSchedule_Schedule `protobuf_oneof:"schedule"`
+}
+
+func (x *InflationSchedule) Reset() {
+	*x = InflationSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-// InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
-// excludes the team vesting distribution, as this is minted once at genesis.
-// The initial InflationDistribution can be calculated from the Evmos Token
-// Model like this:
-// mintDistribution1 = distribution1 / (1 - teamVestingDistribution)
-// 0.5333333         = 40%           / (1 - 25%)
-type InflationDistribution struct {
+func (*InflationSchedule) ProtoMessage() {}
+
+// Deprecated: Use InflationSchedule.ProtoReflect.Descriptor instead.
+func (*InflationSchedule) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InflationSchedule) GetSchedule() isInflationSchedule_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *InflationSchedule) GetExponential() *ExponentialCalculation {
+	if x, ok := x.GetSchedule().(*InflationSchedule_Exponential); ok {
+		return x.Exponential
+	}
+	return nil
+}
+
+func (x *InflationSchedule) GetStep() *StepSchedule {
+	if x, ok := x.GetSchedule().(*InflationSchedule_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *InflationSchedule) GetLinear() *LinearSchedule {
+	if x, ok := x.GetSchedule().(*InflationSchedule_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+type isInflationSchedule_Schedule interface {
+	isInflationSchedule_Schedule()
+}
+
+type InflationSchedule_Exponential struct {
+	// exponential decay with a bonding incentive
+	Exponential *ExponentialCalculation `protobuf:"bytes,1,opt,name=exponential,proto3,oneof"`
+}
+
+type InflationSchedule_Step struct {
+	// piecewise constant provisions by period
+	Step *StepSchedule `protobuf:"bytes,2,opt,name=step,proto3,oneof"`
+}
+
+type InflationSchedule_Linear struct {
+	// linearly decreasing provision with a floor
+	Linear *LinearSchedule `protobuf:"bytes,3,opt,name=linear,proto3,oneof"`
+}
+
+func (*InflationSchedule_Exponential) isInflationSchedule_Schedule() {}
+
+func (*InflationSchedule_Step) isInflationSchedule_Schedule() {}
+
+func (*InflationSchedule_Linear) isInflationSchedule_Schedule() {}
+
+// StepSchedule holds a table of annual provisions. The provision of a period
+// is the one of the last step starting at or before it.
+type StepSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// staking_rewards defines the proportion of the minted minted_denom that is
-	// to be allocated as staking rewards
-	StakingRewards string `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
-	// // usage_incentives defines the proportion of the minted minted_denom that
-	// is
-	// // to be allocated to the incentives module address
-	// string usage_incentives = 2 [
-	//
-	//	(gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec",
-	//	(gogoproto.nullable) = false
-	//
-	// ];
-	// community_pool defines the proportion of the minted minted_denom that is to
-	// be allocated to the community pool
-	CommunityPool string `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// steps sorted by strictly increasing start period. The first step must
+	// start at period 0.
+	Steps []*ProvisionStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *InflationDistribution) Reset() {
-	*x = InflationDistribution{}
+func (x *StepSchedule) Reset() {
+	*x = StepSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[0]
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InflationDistribution) String() string {
+func (x *StepSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InflationDistribution) ProtoMessage() {}
+func (*StepSchedule) ProtoMessage() {}
 
-// Deprecated: Use InflationDistribution.ProtoReflect.Descriptor instead.
-func (*InflationDistribution) Descriptor() ([]byte, []int) {
-	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use StepSchedule.ProtoReflect.Descriptor instead.
+func (*StepSchedule) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *InflationDistribution) GetStakingRewards() string {
+func (x *StepSchedule) GetSteps() []*ProvisionStep {
 	if x != nil {
-		return x.StakingRewards
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *InflationDistribution) GetCommunityPool() string {
+// ProvisionStep defines the annual provision from a start period on.
+type ProvisionStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// first period of the step
+	StartPeriod uint64 `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// annual provision in whole tokens
+	AnnualProvision string `protobuf:"bytes,2,opt,name=annual_provision,json=annualProvision,proto3" json:"annual_provision,omitempty"`
+}
+
+func (x *ProvisionStep) Reset() {
+	*x = ProvisionStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvisionStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionStep) ProtoMessage() {}
+
+// Deprecated: Use ProvisionStep.ProtoReflect.Descriptor instead.
+func (*ProvisionStep) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProvisionStep) GetStartPeriod() uint64 {
 	if x != nil {
-		return x.CommunityPool
+		return x.StartPeriod
+	}
+	return 0
+}
+
+func (x *ProvisionStep) GetAnnualProvision() string {
+	if x != nil {
+		return x.AnnualProvision
 	}
 	return ""
 }
 
-// ExponentialCalculation holds factors to calculate exponential inflation on
-// each period. Calculation reference:
-// periodProvision = exponentialDecay       *  bondingIncentive
-// f(x)            = (a * (1 - r) ^ x + c)  *  (1 + max_variance - bondedRatio *
-// (max_variance / bonding_target))
-type ExponentialCalculation struct {
+// LinearSchedule holds factors to calculate a linearly decreasing inflation
+// on each period. Calculation reference:
+// periodProvision = max(initial_provision - period * decrease, min_provision)
+type LinearSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// initial value
-	A string `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
-	// reduction factor
-	R string `protobuf:"bytes,2,opt,name=r,proto3" json:"r,omitempty"`
-	// long term inflation
-	C string `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
-	// bonding target
-	BondingTarget string `protobuf:"bytes,4,opt,name=bonding_target,json=bondingTarget,proto3" json:"bonding_target,omitempty"`
-	// max variance
-	MaxVariance string `protobuf:"bytes,5,opt,name=max_variance,json=maxVariance,proto3" json:"max_variance,omitempty"`
+	// annual provision of period 0 in whole tokens
+	InitialProvision string `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3" json:"initial_provision,omitempty"`
+	// decrease of the annual provision on each period
+	Decrease string `protobuf:"bytes,2,opt,name=decrease,proto3" json:"decrease,omitempty"`
+	// long term annual provision the decrease stops at
+	MinProvision string `protobuf:"bytes,3,opt,name=min_provision,json=minProvision,proto3" json:"min_provision,omitempty"`
 }
 
-func (x *ExponentialCalculation) Reset() {
-	*x = ExponentialCalculation{}
+func (x *LinearSchedule) Reset() {
+	*x = LinearSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[1]
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExponentialCalculation) String() string {
+func (x *LinearSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExponentialCalculation) ProtoMessage() {}
+func (*LinearSchedule) ProtoMessage() {}
 
-// Deprecated: Use ExponentialCalculation.ProtoReflect.Descriptor instead.
-func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use LinearSchedule.ProtoReflect.Descriptor instead.
+func (*LinearSchedule) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ExponentialCalculation) GetA() string {
+func (x *LinearSchedule) GetInitialProvision() string {
 	if x != nil {
-		return x.A
+		return x.InitialProvision
 	}
 	return ""
 }
 
-func (x *ExponentialCalculation) GetR() string {
+func (x *LinearSchedule) GetDecrease() string {
 	if x != nil {
-		return x.R
+		return x.Decrease
 	}
 	return ""
 }
 
-func (x *ExponentialCalculation) GetC() string {
+func (x *LinearSchedule) GetMinProvision() string {
 	if x != nil {
-		return x.C
+		return x.MinProvision
 	}
 	return ""
 }
 
-func (x *ExponentialCalculation) GetBondingTarget() string {
+// EpochBondingAdjustment defines whether the bonding incentive of the
+// exponential schedule is recalculated on every epoch instead of once per
+// period. Calculation reference:
+// bondingFactor   = prevBondingFactor + clamp(targetBondingFactor -
+// prevBondingFactor, -max_change_per_epoch, max_change_per_epoch)
+// epochProvision  = exponentialDecay * bondingFactor / epochsPerPeriod
+type EpochBondingAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recalculate the bonding incentive on every epoch
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// maximum change of the bonding factor between two epochs
+	MaxChangePerEpoch string `protobuf:"bytes,2,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3" json:"max_change_per_epoch,omitempty"`
+}
+
+func (x *EpochBondingAdjustment) Reset() {
+	*x = EpochBondingAdjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochBondingAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochBondingAdjustment) ProtoMessage() {}
+
+// Deprecated: Use EpochBondingAdjustment.ProtoReflect.Descriptor instead.
+func (*EpochBondingAdjustment) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EpochBondingAdjustment) GetEnabled() bool {
 	if x != nil {
-		return x.BondingTarget
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *ExponentialCalculation) GetMaxVariance() string {
+func (x *EpochBondingAdjustment) GetMaxChangePerEpoch() string {
 	if x != nil {
-		return x.MaxVariance
+		return x.MaxChangePerEpoch
 	}
 	return ""
 }
 
+// InflationPause records the latest pause and resume of the inflation through
+// MsgPauseInflation and MsgResumeInflation.
+type InflationPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true while the inflation is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// block height of the latest pause
+	PauseHeight int64 `protobuf:"varint,2,opt,name=pause_height,json=pauseHeight,proto3" json:"pause_height,omitempty"`
+	// block height of the latest resume
+	ResumeHeight int64 `protobuf:"varint,3,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
+	// number of skipped epochs when the inflation was paused
+	SkippedEpochsAtPause uint64 `protobuf:"varint,4,opt,name=skipped_epochs_at_pause,json=skippedEpochsAtPause,proto3" json:"skipped_epochs_at_pause,omitempty"`
+}
+
+func (x *InflationPause) Reset() {
+	*x = InflationPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_canto_inflation_v1_inflation_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationPause) ProtoMessage() {}
+
+// Deprecated: Use InflationPause.ProtoReflect.Descriptor instead.
+func (*InflationPause) Descriptor() ([]byte, []int) {
+	return file_canto_inflation_v1_inflation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InflationPause) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *InflationPause) GetPauseHeight() int64 {
+	if x != nil {
+		return x.PauseHeight
+	}
+	return 0
+}
+
+func (x *InflationPause) GetResumeHeight() int64 {
+	if x != nil {
+		return x.ResumeHeight
+	}
+	return 0
+}
+
+func (x *InflationPause) GetSkippedEpochsAtPause() uint64 {
+	if x != nil {
+		return x.SkippedEpochsAtPause
+	}
+	return 0
+}
+
 var File_canto_inflation_v1_inflation_proto protoreflect.FileDescriptor
 
 var file_canto_inflation_v1_inflation_proto_rawDesc = []byte{
@@ -1338,60 +5708,165 @@ var file_canto_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a,
-	0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
-	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0xa4, 0x03, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x44, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12, 0x44, 0x0a, 0x01, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a,
+	0x12, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x61,
+	0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4e, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xae, 0x02,
+	0x0a, 0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x18, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
 	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x72, 0x12, 0x44, 0x0a,
-	0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x18, 0x01, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x51, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa4,
+	0x03, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x01, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12,
+	0x44, 0x0a, 0x01, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x01, 0x72, 0x12, 0x44, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x63, 0x12, 0x5d, 0x0a, 0x0e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xdd, 0x02, 0x0a, 0x11, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x7a, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2a, 0xb2, 0xe7,
+	0xb0, 0x2a, 0x25, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x5b, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x23, 0xb2, 0xe7, 0xb0, 0x2a, 0x1e, 0x63, 0x61,
+	0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x53, 0x74, 0x65, 0x70, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x12, 0x63, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x25, 0xb2, 0xe7, 0xb0, 0x2a, 0x20, 0x63,
+	0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x78, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x52, 0x0a, 0x0c, 0x53, 0x74, 0x65, 0x70, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x61,
+	0x0a, 0x10, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
 	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x01, 0x63, 0x12, 0x5d, 0x0a, 0x0e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x52, 0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xa6, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
 	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
 	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0xc3, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x6e,
-	0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x49,
-	0x58, 0xaa, 0x02, 0x12, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x61,
-	0x6e, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43,
-	0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x67, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x61, 0x74,
+	0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x41, 0x74, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x2a, 0xac, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59,
+	0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43, 0x49, 0x50,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x61, 0x6e,
+	0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1e, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x43, 0x61, 0x6e, 0x74, 0x6f, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1406,17 +5881,32 @@ func file_canto_inflation_v1_inflation_proto_rawDescGZIP() []byte {
 	return file_canto_inflation_v1_inflation_proto_rawDescData
 }
 
-var file_canto_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_canto_inflation_v1_inflation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_canto_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_canto_inflation_v1_inflation_proto_goTypes = []interface{}{
-	(*InflationDistribution)(nil),  // 0: canto.inflation.v1.InflationDistribution
-	(*ExponentialCalculation)(nil), // 1: canto.inflation.v1.ExponentialCalculation
+	(RecipientType)(0),             // 0: canto.inflation.v1.RecipientType
+	(*InflationRecipient)(nil),     // 1: canto.inflation.v1.InflationRecipient
+	(*InflationDistribution)(nil),  // 2: canto.inflation.v1.InflationDistribution
+	(*ExponentialCalculation)(nil), // 3: canto.inflation.v1.ExponentialCalculation
+	(*InflationSchedule)(nil),      // 4: canto.inflation.v1.InflationSchedule
+	(*StepSchedule)(nil),           // 5: canto.inflation.v1.StepSchedule
+	(*ProvisionStep)(nil),          // 6: canto.inflation.v1.ProvisionStep
+	(*LinearSchedule)(nil),         // 7: canto.inflation.v1.LinearSchedule
+	(*EpochBondingAdjustment)(nil), // 8: canto.inflation.v1.EpochBondingAdjustment
+	(*InflationPause)(nil),         // 9: canto.inflation.v1.InflationPause
 }
 var file_canto_inflation_v1_inflation_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: canto.inflation.v1.InflationRecipient.recipient_type:type_name -> canto.inflation.v1.RecipientType
+	1, // 1: canto.inflation.v1.InflationDistribution.recipients:type_name -> canto.inflation.v1.InflationRecipient
+	3, // 2: canto.inflation.v1.InflationSchedule.exponential:type_name -> canto.inflation.v1.ExponentialCalculation
+	5, // 3: canto.inflation.v1.InflationSchedule.step:type_name -> canto.inflation.v1.StepSchedule
+	7, // 4: canto.inflation.v1.InflationSchedule.linear:type_name -> canto.inflation.v1.LinearSchedule
+	6, // 5: canto.inflation.v1.StepSchedule.steps:type_name -> canto.inflation.v1.ProvisionStep
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_canto_inflation_v1_inflation_proto_init() }
@@ -1426,7 +5916,7 @@ func file_canto_inflation_v1_inflation_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_canto_inflation_v1_inflation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InflationDistribution); i {
+			switch v := v.(*InflationRecipient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1438,6 +5928,18 @@ func file_canto_inflation_v1_inflation_proto_init() {
 			}
 		}
 		file_canto_inflation_v1_inflation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExponentialCalculation); i {
 			case 0:
 				return &v.state
@@ -1449,19 +5951,97 @@ func file_canto_inflation_v1_inflation_proto_init() {
 				return nil
 			}
 		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvisionStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochBondingAdjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_canto_inflation_v1_inflation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_canto_inflation_v1_inflation_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*InflationSchedule_Exponential)(nil),
+		(*InflationSchedule_Step)(nil),
+		(*InflationSchedule_Linear)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_canto_inflation_v1_inflation_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_canto_inflation_v1_inflation_proto_goTypes,
 		DependencyIndexes: file_canto_inflation_v1_inflation_proto_depIdxs,
+		EnumInfos:         file_canto_inflation_v1_inflation_proto_enumTypes,
 		MessageInfos:      file_canto_inflation_v1_inflation_proto_msgTypes,
 	}.Build()
 	File_canto_inflation_v1_inflation_proto = out.File
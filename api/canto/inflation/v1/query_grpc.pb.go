@@ -25,6 +25,10 @@ const (
 	Query_CirculatingSupply_FullMethodName  = "/canto.inflation.v1.Query/CirculatingSupply"
 	Query_InflationRate_FullMethodName      = "/canto.inflation.v1.Query/InflationRate"
 	Query_Params_FullMethodName             = "/canto.inflation.v1.Query/Params"
+	Query_RemainingSupply_FullMethodName    = "/canto.inflation.v1.Query/RemainingSupply"
+	Query_ProjectedSchedule_FullMethodName  = "/canto.inflation.v1.Query/ProjectedSchedule"
+	Query_BondingFactor_FullMethodName      = "/canto.inflation.v1.Query/BondingFactor"
+	Query_InflationPause_FullMethodName     = "/canto.inflation.v1.Query/InflationPause"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,13 +41,27 @@ type QueryClient interface {
 	EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error)
 	// SkippedEpochs retrieves the total number of skipped epochs.
 	SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error)
-	// CirculatingSupply retrieves the total number of tokens that are in
-	// circulation (i.e. excluding unvested tokens).
+	// CirculatingSupply retrieves the total supply and the number of tokens that
+	// are in circulation (i.e. excluding module accounts, excluded addresses and
+	// unvested tokens).
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RemainingSupply retrieves the amount of tokens that can still be minted
+	// before the supply reaches max_supply.
+	RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error)
+	// ProjectedSchedule simulates the inflation schedule forward from the
+	// current period and returns the projected provisions, supply and inflation
+	// rate of each period.
+	ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error)
+	// BondingFactor retrieves the bonding incentive factor applied to the
+	// current epoch mint provision and the one targeted by the bonded ratio.
+	BondingFactor(ctx context.Context, in *QueryBondingFactorRequest, opts ...grpc.CallOption) (*QueryBondingFactorResponse, error)
+	// InflationPause retrieves whether the inflation is paused and the heights
+	// of its latest pause and resume.
+	InflationPause(ctx context.Context, in *QueryInflationPauseRequest, opts ...grpc.CallOption) (*QueryInflationPauseResponse, error)
 }
 
 type queryClient struct {
@@ -108,6 +126,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error) {
+	out := new(QueryRemainingSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_RemainingSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error) {
+	out := new(QueryProjectedScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BondingFactor(ctx context.Context, in *QueryBondingFactorRequest, opts ...grpc.CallOption) (*QueryBondingFactorResponse, error) {
+	out := new(QueryBondingFactorResponse)
+	err := c.cc.Invoke(ctx, Query_BondingFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationPause(ctx context.Context, in *QueryInflationPauseRequest, opts ...grpc.CallOption) (*QueryInflationPauseResponse, error) {
+	out := new(QueryInflationPauseResponse)
+	err := c.cc.Invoke(ctx, Query_InflationPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -118,13 +172,27 @@ type QueryServer interface {
 	EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error)
 	// SkippedEpochs retrieves the total number of skipped epochs.
 	SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error)
-	// CirculatingSupply retrieves the total number of tokens that are in
-	// circulation (i.e. excluding unvested tokens).
+	// CirculatingSupply retrieves the total supply and the number of tokens that
+	// are in circulation (i.e. excluding module accounts, excluded addresses and
+	// unvested tokens).
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RemainingSupply retrieves the amount of tokens that can still be minted
+	// before the supply reaches max_supply.
+	RemainingSupply(context.Context, *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error)
+	// ProjectedSchedule simulates the inflation schedule forward from the
+	// current period and returns the projected provisions, supply and inflation
+	// rate of each period.
+	ProjectedSchedule(context.Context, *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error)
+	// BondingFactor retrieves the bonding incentive factor applied to the
+	// current epoch mint provision and the one targeted by the bonded ratio.
+	BondingFactor(context.Context, *QueryBondingFactorRequest) (*QueryBondingFactorResponse, error)
+	// InflationPause retrieves whether the inflation is paused and the heights
+	// of its latest pause and resume.
+	InflationPause(context.Context, *QueryInflationPauseRequest) (*QueryInflationPauseResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -150,6 +218,18 @@ func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRa
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) RemainingSupply(context.Context, *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingSupply not implemented")
+}
+func (UnimplementedQueryServer) ProjectedSchedule(context.Context, *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSchedule not implemented")
+}
+func (UnimplementedQueryServer) BondingFactor(context.Context, *QueryBondingFactorRequest) (*QueryBondingFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondingFactor not implemented")
+}
+func (UnimplementedQueryServer) InflationPause(context.Context, *QueryInflationPauseRequest) (*QueryInflationPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationPause not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +351,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RemainingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemainingSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingSupply(ctx, req.(*QueryRemainingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSchedule(ctx, req.(*QueryProjectedScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BondingFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondingFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondingFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BondingFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondingFactor(ctx, req.(*QueryBondingFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InflationPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationPause(ctx, req.(*QueryInflationPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,6 +454,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RemainingSupply",
+			Handler:    _Query_RemainingSupply_Handler,
+		},
+		{
+			MethodName: "ProjectedSchedule",
+			Handler:    _Query_ProjectedSchedule_Handler,
+		},
+		{
+			MethodName: "BondingFactor",
+			Handler:    _Query_BondingFactor_Handler,
+		},
+		{
+			MethodName: "InflationPause",
+			Handler:    _Query_InflationPause_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/inflation/v1/query.proto",
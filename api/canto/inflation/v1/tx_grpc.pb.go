@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/canto.inflation.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName    = "/canto.inflation.v1.Msg/UpdateParams"
+	Msg_PauseInflation_FullMethodName  = "/canto.inflation.v1.Msg/PauseInflation"
+	Msg_ResumeInflation_FullMethodName = "/canto.inflation.v1.Msg/ResumeInflation"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +30,12 @@ const (
 type MsgClient interface {
 	// UpdateParams updates the parameters of the x/inflation module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PauseInflation stops the inflation minting and counts the skipped epochs
+	// until it is resumed.
+	PauseInflation(ctx context.Context, in *MsgPauseInflation, opts ...grpc.CallOption) (*MsgPauseInflationResponse, error)
+	// ResumeInflation resumes the inflation minting and optionally mints the
+	// provisions of the epochs skipped while paused.
+	ResumeInflation(ctx context.Context, in *MsgResumeInflation, opts ...grpc.CallOption) (*MsgResumeInflationResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +55,36 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PauseInflation(ctx context.Context, in *MsgPauseInflation, opts ...grpc.CallOption) (*MsgPauseInflationResponse, error) {
+	out := new(MsgPauseInflationResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseInflation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeInflation(ctx context.Context, in *MsgResumeInflation, opts ...grpc.CallOption) (*MsgResumeInflationResponse, error) {
+	out := new(MsgResumeInflationResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeInflation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// UpdateParams updates the parameters of the x/inflation module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PauseInflation stops the inflation minting and counts the skipped epochs
+	// until it is resumed.
+	PauseInflation(context.Context, *MsgPauseInflation) (*MsgPauseInflationResponse, error)
+	// ResumeInflation resumes the inflation minting and optionally mints the
+	// provisions of the epochs skipped while paused.
+	ResumeInflation(context.Context, *MsgResumeInflation) (*MsgResumeInflationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +95,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) PauseInflation(context.Context, *MsgPauseInflation) (*MsgPauseInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseInflation not implemented")
+}
+func (UnimplementedMsgServer) ResumeInflation(context.Context, *MsgResumeInflation) (*MsgResumeInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInflation not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +132,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseInflation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseInflation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseInflation(ctx, req.(*MsgPauseInflation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeInflation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeInflation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeInflation(ctx, req.(*MsgResumeInflation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +179,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PauseInflation",
+			Handler:    _Msg_PauseInflation_Handler,
+		},
+		{
+			MethodName: "ResumeInflation",
+			Handler:    _Msg_ResumeInflation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/inflation/v1/tx.proto",
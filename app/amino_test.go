@@ -29,6 +29,7 @@ import (
 
 	coinswapapi "github.com/TucanaProtocol/Tucana/v8/api/canto/coinswap/v1"
 	csrapi "github.com/TucanaProtocol/Tucana/v8/api/canto/csr/v1"
+	erc20api "github.com/TucanaProtocol/Tucana/v8/api/canto/erc20/v1"
 	govshuttleapi "github.com/TucanaProtocol/Tucana/v8/api/canto/govshuttle/v1"
 	inflationapi "github.com/TucanaProtocol/Tucana/v8/api/canto/inflation/v1"
 	onboardingapi "github.com/TucanaProtocol/Tucana/v8/api/canto/onboarding/v1"
@@ -38,6 +39,7 @@ import (
 	csrtypes "github.com/TucanaProtocol/Tucana/v8/x/csr/types"
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle"
 	govshuttletypes "github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation"
@@ -89,6 +91,9 @@ func TestAminoJSON_Equivalence(t *testing.T) {
 		GenType(&csrtypes.MsgUpdateParams{}, &csrapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&csrtypes.Params{}, &csrapi.Params{}, GenOpts.WithDisallowNil()),
 
+		// erc20
+		GenType(&erc20types.MsgConvertERC20{}, &erc20api.MsgConvertERC20{}, GenOpts.WithDisallowNil()),
+
 		// inflation
 		GenType(&inflationtypes.MsgUpdateParams{}, &inflationapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&inflationtypes.Params{}, &inflationapi.Params{}, GenOpts.WithDisallowNil()),
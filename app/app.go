@@ -307,9 +307,10 @@ func NewCanto(
 		},
 	}
 
-	// evm/MsgEthereumTx, erc20/MsgConvertERC20, coinswap/MsgSwapOrder
+	// evm/MsgEthereumTx, erc20/MsgConvertERC20, erc20/MsgConvertERC20s, coinswap/MsgSwapOrder
 	signingOptions.DefineCustomGetSigners(protov2.MessageName(&evmv1.MsgEthereumTx{}), evmtypes.GetSignersFromMsgEthereumTxV2)
 	signingOptions.DefineCustomGetSigners(protov2.MessageName(&erc20v1.MsgConvertERC20{}), erc20types.GetSignersFromMsgConvertERC20V2)
+	signingOptions.DefineCustomGetSigners(protov2.MessageName(&erc20v1.MsgConvertERC20S{}), erc20types.GetSignersFromMsgConvertERC20sV2)
 	signingOptions.DefineCustomGetSigners(
 		protov2.MessageName(&coinswapv1.MsgSwapOrder{}),
 		coinswaptypes.CreateGetSignersFromMsgSwapOrderV2(&signingOptions),
@@ -612,6 +613,13 @@ func NewCanto(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	epochsKeeper := epochskeeper.NewKeeper(
+		appCodec,
+		keys[epochstypes.StoreKey],
+		app.GetSubspace(epochstypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[inflationtypes.StoreKey]),
 		appCodec,
@@ -620,6 +628,7 @@ func NewCanto(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		epochsKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -631,6 +640,7 @@ func NewCanto(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.EvmKeeper,
+		epochsKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -640,6 +650,8 @@ func NewCanto(
 		app.GetSubspace(govshuttletypes.ModuleName),
 		app.AccountKeeper,
 		app.Erc20Keeper,
+		app.BankKeeper,
+		app.DistrKeeper,
 		govKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -655,15 +667,16 @@ func NewCanto(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(
-		appCodec,
-		keys[epochstypes.StoreKey],
-	)
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
 		),
+	).SetEpochReferrers(
+		// insert modules referencing epoch identifiers here
+		app.InflationKeeper.Hooks(),
+		app.Erc20Keeper.Hooks(),
 	)
 
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
@@ -674,6 +687,7 @@ func NewCanto(
 	)
 
 	app.OnboardingKeeper = onboardingkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[onboardingtypes.StoreKey]),
 		app.GetSubspace(onboardingtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -690,12 +704,20 @@ func NewCanto(
 	// NOTE: ICS4 wrapper for Transfer Keeper already set
 
 	// Create Transfer Stack
+	// MsgTransfer is routed through the onboarding transfer msg server, which
+	// converts ERC20-native tokens from the sender's ERC20 balance:
+	// onboarding.Transfer -> transferKeeper.Transfer
+
 	// SendPacket, since it is originating from the application to core IBC:
 	// transferKeeper.SendPacket -> onboarding.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the otherway
 	// channel.RecvPacket -> onboarding.OnRecvPacket -> transfer.OnRecvPacket
 
+	// Acknowledgements and timeouts refund ERC20-native tokens as ERC20:
+	// channel.AcknowledgePacket -> onboarding.OnAcknowledgementPacket -> transfer.OnAcknowledgementPacket
+	// channel.TimeoutPacket -> onboarding.OnTimeoutPacket -> transfer.OnTimeoutPacket
+
 	// transfer stack contains (from top to bottom):
 	// - Onboarding Middleware
 	// - Transfer
@@ -749,7 +771,7 @@ func NewCanto(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		transfer.NewAppModule(app.TransferKeeper),
+		onboarding.NewTransferAppModule(app.TransferKeeper, *app.OnboardingKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		ibctm.NewAppModule(),
 
@@ -833,6 +855,7 @@ func NewCanto(
 		feemarkettypes.ModuleName,
 		feegrant.ModuleName,
 		onboardingtypes.ModuleName,
+		erc20types.ModuleName,
 		// no-op modules
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
@@ -849,7 +872,6 @@ func NewCanto(
 		upgradetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		inflationtypes.ModuleName,
-		erc20types.ModuleName,
 		govshuttletypes.ModuleName,
 		csrtypes.ModuleName,
 		coinswaptypes.ModuleName,
@@ -1315,6 +1337,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govshuttletypes.ModuleName)
 	paramsKeeper.Subspace(csrtypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
+	paramsKeeper.Subspace(epochstypes.ModuleName)
 
 	keyTable := ibcclienttypes.ParamKeyTable()
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
@@ -2,17 +2,26 @@ package app
 
 import (
 	"testing"
+	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	"github.com/evmos/ethermint/tests"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 	protov2 "google.golang.org/protobuf/proto"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	coinswapv1 "github.com/TucanaProtocol/Tucana/v8/api/canto/coinswap/v1"
 	erc20v1 "github.com/TucanaProtocol/Tucana/v8/api/canto/erc20/v1"
+	"github.com/TucanaProtocol/Tucana/v8/types"
 	coinswaptypes "github.com/TucanaProtocol/Tucana/v8/x/coinswap/types"
 	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 )
@@ -31,6 +40,7 @@ func TestDefineCustomGetSigners(t *testing.T) {
 		},
 	}
 	signingOptions.DefineCustomGetSigners(protov2.MessageName(&erc20v1.MsgConvertERC20{}), erc20types.GetSignersFromMsgConvertERC20V2)
+	signingOptions.DefineCustomGetSigners(protov2.MessageName(&erc20v1.MsgConvertERC20S{}), erc20types.GetSignersFromMsgConvertERC20sV2)
 	signingOptions.DefineCustomGetSigners(protov2.MessageName(&coinswapv1.MsgSwapOrder{}), coinswaptypes.CreateGetSignersFromMsgSwapOrderV2(&signingOptions))
 
 	ctx, err := signing.NewContext(signingOptions)
@@ -49,6 +59,20 @@ func TestDefineCustomGetSigners(t *testing.T) {
 			},
 			want: [][]byte{accAddr.Bytes()},
 		},
+		{
+			name: "MsgConvertERC20 invalid sender",
+			msg: &erc20v1.MsgConvertERC20{
+				Sender: addr,
+			},
+			wantErr: true,
+		},
+		{
+			name: "MsgConvertERC20s",
+			msg: &erc20v1.MsgConvertERC20S{
+				Sender: common.BytesToAddress(accAddr.Bytes()).String(),
+			},
+			want: [][]byte{accAddr.Bytes()},
+		},
 		{
 			name: "MsgSwapOrder",
 			msg: &coinswapv1.MsgSwapOrder{
@@ -69,3 +93,50 @@ func TestDefineCustomGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgConvertERC20Authz(t *testing.T) {
+	app := Setup(false, feemarkettypes.DefaultGenesisState())
+	ctx := app.NewContextLegacy(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	grantee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := erc20types.NewMsgConvertERC20(
+		sdkmath.NewInt(100), granter, tests.GenerateAddress(), common.BytesToAddress(granter),
+	)
+
+	signers, _, err := app.AppCodec().GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{granter.Bytes()}, signers)
+
+	// the grantee can't convert the granter tokens without a grant
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.ErrorIs(t, err, authz.ErrNoAuthorizationFound)
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = app.AuthzKeeper.SaveGrant(
+		ctx, grantee, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), &expiration,
+	)
+	require.NoError(t, err)
+
+	// the granted message reaches the erc20 handler
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	require.ErrorIs(t, err, erc20types.ErrTokenPairNotFound)
+}
+
+func TestMsgConvertERC20EIP712(t *testing.T) {
+	// Setup registers the app encoding config on the EIP-712 codecs
+	Setup(true, nil)
+
+	sender := common.BytesToAddress(tests.GenerateAddress().Bytes())
+	msg := erc20types.NewMsgConvertERC20(
+		sdkmath.NewInt(100), sender.Bytes(), tests.GenerateAddress(), sender,
+	)
+
+	fee := legacytx.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("atuc", 1)))
+	signDoc := legacytx.StdSignBytes(types.MainnetChainID+"-1", 1, 0, 0, fee, []sdk.Msg{msg}, "")
+
+	typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
+	require.NoError(t, err)
+	require.Equal(t, "Tx", typedData.PrimaryType)
+	require.Contains(t, string(signDoc), "canto/MsgConvertERC20")
+}
@@ -49,6 +49,7 @@ import (
 	cmdcfg "github.com/TucanaProtocol/Tucana/v8/cmd/config"
 	cantokr "github.com/TucanaProtocol/Tucana/v8/crypto/keyring"
 	"github.com/TucanaProtocol/Tucana/v8/testutil/network"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 )
 
 var (
@@ -426,6 +427,12 @@ func initGenFiles(
 	evmGenState.Params.EvmDenom = coinDenom
 	appGenState[evmtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&evmGenState)
 
+	var erc20GenState erc20types.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[erc20types.ModuleName], &erc20GenState)
+
+	erc20GenState.Params.RegistrationDeposit = erc20types.DefaultRegistrationDeposit(coinDenom)
+	appGenState[erc20types.ModuleName] = clientCtx.Codec.MustMarshalJSON(&erc20GenState)
+
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
@@ -26,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdHookFailures(),
+		GetCmdEpochHistory(),
+		GetCmdNextEpochEnd(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -107,3 +111,148 @@ func GetCmdCurrentEpoch() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdHookFailures provides the number of failed hook executions per module
+func GetCmdHookFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-failures",
+		Short: "Query the number of failed epoch hook executions of each module",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs hook-failures`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HookFailures(cmd.Context(), &types.QueryHookFailuresRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEpochHistory provides the boundaries of the recently ended epochs by
+// specified identifier
+func GetCmdEpochHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-history [identifier]",
+		Short: "Query the start and end heights and times of the recently ended epochs by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs epoch-history week --reverse`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochHistory(cmd.Context(), &types.QueryEpochHistoryRequest{
+				Identifier: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch history")
+
+	return cmd
+}
+
+// GetCmdNextEpochEnd provides the expected end of the current epoch by
+// specified identifier
+func GetCmdNextEpochEnd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch-end [identifier]",
+		Short: "Query the expected end time and height of the current epoch by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs next-epoch-end week`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextEpochEnd(cmd.Context(), &types.QueryNextEpochEndRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams provides the epochs module params
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current epochs parameters",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs params`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,300 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+var (
+	FlagAuthority     = "authority"
+	FlagStartTime     = "start-time"
+	FlagStartHeight   = "start-height"
+	FlagCatchUpPolicy = "catch-up-policy"
+)
+
+// blocksSuffix marks an epoch duration given as a number of blocks
+const blocksSuffix = "blocks"
+
+// NewTxCmd returns a root CLI command handler for epochs transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "epochs subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateEpochProposalCmd(),
+		NewUpdateEpochDurationProposalCmd(),
+		NewUpdateEpochCatchUpPolicyProposalCmd(),
+		NewDeleteEpochProposalCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateEpochProposalCmd implements the command to submit a create-epoch proposal
+func NewCreateEpochProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-epoch [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to create a new epoch",
+		Long: `Submit a proposal to create a new epoch along with an initial deposit.
+The duration is either a time duration or a number of blocks suffixed with "blocks" for a height-based epoch.
+A time-based epoch starts counting at the given start time (RFC3339), which defaults to the block time of the proposal execution.
+A height-based epoch starts counting at the given start height, which defaults to the height of the proposal execution.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal create-epoch month 720h --start-time 2024-01-01T00:00:00Z
+$ %s tx gov submit-proposal create-epoch rewards 100000blocks --start-height 5000000`, version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			duration, durationBlocks, err := parseEpochDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			var startTime time.Time
+			startTimeStr, _ := cmd.Flags().GetString(FlagStartTime)
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid start time %s: %w", startTimeStr, err)
+				}
+			}
+
+			startHeight, _ := cmd.Flags().GetInt64(FlagStartHeight)
+
+			catchUpPolicyStr, _ := cmd.Flags().GetString(FlagCatchUpPolicy)
+			catchUpPolicy, err := parseCatchUpPolicy(catchUpPolicyStr)
+			if err != nil {
+				return err
+			}
+
+			authority, err := ReadAuthorityFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgCreateEpoch{
+					Authority:      authority,
+					Identifier:     args[0],
+					StartTime:      startTime,
+					Duration:       duration,
+					DurationBlocks: durationBlocks,
+					StartHeight:    startHeight,
+					CatchUpPolicy:  catchUpPolicy,
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit create epoch proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	cmd.Flags().String(FlagStartTime, "", "start time of the first epoch (RFC3339), defaults to the proposal execution time")
+	cmd.Flags().Int64(FlagStartHeight, 0, "start height of the first height-based epoch, defaults to the proposal execution height")
+	cmd.Flags().String(FlagCatchUpPolicy, "fire-each", "catch-up policy on the epochs missed during a chain halt (fire-each|skip|fire-once)")
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateEpochDurationProposalCmd implements the command to submit an update-epoch-duration proposal
+func NewUpdateEpochDurationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-epoch-duration [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to update the duration of an epoch",
+		Long: `Submit a proposal to update the duration of an epoch along with an initial deposit. The new duration already applies to the running epoch.
+The duration is either a time duration or a number of blocks suffixed with "blocks" for a height-based epoch.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-epoch-duration week 168h", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			duration, durationBlocks, err := parseEpochDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			authority, err := ReadAuthorityFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgUpdateEpochDuration{
+					Authority:      authority,
+					Identifier:     args[0],
+					Duration:       duration,
+					DurationBlocks: durationBlocks,
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit update epoch duration proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateEpochCatchUpPolicyProposalCmd implements the command to submit an update-epoch-catch-up-policy proposal
+func NewUpdateEpochCatchUpPolicyProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-epoch-catch-up-policy [identifier] [policy]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to update the catch-up policy of an epoch",
+		Long: `Submit a proposal to update how an epoch catches up on the epochs missed while the chain was halted along with an initial deposit.
+The policy is one of:
+  fire-each: end one missed epoch per block and fire the hooks for each of them
+  skip:      skip the missed epochs and realign the epoch on its start time grid
+  fire-once: fire the epoch end hooks once for all the missed epochs and realign the epoch on its start time grid`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-epoch-catch-up-policy day fire-once", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			catchUpPolicy, err := parseCatchUpPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			authority, err := ReadAuthorityFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgUpdateEpochCatchUpPolicy{
+					Authority:     authority,
+					Identifier:    args[0],
+					CatchUpPolicy: catchUpPolicy,
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit update epoch catch-up policy proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteEpochProposalCmd implements the command to submit a delete-epoch proposal
+func NewDeleteEpochProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-epoch [identifier]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to delete an epoch",
+		Long:    "Submit a proposal to delete an epoch along with an initial deposit. Epochs referenced by a module, such as the inflation epoch, cannot be deleted.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal delete-epoch hour", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := ReadAuthorityFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgDeleteEpoch{
+					Authority:  authority,
+					Identifier: args[0],
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit delete epoch proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseEpochDuration parses either a time duration (e.g. 720h) or a number of
+// blocks of a height-based epoch (e.g. 1000blocks)
+func parseEpochDuration(arg string) (time.Duration, int64, error) {
+	if blocks, ok := strings.CutSuffix(arg, blocksSuffix); ok {
+		durationBlocks, err := strconv.ParseInt(blocks, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid epoch duration blocks %s: %w", arg, err)
+		}
+		return 0, durationBlocks, nil
+	}
+
+	duration, err := time.ParseDuration(arg)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid epoch duration %s: %w", arg, err)
+	}
+	return duration, 0, nil
+}
+
+// parseCatchUpPolicy parses a catch-up policy from its short name (e.g.
+// fire-once) or its enum name (e.g. CATCH_UP_POLICY_FIRE_ONCE)
+func parseCatchUpPolicy(arg string) (types.CatchUpPolicy, error) {
+	name := strings.ToUpper(strings.ReplaceAll(arg, "-", "_"))
+	if !strings.HasPrefix(name, "CATCH_UP_POLICY_") {
+		name = "CATCH_UP_POLICY_" + name
+	}
+
+	policy, ok := types.CatchUpPolicy_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid catch-up policy %s, expected one of fire-each, skip or fire-once", arg)
+	}
+	return types.CatchUpPolicy(policy), nil
+}
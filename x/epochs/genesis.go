@@ -12,6 +12,8 @@ import (
 // InitGenesis initializes the epochs module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	// set epoch info from genesis
 	for _, epoch := range genState.Epochs {
 		// Initialize empty epoch values via Cosmos SDK
@@ -23,11 +25,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetEpochInfo(ctx, epoch)
 	}
+
+	// set epoch history from genesis
+	for _, boundary := range genState.EpochHistory {
+		k.SetEpochBoundary(ctx, boundary)
+	}
 }
 
 // ExportGenesis returns the epochs module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Epochs: k.AllEpochInfos(ctx),
+		Params:       k.GetParams(ctx),
+		Epochs:       k.AllEpochInfos(ctx),
+		EpochHistory: k.AllEpochHistory(ctx),
 	}
 }
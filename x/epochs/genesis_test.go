@@ -42,6 +42,8 @@ func TestEpochsExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.Epochs[1].CurrentEpochStartHeight, chainStartHeight)
 	require.Equal(t, genesis.Epochs[1].CurrentEpochStartTime, chainStartTime)
 	require.Equal(t, genesis.Epochs[1].EpochCountingStarted, false)
+	require.Equal(t, types.DefaultParams(), genesis.Params)
+	require.Empty(t, genesis.EpochHistory)
 }
 
 func TestEpochsInitGenesis(t *testing.T) {
@@ -90,6 +92,17 @@ func TestEpochsInitGenesis(t *testing.T) {
 	require.EqualError(t, genesisState.Validate(), "duplicated epoch entry monthly")
 
 	genesisState = types.GenesisState{
+		Params: types.NewParams(10),
+		EpochHistory: []types.EpochBoundary{
+			{
+				Identifier:  "monthly",
+				EpochNumber: 1,
+				StartHeight: 1,
+				StartTime:   now.Add(-time.Hour * 24),
+				EndHeight:   1,
+				EndTime:     now,
+			},
+		},
 		Epochs: []types.EpochInfo{
 			{
 				Identifier:              "monthly",
@@ -113,4 +126,10 @@ func TestEpochsInitGenesis(t *testing.T) {
 	require.Equal(t, epochInfo.CurrentEpochStartHeight, ctx.BlockHeight())
 	require.Equal(t, epochInfo.CurrentEpochStartTime.UTC().String(), time.Time{}.String())
 	require.Equal(t, epochInfo.EpochCountingStarted, true)
+	require.Equal(t, types.NewParams(10), app.EpochsKeeper.GetParams(ctx))
+
+	exported := epochs.ExportGenesis(ctx, app.EpochsKeeper)
+	require.Len(t, exported.EpochHistory, 1)
+	require.Equal(t, int64(1), exported.EpochHistory[0].EpochNumber)
+	require.Equal(t, now, exported.EpochHistory[0].EndTime.UTC())
 }
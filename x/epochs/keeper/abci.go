@@ -19,30 +19,58 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	logger := k.Logger(sdkCtx)
 	k.IterateEpochInfo(sdkCtx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
 		// Has it not started, and is the block time > initial epoch start time
-		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(sdkCtx.BlockTime())
-
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochEnd := sdkCtx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(sdkCtx.BlockTime())
+		// (or the block height >= start height for height-based epochs)
+		shouldInitialEpochStart := epochInfo.ShouldStartInitialEpoch(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		shouldEpochEnd := epochInfo.ShouldEndEpoch(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		endedEpochs := epochInfo.EndedEpochs(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		boundary := types.NewEpochBoundary(epochInfo, sdkCtx.BlockHeight(), sdkCtx.BlockTime())
 
 		epochInfo.CurrentEpochStartHeight = sdkCtx.BlockHeight()
 
 		switch {
 		case shouldInitialEpochStart:
 			epochInfo.StartInitialEpoch()
+			if epochInfo.IsHeightBased() {
+				epochInfo.CurrentEpochStartTime = sdkCtx.BlockTime()
+			}
 
 			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
 		case shouldEpochEnd:
-			epochInfo.EndEpoch()
+			k.RecordEpochBoundary(sdkCtx, boundary)
+
+			// Catch up on the epochs missed while the chain was halted
+			// according to the epoch catch-up policy
+			skippedEpochs, fireEpochEnd := int64(0), true
+			switch {
+			case endedEpochs <= 1 || epochInfo.CatchUpPolicy == types.CATCH_UP_POLICY_FIRE_EACH:
+				epochInfo.EndEpoch()
+			case epochInfo.CatchUpPolicy == types.CATCH_UP_POLICY_FIRE_ONCE:
+				skippedEpochs = endedEpochs - 1
+				epochInfo.RealignEpoch(endedEpochs)
+			default:
+				skippedEpochs, fireEpochEnd = endedEpochs, false
+				epochInfo.RealignEpoch(endedEpochs)
+			}
+			if epochInfo.IsHeightBased() {
+				epochInfo.CurrentEpochStartTime = sdkCtx.BlockTime()
+			}
 
-			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
+			logger.Info("ending epoch", "identifier", epochInfo.Identifier, "skipped-epochs", skippedEpochs)
 
 			sdkCtx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeEpochEnd,
 					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+					sdk.NewAttribute(types.AttributeSkippedEpochs, strconv.FormatInt(skippedEpochs, 10)),
 				),
 			)
-			k.AfterEpochEnd(sdkCtx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+
+			if skippedEpochs > 0 {
+				k.AfterEpochsSkipped(sdkCtx, epochInfo.Identifier, epochInfo.CurrentEpoch, skippedEpochs)
+			}
+			if fireEpochEnd {
+				k.AfterEpochEnd(sdkCtx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			}
 		default:
 			// continue
 			return false
@@ -4,7 +4,12 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs"
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/keeper"
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 )
 
@@ -212,3 +217,264 @@ func (suite *KeeperTestSuite) TestEpochStartingOneMonthAfterInitGenesis() {
 	suite.Require().Equal(epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	suite.Require().Equal(epochInfo.EpochCountingStarted, true)
 }
+
+func (suite *KeeperTestSuite) TestHeightBasedEpochBeginBlocker() {
+	// On init genesis, default epochs information is set
+	// To check init genesis again, should make it fresh status
+	epochInfos := suite.app.EpochsKeeper.AllEpochInfos(suite.ctx)
+	for _, epochInfo := range epochInfos {
+		suite.app.EpochsKeeper.DeleteEpochInfo(suite.ctx, epochInfo.Identifier)
+	}
+
+	now := time.Now()
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	epochs.InitGenesis(suite.ctx, suite.app.EpochsKeeper, types.GenesisState{
+		Epochs: []types.EpochInfo{
+			{
+				Identifier:     "blocks",
+				DurationBlocks: 10,
+				StartHeight:    5,
+			},
+		},
+	})
+
+	// epoch not started before its start height, whatever the block time
+	suite.ctx = suite.ctx.WithBlockHeight(4).WithBlockTime(now.Add(time.Hour * 24 * 365))
+	suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+	epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().True(found)
+	suite.Require().False(epochInfo.EpochCountingStarted)
+
+	// epoch starts at its start height
+	suite.ctx = suite.ctx.WithBlockHeight(5).WithBlockTime(now.Add(time.Hour * 24 * 366))
+	suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().True(epochInfo.EpochCountingStarted)
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+	suite.Require().Equal(int64(5), epochInfo.CurrentEpochStartHeight)
+	suite.Require().Equal(suite.ctx.BlockTime().UTC(), epochInfo.CurrentEpochStartTime.UTC())
+
+	// epoch does not end before its duration blocks, whatever the block time
+	suite.ctx = suite.ctx.WithBlockHeight(14).WithBlockTime(now.Add(time.Hour * 24 * 730))
+	suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+	suite.Require().Equal(int64(5), epochInfo.CurrentEpochStartHeight)
+
+	// epoch ends after its duration blocks
+	suite.ctx = suite.ctx.WithBlockHeight(15).WithBlockTime(now.Add(time.Hour * 24 * 730))
+	suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+	suite.Require().Equal(int64(15), epochInfo.CurrentEpochStartHeight)
+	suite.Require().Equal(suite.ctx.BlockTime().UTC(), epochInfo.CurrentEpochStartTime.UTC())
+
+	// the current epoch query reports the height-based epoch
+	res, err := suite.queryClient.CurrentEpoch(suite.ctx, &types.QueryCurrentEpochRequest{Identifier: "blocks"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), res.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestEpochHistoryBeginBlocker() {
+	epochInfos := suite.app.EpochsKeeper.AllEpochInfos(suite.ctx)
+	for _, epochInfo := range epochInfos {
+		suite.app.EpochsKeeper.DeleteEpochInfo(suite.ctx, epochInfo.Identifier)
+	}
+
+	now := time.Now().UTC()
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	epochs.InitGenesis(suite.ctx, suite.app.EpochsKeeper, types.GenesisState{
+		Params: types.NewParams(2),
+		Epochs: []types.EpochInfo{
+			{
+				Identifier:     "blocks",
+				DurationBlocks: 10,
+				StartHeight:    1,
+			},
+		},
+	})
+
+	// end three epochs of 10 blocks of 5 seconds
+	for height := int64(1); height <= 31; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(now.Add(time.Duration(height-1) * time.Second * 5))
+		suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+	}
+
+	// only the two most recent epochs are kept
+	history := suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, "blocks")
+	suite.Require().Equal([]types.EpochBoundary{
+		{
+			Identifier:  "blocks",
+			EpochNumber: 2,
+			StartHeight: 11,
+			StartTime:   now.Add(time.Second * 50),
+			EndHeight:   21,
+			EndTime:     now.Add(time.Second * 100),
+		},
+		{
+			Identifier:  "blocks",
+			EpochNumber: 3,
+			StartHeight: 21,
+			StartTime:   now.Add(time.Second * 100),
+			EndHeight:   31,
+			EndTime:     now.Add(time.Second * 150),
+		},
+	}, history)
+
+	// the next epoch end is estimated from the average block time
+	res, err := suite.app.EpochsKeeper.NextEpochEnd(suite.ctx, &types.QueryNextEpochEndRequest{Identifier: "blocks"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(4), res.CurrentEpoch)
+	suite.Require().Equal(int64(41), res.EndHeight)
+	suite.Require().Equal(time.Second*5, res.AverageBlockTime)
+	suite.Require().Equal(now.Add(time.Second*200), res.EndTime.UTC())
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerWithoutParams() {
+	epochInfos := suite.app.EpochsKeeper.AllEpochInfos(suite.ctx)
+	for _, epochInfo := range epochInfos {
+		suite.app.EpochsKeeper.DeleteEpochInfo(suite.ctx, epochInfo.Identifier)
+	}
+
+	now := time.Now().UTC()
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(now)
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, types.EpochInfo{
+		Identifier:     "blocks",
+		DurationBlocks: 10,
+		StartHeight:    1,
+	})
+
+	// remove the params, as on a chain upgraded from consensus version 1
+	paramsStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Delete(types.ParamStoreKeyEpochHistoryLength)
+	suite.Require().Panics(func() { suite.app.EpochsKeeper.GetParams(suite.ctx) })
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.EpochsKeeper).Migrate1to2(suite.ctx))
+	suite.Require().Equal(types.DefaultParams(), suite.app.EpochsKeeper.GetParams(suite.ctx))
+
+	// end the first epoch
+	for height := int64(1); height <= 11; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(now.Add(time.Duration(height-1) * time.Second))
+		suite.Require().NotPanics(func() {
+			suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+		})
+	}
+
+	suite.Require().Len(suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, "blocks"), 1)
+}
+
+// hookCall records a call to the epoch hooks
+type hookCall struct {
+	hook          string
+	epochNumber   int64
+	skippedEpochs int64
+}
+
+var _ types.EpochHooks = &recordingEpochHooks{}
+
+// recordingEpochHooks records the calls to the epoch hooks
+type recordingEpochHooks struct {
+	calls []hookCall
+}
+
+func (h *recordingEpochHooks) AfterEpochEnd(_ sdk.Context, _ string, epochNumber int64) error {
+	h.calls = append(h.calls, hookCall{"after_epoch_end", epochNumber, 0})
+	return nil
+}
+
+func (h *recordingEpochHooks) BeforeEpochStart(_ sdk.Context, _ string, epochNumber int64) error {
+	h.calls = append(h.calls, hookCall{"before_epoch_start", epochNumber, 0})
+	return nil
+}
+
+func (h *recordingEpochHooks) AfterEpochsSkipped(_ sdk.Context, _ string, epochNumber, skippedEpochs int64) error {
+	h.calls = append(h.calls, hookCall{"after_epochs_skipped", epochNumber, skippedEpochs})
+	return nil
+}
+
+func (h *recordingEpochHooks) GetModuleName() string {
+	return "recording"
+}
+
+func (suite *KeeperTestSuite) TestCatchUpPolicyBeginBlocker() {
+	day := time.Hour * 24
+
+	testCases := []struct {
+		name                     string
+		policy                   types.CatchUpPolicy
+		expCalls                 []hookCall
+		expCurrentEpoch          int64
+		expCurrentEpochStartTime time.Duration
+	}{
+		{
+			"fire each missed epoch on consecutive blocks",
+			types.CATCH_UP_POLICY_FIRE_EACH,
+			[]hookCall{
+				{"after_epoch_end", 2, 0},
+				{"before_epoch_start", 2, 0},
+			},
+			2,
+			day,
+		},
+		{
+			"skip missed epochs and realign",
+			types.CATCH_UP_POLICY_SKIP,
+			[]hookCall{
+				{"after_epochs_skipped", 4, 3},
+				{"before_epoch_start", 4, 0},
+			},
+			4,
+			3 * day,
+		},
+		{
+			"fire once and realign",
+			types.CATCH_UP_POLICY_FIRE_ONCE,
+			[]hookCall{
+				{"after_epochs_skipped", 4, 2},
+				{"after_epoch_end", 4, 0},
+				{"before_epoch_start", 4, 0},
+			},
+			4,
+			3 * day,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			hooks := &recordingEpochHooks{}
+			k := keeper.NewKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName), "")
+			k.SetHooks(hooks)
+			for _, epochInfo := range k.AllEpochInfos(suite.ctx) {
+				k.DeleteEpochInfo(suite.ctx, epochInfo.Identifier)
+			}
+
+			now := time.Now().UTC()
+			k.SetEpochInfo(suite.ctx, types.EpochInfo{
+				Identifier:              types.DayEpochID,
+				StartTime:               now,
+				Duration:                day,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   now,
+				CurrentEpochStartHeight: 1,
+				EpochCountingStarted:    true,
+				CatchUpPolicy:           tc.policy,
+			})
+
+			// the chain halts for three and a half epochs
+			ctx := suite.ctx.WithBlockHeight(2).WithBlockTime(now.Add(3*day + 12*time.Hour))
+			suite.Require().NoError(k.BeginBlocker(ctx))
+
+			suite.Require().Equal(tc.expCalls, hooks.calls)
+
+			epochInfo, found := k.GetEpochInfo(ctx, types.DayEpochID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expCurrentEpoch, epochInfo.CurrentEpoch)
+			suite.Require().Equal(now.Add(tc.expCurrentEpochStartTime), epochInfo.CurrentEpochStartTime.UTC())
+			suite.Require().Equal(int64(2), epochInfo.CurrentEpochStartHeight)
+		})
+	}
+}
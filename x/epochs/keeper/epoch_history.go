@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+// SetEpochBoundary stores the boundaries of an ended epoch
+func (k Keeper) SetEpochBoundary(ctx sdk.Context, boundary types.EpochBoundary) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(boundary.Identifier))
+	bz := k.cdc.MustMarshal(&boundary)
+	store.Set(types.GetEpochBoundaryKey(boundary.EpochNumber), bz)
+}
+
+// RecordEpochBoundary stores the boundaries of an ended epoch and prunes the
+// boundaries of the epoch identifier beyond the epoch history length
+func (k Keeper) RecordEpochBoundary(ctx sdk.Context, boundary types.EpochBoundary) {
+	historyLength := k.GetParams(ctx).EpochHistoryLength
+	if historyLength > 0 {
+		k.SetEpochBoundary(ctx, boundary)
+	}
+	k.PruneEpochHistory(ctx, boundary.Identifier, boundary.EpochNumber, historyLength)
+}
+
+// PruneEpochHistory deletes the boundaries of the epoch identifier that ended
+// historyLength or more epochs before the given epoch number. Only the deleted
+// boundaries are iterated, from the lowest epoch number.
+func (k Keeper) PruneEpochHistory(ctx sdk.Context, identifier string, epochNumber int64, historyLength uint64) {
+	if epochNumber <= 0 || historyLength >= uint64(epochNumber) {
+		// no boundary is older than the history
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(identifier))
+
+	// the boundaries from the oldest kept epoch number on fit in the history
+	oldestKept := epochNumber - int64(historyLength) + 1
+	iterator := store.Iterator(nil, types.GetEpochBoundaryKey(oldestKept))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// DeleteEpochHistory deletes every boundary of the epoch identifier
+func (k Keeper) DeleteEpochHistory(ctx sdk.Context, identifier string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(identifier))
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateEpochHistory iterates through the boundaries of the epoch identifier
+// in ascending epoch number order
+func (k Keeper) IterateEpochHistory(ctx sdk.Context, identifier string, fn func(boundary types.EpochBoundary) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(identifier))
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var boundary types.EpochBoundary
+		k.cdc.MustUnmarshal(iterator.Value(), &boundary)
+
+		if fn(boundary) {
+			break
+		}
+	}
+}
+
+// GetEpochHistory returns the stored boundaries of the epoch identifier
+func (k Keeper) GetEpochHistory(ctx sdk.Context, identifier string) []types.EpochBoundary {
+	history := []types.EpochBoundary{}
+	k.IterateEpochHistory(ctx, identifier, func(boundary types.EpochBoundary) (stop bool) {
+		history = append(history, boundary)
+		return false
+	})
+	return history
+}
+
+// AllEpochHistory returns the stored boundaries of every epoch
+func (k Keeper) AllEpochHistory(ctx sdk.Context) []types.EpochBoundary {
+	history := []types.EpochBoundary{}
+	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		history = append(history, k.GetEpochHistory(ctx, epochInfo.Identifier)...)
+		return false
+	})
+	return history
+}
+
+// AverageBlockTime returns the average block time over the span of the stored
+// boundaries of the epoch identifier. It falls back to the average block time
+// of the current epoch and returns zero if no block was produced in either.
+func (k Keeper) AverageBlockTime(ctx sdk.Context, epochInfo types.EpochInfo) time.Duration {
+	var (
+		elapsed time.Duration
+		blocks  int64
+	)
+
+	history := k.GetEpochHistory(ctx, epochInfo.Identifier)
+	if len(history) > 0 {
+		first, last := history[0], history[len(history)-1]
+		elapsed = last.EndTime.Sub(first.StartTime)
+		blocks = last.EndHeight - first.StartHeight
+	}
+
+	if blocks <= 0 && epochInfo.EpochCountingStarted {
+		elapsed = ctx.BlockTime().Sub(epochInfo.CurrentEpochStartTime)
+		blocks = ctx.BlockHeight() - epochInfo.CurrentEpochStartHeight
+	}
+
+	if blocks <= 0 || elapsed <= 0 {
+		return 0
+	}
+
+	return elapsed / time.Duration(blocks)
+}
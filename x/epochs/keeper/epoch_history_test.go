@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordEpochBoundary() {
+	suite.SetupTest()
+
+	now := time.Now().UTC()
+	boundary := func(identifier string, epochNumber int64) types.EpochBoundary {
+		return types.EpochBoundary{
+			Identifier:  identifier,
+			EpochNumber: epochNumber,
+			StartHeight: epochNumber * 10,
+			StartTime:   now.Add(time.Duration(epochNumber) * time.Hour),
+			EndHeight:   (epochNumber + 1) * 10,
+			EndTime:     now.Add(time.Duration(epochNumber+1) * time.Hour),
+		}
+	}
+
+	suite.app.EpochsKeeper.SetParams(suite.ctx, types.NewParams(3))
+
+	for i := int64(1); i <= 5; i++ {
+		suite.app.EpochsKeeper.RecordEpochBoundary(suite.ctx, boundary(types.DayEpochID, i))
+	}
+	suite.app.EpochsKeeper.RecordEpochBoundary(suite.ctx, boundary(types.WeekEpochID, 1))
+
+	// only the most recent epochs are kept, per identifier
+	history := suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, types.DayEpochID)
+	suite.Require().Equal([]types.EpochBoundary{
+		boundary(types.DayEpochID, 3),
+		boundary(types.DayEpochID, 4),
+		boundary(types.DayEpochID, 5),
+	}, history)
+	suite.Require().Len(suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, types.WeekEpochID), 1)
+
+	// a shorter history length prunes the history on the next epoch end
+	suite.app.EpochsKeeper.SetParams(suite.ctx, types.NewParams(1))
+	suite.app.EpochsKeeper.RecordEpochBoundary(suite.ctx, boundary(types.DayEpochID, 6))
+	history = suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, types.DayEpochID)
+	suite.Require().Equal([]types.EpochBoundary{boundary(types.DayEpochID, 6)}, history)
+
+	// a zero history length disables the history
+	suite.app.EpochsKeeper.SetParams(suite.ctx, types.NewParams(0))
+	suite.app.EpochsKeeper.RecordEpochBoundary(suite.ctx, boundary(types.DayEpochID, 7))
+	suite.Require().Empty(suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, types.DayEpochID))
+
+	// the history of an epoch is deleted along with it
+	suite.app.EpochsKeeper.DeleteEpochHistory(suite.ctx, types.WeekEpochID)
+	suite.Require().Empty(suite.app.EpochsKeeper.AllEpochHistory(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochHistory() {
+	suite.SetupTest()
+
+	epochNumbers := func() []int64 {
+		var numbers []int64
+		for _, boundary := range suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, types.DayEpochID) {
+			numbers = append(numbers, boundary.EpochNumber)
+		}
+		return numbers
+	}
+
+	for _, epochNumber := range []int64{1, 2, 3, 7, 9, 10} {
+		suite.app.EpochsKeeper.SetEpochBoundary(suite.ctx, types.EpochBoundary{
+			Identifier:  types.DayEpochID,
+			EpochNumber: epochNumber,
+		})
+	}
+
+	// a history longer than the epoch number keeps every boundary
+	suite.app.EpochsKeeper.PruneEpochHistory(suite.ctx, types.DayEpochID, 10, 10)
+	suite.app.EpochsKeeper.PruneEpochHistory(suite.ctx, types.DayEpochID, 10, math.MaxUint64)
+	suite.Require().Equal([]int64{1, 2, 3, 7, 9, 10}, epochNumbers())
+
+	// only the boundaries of the last epochs are kept, even with missing epochs
+	suite.app.EpochsKeeper.PruneEpochHistory(suite.ctx, types.DayEpochID, 10, 4)
+	suite.Require().Equal([]int64{7, 9, 10}, epochNumbers())
+
+	suite.app.EpochsKeeper.PruneEpochHistory(suite.ctx, types.DayEpochID, 10, 0)
+	suite.Require().Empty(epochNumbers())
+}
+
+func (suite *KeeperTestSuite) TestAverageBlockTime() {
+	suite.SetupTest()
+
+	now := time.Now().UTC()
+	epochInfo := types.EpochInfo{
+		Identifier:              types.DayEpochID,
+		Duration:                time.Hour * 24,
+		CurrentEpoch:            3,
+		CurrentEpochStartHeight: 200,
+		CurrentEpochStartTime:   now,
+		EpochCountingStarted:    true,
+	}
+
+	// falls back to the current epoch without history
+	suite.ctx = suite.ctx.WithBlockHeight(250).WithBlockTime(now.Add(time.Second * 250))
+	suite.Require().Equal(time.Second*5, suite.app.EpochsKeeper.AverageBlockTime(suite.ctx, epochInfo))
+
+	// zero when no block was produced
+	suite.ctx = suite.ctx.WithBlockHeight(200).WithBlockTime(now)
+	suite.Require().Equal(time.Duration(0), suite.app.EpochsKeeper.AverageBlockTime(suite.ctx, epochInfo))
+
+	// averaged over the span of the epoch history
+	suite.app.EpochsKeeper.SetEpochBoundary(suite.ctx, types.EpochBoundary{
+		Identifier:  types.DayEpochID,
+		EpochNumber: 1,
+		StartHeight: 0,
+		StartTime:   now.Add(-time.Second * 600),
+		EndHeight:   100,
+		EndTime:     now.Add(-time.Second * 300),
+	})
+	suite.app.EpochsKeeper.SetEpochBoundary(suite.ctx, types.EpochBoundary{
+		Identifier:  types.DayEpochID,
+		EpochNumber: 2,
+		StartHeight: 100,
+		StartTime:   now.Add(-time.Second * 300),
+		EndHeight:   200,
+		EndTime:     now,
+	})
+	suite.Require().Equal(time.Second*3, suite.app.EpochsKeeper.AverageBlockTime(suite.ctx, epochInfo))
+}
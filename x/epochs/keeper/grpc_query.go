@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,3 +67,141 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// HookFailures provides the number of failed hook executions of each hook
+// receiver
+func (k Keeper) HookFailures(
+	c context.Context,
+	req *types.QueryHookFailuresRequest,
+) (*types.QueryHookFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHookFailuresResponse{
+		HookFailures: k.AllHookFailures(ctx),
+	}, nil
+}
+
+// EpochHistory provides the boundaries of the recently ended epochs of
+// specified identifier
+func (k Keeper) EpochHistory(
+	c context.Context,
+	req *types.QueryEpochHistoryRequest,
+) (*types.QueryEpochHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	var history []types.EpochBoundary
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(req.Identifier))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var boundary types.EpochBoundary
+		if err := k.cdc.Unmarshal(value, &boundary); err != nil {
+			return err
+		}
+		history = append(history, boundary)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochHistoryResponse{
+		EpochHistory: history,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// NextEpochEnd provides the expected end of the current epoch of specified
+// identifier. The end height of a time-based epoch and the end time of a
+// height-based epoch are estimated from the average block time.
+func (k Keeper) NextEpochEnd(
+	c context.Context,
+	req *types.QueryNextEpochEndRequest,
+) (*types.QueryNextEpochEndResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	blockHeight, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+	averageBlockTime := k.AverageBlockTime(ctx, info)
+
+	// the first epoch ends next if the epoch counting has not started
+	currentEpoch := info.CurrentEpoch
+	if !info.EpochCountingStarted {
+		currentEpoch = 1
+	}
+
+	var (
+		endTime   time.Time
+		endHeight int64
+	)
+
+	if info.IsHeightBased() {
+		startHeight := info.CurrentEpochStartHeight
+		if !info.EpochCountingStarted {
+			startHeight = max(info.StartHeight, blockHeight)
+		}
+		endHeight = startHeight + info.DurationBlocks
+
+		endTime = blockTime
+		if remaining := endHeight - blockHeight; remaining > 0 {
+			endTime = blockTime.Add(time.Duration(remaining) * averageBlockTime)
+		}
+	} else {
+		startTime := info.CurrentEpochStartTime
+		if !info.EpochCountingStarted {
+			startTime = info.StartTime
+		}
+		endTime = startTime.Add(info.Duration)
+
+		// the epoch ends on the first block strictly after its end time
+		remaining := endTime.Sub(blockTime)
+		switch {
+		case remaining <= 0:
+			endHeight = blockHeight + 1
+		case averageBlockTime > 0:
+			endHeight = blockHeight + int64(remaining/averageBlockTime) + 1
+		}
+	}
+
+	return &types.QueryNextEpochEndResponse{
+		CurrentEpoch:     currentEpoch,
+		EndTime:          endTime,
+		EndHeight:        endHeight,
+		AverageBlockTime: averageBlockTime,
+	}, nil
+}
+
+// Params returns the epochs module params
+func (k Keeper) Params(
+	c context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
@@ -193,3 +193,195 @@ func (suite *KeeperTestSuite) TestCurrentEpoch() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestHookFailures() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.HookFailures(suite.ctx, &types.QueryHookFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.HookFailures)
+
+	suite.app.EpochsKeeper.IncrementHookFailures(suite.ctx, "inflation")
+	suite.app.EpochsKeeper.IncrementHookFailures(suite.ctx, "inflation")
+
+	res, err = suite.queryClient.HookFailures(suite.ctx, &types.QueryHookFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.HookFailure{{ModuleName: "inflation", Failures: 2}}, res.HookFailures)
+}
+
+func (suite *KeeperTestSuite) TestEpochHistory() {
+	suite.SetupTest()
+
+	_, err := suite.queryClient.EpochHistory(suite.ctx, &types.QueryEpochHistoryRequest{Identifier: "unknown"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.EpochHistory(suite.ctx, &types.QueryEpochHistoryRequest{Identifier: types.DayEpochID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.EpochHistory)
+
+	now := suite.ctx.BlockTime()
+	var boundaries []types.EpochBoundary
+	for i := int64(1); i <= 3; i++ {
+		boundary := types.EpochBoundary{
+			Identifier:  types.DayEpochID,
+			EpochNumber: i,
+			StartHeight: i * 100,
+			StartTime:   now.Add(time.Duration(i) * time.Hour * 24),
+			EndHeight:   (i + 1) * 100,
+			EndTime:     now.Add(time.Duration(i+1) * time.Hour * 24),
+		}
+		suite.app.EpochsKeeper.SetEpochBoundary(suite.ctx, boundary)
+		boundaries = append(boundaries, boundary)
+	}
+
+	res, err = suite.queryClient.EpochHistory(suite.ctx, &types.QueryEpochHistoryRequest{Identifier: types.DayEpochID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.EpochHistory, 3)
+	for i := range boundaries {
+		suite.Require().Equal(boundaries[i].EpochNumber, res.EpochHistory[i].EpochNumber)
+		suite.Require().Equal(boundaries[i].EndHeight, res.EpochHistory[i].EndHeight)
+	}
+
+	// the most recent epochs first
+	res, err = suite.queryClient.EpochHistory(suite.ctx, &types.QueryEpochHistoryRequest{
+		Identifier: types.DayEpochID,
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.EpochHistory, 2)
+	suite.Require().Equal(int64(3), res.EpochHistory[0].EpochNumber)
+	suite.Require().Equal(int64(2), res.EpochHistory[1].EpochNumber)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// the history of other identifiers isn't returned
+	res, err = suite.queryClient.EpochHistory(suite.ctx, &types.QueryEpochHistoryRequest{Identifier: types.WeekEpochID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.EpochHistory)
+}
+
+func (suite *KeeperTestSuite) TestNextEpochEnd() {
+	suite.SetupTest()
+
+	_, err := suite.queryClient.NextEpochEnd(suite.ctx, &types.QueryNextEpochEndRequest{Identifier: "unknown"})
+	suite.Require().Error(err)
+
+	now := suite.ctx.BlockTime()
+
+	testCases := []struct {
+		name         string
+		epochInfo    types.EpochInfo
+		blockHeight  int64
+		blockTime    time.Time
+		expEpoch     int64
+		expEndTime   time.Time
+		expEndHeight int64
+	}{
+		{
+			"time-based epoch not started",
+			types.EpochInfo{
+				Identifier: "test",
+				StartTime:  now.Add(time.Hour),
+				Duration:   time.Hour * 24,
+			},
+			1,
+			now,
+			1,
+			now.Add(time.Hour * 25),
+			0,
+		},
+		{
+			"time-based epoch",
+			types.EpochInfo{
+				Identifier:              "test",
+				StartTime:               now,
+				Duration:                time.Hour * 24,
+				CurrentEpoch:            2,
+				CurrentEpochStartTime:   now,
+				CurrentEpochStartHeight: 100,
+				EpochCountingStarted:    true,
+			},
+			200,
+			now.Add(time.Second * 1000),
+			2,
+			now.Add(time.Hour * 24),
+			// 85400s left at 10s per block, ending on the block after the end time
+			200 + 8540 + 1,
+		},
+		{
+			"time-based epoch past its end time",
+			types.EpochInfo{
+				Identifier:              "test",
+				StartTime:               now,
+				Duration:                time.Hour,
+				CurrentEpoch:            2,
+				CurrentEpochStartTime:   now,
+				CurrentEpochStartHeight: 100,
+				EpochCountingStarted:    true,
+			},
+			200,
+			now.Add(time.Hour * 2),
+			2,
+			now.Add(time.Hour),
+			201,
+		},
+		{
+			"height-based epoch",
+			types.EpochInfo{
+				Identifier:              "test",
+				DurationBlocks:          1000,
+				CurrentEpoch:            5,
+				CurrentEpochStartTime:   now,
+				CurrentEpochStartHeight: 100,
+				EpochCountingStarted:    true,
+			},
+			200,
+			now.Add(time.Second * 500),
+			5,
+			now.Add(time.Second * 500).Add(time.Second * 5 * 900),
+			1100,
+		},
+		{
+			"height-based epoch not started",
+			types.EpochInfo{
+				Identifier:     "test",
+				DurationBlocks: 1000,
+				StartHeight:    500,
+			},
+			200,
+			now,
+			1,
+			// no block produced in the epoch to estimate the block time
+			now,
+			1500,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.ctx = suite.ctx.WithBlockHeight(tc.blockHeight).WithBlockTime(tc.blockTime)
+			suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, tc.epochInfo)
+
+			res, err := suite.app.EpochsKeeper.NextEpochEnd(suite.ctx, &types.QueryNextEpochEndRequest{Identifier: "test"})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expEpoch, res.CurrentEpoch)
+			suite.Require().Equal(tc.expEndTime.UTC(), res.EndTime.UTC())
+			suite.Require().Equal(tc.expEndHeight, res.EndHeight)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+
+	suite.app.EpochsKeeper.SetParams(suite.ctx, types.NewParams(7))
+
+	res, err = suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewParams(7), res.Params)
+}
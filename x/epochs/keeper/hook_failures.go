@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+// GetHookFailures returns the number of failed hook executions of a module
+func (k Keeper) GetHookFailures(ctx sdk.Context, moduleName string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+	bz := store.Get([]byte(moduleName))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetHookFailures stores the number of failed hook executions of a module
+func (k Keeper) SetHookFailures(ctx sdk.Context, moduleName string, failures uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+	store.Set([]byte(moduleName), sdk.Uint64ToBigEndian(failures))
+}
+
+// IncrementHookFailures increments the number of failed hook executions of a
+// module
+func (k Keeper) IncrementHookFailures(ctx sdk.Context, moduleName string) {
+	k.SetHookFailures(ctx, moduleName, k.GetHookFailures(ctx, moduleName)+1)
+}
+
+// AllHookFailures returns the number of failed hook executions of every module
+// with at least one failure
+func (k Keeper) AllHookFailures(ctx sdk.Context) []types.HookFailure {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	hookFailures := []types.HookFailure{}
+	for ; iterator.Valid(); iterator.Next() {
+		hookFailures = append(hookFailures, types.HookFailure{
+			ModuleName: string(iterator.Key()),
+			Failures:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return hookFailures
+}
@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,27 +20,127 @@ func NewMultiEpochHooks(hooks ...types.EpochHooks) MultiEpochHooks {
 }
 
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the
-// number of epoch that is ending
-func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// number of epoch that is ending. It runs every hook and returns their errors.
+func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	var errs []error
 	for i := range mh {
-		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		errs = append(errs, mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber))
 	}
+	return errors.Join(errs...)
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is
-// the number of epoch that is starting
-func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// the number of epoch that is starting. It runs every hook and returns their
+// errors.
+func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	var errs []error
 	for i := range mh {
-		mh[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		errs = append(errs, mh[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber))
 	}
+	return errors.Join(errs...)
+}
+
+// AfterEpochsSkipped is called when missed epochs are skipped by the epoch
+// catch-up policy. It runs every hook and returns their errors.
+func (mh MultiEpochHooks) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) error {
+	var errs []error
+	for i := range mh {
+		errs = append(errs, mh[i].AfterEpochsSkipped(ctx, epochIdentifier, epochNumber, skippedEpochs))
+	}
+	return errors.Join(errs...)
+}
+
+// GetModuleName returns the names of the combined hook receivers
+func (mh MultiEpochHooks) GetModuleName() string {
+	names := make([]string, len(mh))
+	for i := range mh {
+		names[i] = mh[i].GetModuleName()
+	}
+	return strings.Join(names, ",")
 }
 
 // AfterEpochEnd executes the indicated hook after epochs ends
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	k.runHooks(ctx, "after_epoch_end", identifier, epochNumber, func(ctx sdk.Context, h types.EpochHooks) error {
+		return h.AfterEpochEnd(ctx, identifier, epochNumber)
+	})
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	k.runHooks(ctx, "before_epoch_start", identifier, epochNumber, func(ctx sdk.Context, h types.EpochHooks) error {
+		return h.BeforeEpochStart(ctx, identifier, epochNumber)
+	})
+}
+
+// AfterEpochsSkipped executes the indicated hook after missed epochs are
+// skipped
+func (k Keeper) AfterEpochsSkipped(ctx sdk.Context, identifier string, epochNumber, skippedEpochs int64) {
+	k.runHooks(ctx, "after_epochs_skipped", identifier, epochNumber, func(ctx sdk.Context, h types.EpochHooks) error {
+		return h.AfterEpochsSkipped(ctx, identifier, epochNumber, skippedEpochs)
+	})
+}
+
+// runHooks runs the given hook function on each hook receiver in a cached
+// context. The state changes of a receiver are committed if it succeeds and
+// discarded if it returns an error or panics, so that a faulty receiver
+// neither halts the chain nor affects the other receivers.
+func (k Keeper) runHooks(
+	ctx sdk.Context,
+	hook, identifier string,
+	epochNumber int64,
+	fn func(ctx sdk.Context, h types.EpochHooks) error,
+) {
+	if k.hooks == nil {
+		return
+	}
+
+	receivers, ok := k.hooks.(MultiEpochHooks)
+	if !ok {
+		receivers = MultiEpochHooks{k.hooks}
+	}
+
+	for _, receiver := range receivers {
+		cacheCtx, write := ctx.CacheContext()
+
+		err := applyHook(cacheCtx, receiver, fn)
+		if err == nil {
+			write()
+			continue
+		}
+
+		moduleName := receiver.GetModuleName()
+		k.Logger(ctx).Error(
+			"epoch hook failed",
+			"hook", hook,
+			"module", moduleName,
+			"identifier", identifier,
+			"epoch-number", epochNumber,
+			"error", err.Error(),
+		)
+
+		k.IncrementHookFailures(ctx, moduleName)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochHookFailed,
+				sdk.NewAttribute(types.AttributeHook, hook),
+				sdk.NewAttribute(types.AttributeModule, moduleName),
+				sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+				sdk.NewAttribute(types.AttributeError, err.Error()),
+			),
+		)
+	}
+}
+
+// applyHook runs the hook function and converts a panic into an error
+func applyHook(ctx sdk.Context, receiver types.EpochHooks, fn func(ctx sdk.Context, h types.EpochHooks) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return fn(ctx, receiver)
 }
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/keeper"
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+var _ types.EpochHooks = &mockEpochHooks{}
+
+// mockEpochHooks stores an epoch named after the module on each hook call and
+// then fails or panics if configured to
+type mockEpochHooks struct {
+	k          *keeper.Keeper
+	moduleName string
+	err        error
+	panics     bool
+}
+
+func (h *mockEpochHooks) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) error {
+	return h.run(ctx)
+}
+
+func (h *mockEpochHooks) BeforeEpochStart(ctx sdk.Context, _ string, _ int64) error {
+	return h.run(ctx)
+}
+
+func (h *mockEpochHooks) AfterEpochsSkipped(ctx sdk.Context, _ string, _, _ int64) error {
+	return h.run(ctx)
+}
+
+func (h *mockEpochHooks) GetModuleName() string {
+	return h.moduleName
+}
+
+func (h *mockEpochHooks) run(ctx sdk.Context) error {
+	h.k.SetEpochInfo(ctx, types.EpochInfo{Identifier: h.moduleName, Duration: time.Hour})
+	if h.panics {
+		panic("mock hook panic")
+	}
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestHooksIsolation() {
+	suite.SetupTest()
+
+	k := keeper.NewKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName), "")
+	k.SetHooks(keeper.NewMultiEpochHooks(
+		&mockEpochHooks{k: k, moduleName: "failing", err: errors.New("mock hook error")},
+		&mockEpochHooks{k: k, moduleName: "panicking", panics: true},
+		&mockEpochHooks{k: k, moduleName: "succeeding"},
+	))
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		k.AfterEpochEnd(ctx, types.DayEpochID, 1)
+		k.BeforeEpochStart(ctx, types.DayEpochID, 2)
+	})
+
+	// only the state changes of the succeeding hook are committed
+	_, found := k.GetEpochInfo(ctx, "failing")
+	suite.Require().False(found)
+	_, found = k.GetEpochInfo(ctx, "panicking")
+	suite.Require().False(found)
+	_, found = k.GetEpochInfo(ctx, "succeeding")
+	suite.Require().True(found)
+
+	// failures are counted per module
+	suite.Require().Equal(uint64(2), k.GetHookFailures(ctx, "failing"))
+	suite.Require().Equal(uint64(2), k.GetHookFailures(ctx, "panicking"))
+	suite.Require().Equal(uint64(0), k.GetHookFailures(ctx, "succeeding"))
+	suite.Require().Equal([]types.HookFailure{
+		{ModuleName: "failing", Failures: 2},
+		{ModuleName: "panicking", Failures: 2},
+	}, k.AllHookFailures(ctx))
+
+	// a failure event is emitted for each failed hook execution
+	var failedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochHookFailed {
+			failedEvents = append(failedEvents, event)
+		}
+	}
+	suite.Require().Len(failedEvents, 4)
+
+	module, found := failedEvents[0].GetAttribute(types.AttributeModule)
+	suite.Require().True(found)
+	suite.Require().Equal("failing", module.Value)
+	hookErr, found := failedEvents[0].GetAttribute(types.AttributeError)
+	suite.Require().True(found)
+	suite.Require().Equal("mock hook error", hookErr.Value)
+
+	module, found = failedEvents[1].GetAttribute(types.AttributeModule)
+	suite.Require().True(found)
+	suite.Require().Equal("panicking", module.Value)
+	hookErr, found = failedEvents[1].GetAttribute(types.AttributeError)
+	suite.Require().True(found)
+	suite.Require().Equal("panic: mock hook panic", hookErr.Value)
+}
+
+func (suite *KeeperTestSuite) TestMultiEpochHooks() {
+	suite.SetupTest()
+
+	hooks := keeper.NewMultiEpochHooks(
+		&mockEpochHooks{k: &suite.app.EpochsKeeper, moduleName: "failing", err: errors.New("mock hook error")},
+		&mockEpochHooks{k: &suite.app.EpochsKeeper, moduleName: "succeeding"},
+	)
+
+	suite.Require().Equal("failing,succeeding", hooks.GetModuleName())
+	suite.Require().ErrorContains(hooks.AfterEpochEnd(suite.ctx, types.DayEpochID, 1), "mock hook error")
+	suite.Require().ErrorContains(hooks.BeforeEpochStart(suite.ctx, types.DayEpochID, 1), "mock hook error")
+}
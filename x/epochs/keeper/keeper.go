@@ -7,25 +7,44 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 )
 
 // Keeper of this module maintains collections of epochs and hooks.
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	paramstore paramtypes.Subspace
+	hooks      types.EpochHooks
+	referrers  []types.EpochReferrer
+
+	// the address capable of executing a MsgCreateEpoch, MsgUpdateEpochDuration
+	// or MsgDeleteEpoch message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace, authority string) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramstore: ps,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/epochs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetHooks set the epoch hooks
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -37,6 +56,29 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	return k
 }
 
+// SetEpochReferrers sets the modules that are checked for references before
+// an epoch is deleted
+func (k *Keeper) SetEpochReferrers(referrers ...types.EpochReferrer) *Keeper {
+	if k.referrers != nil {
+		panic("cannot set epoch referrers twice")
+	}
+
+	k.referrers = referrers
+
+	return k
+}
+
+// IsEpochReferenced returns true if any of the epoch referrers relies on the
+// epoch with the given identifier
+func (k Keeper) IsEpochReferenced(ctx sdk.Context, identifier string) bool {
+	for _, referrer := range k.referrers {
+		if referrer.IsEpochReferenced(ctx, identifier) {
+			return true
+		}
+	}
+	return false
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateEpoch defines a new epoch. The epoch starts counting at the first
+// block after its start time, which defaults to the current block time.
+func (k msgServer) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "identifier %s", req.Identifier)
+	}
+
+	epoch := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               req.StartTime,
+		Duration:                req.Duration,
+		DurationBlocks:          req.DurationBlocks,
+		StartHeight:             req.StartHeight,
+		CatchUpPolicy:           req.CatchUpPolicy,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+	}
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+	if epoch.IsHeightBased() && epoch.StartHeight == 0 {
+		epoch.StartHeight = ctx.BlockHeight()
+	}
+
+	if err := epoch.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeEpochStartTime, epoch.StartTime.String()),
+			sdk.NewAttribute(types.AttributeEpochDuration, epoch.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochDurationBlocks, strconv.FormatInt(epoch.DurationBlocks, 10)),
+			sdk.NewAttribute(types.AttributeCatchUpPolicy, epoch.CatchUpPolicy.String()),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration updates the duration of an existing epoch. The new
+// duration already applies to the running epoch, and setting a block duration
+// turns the epoch into a height-based one.
+func (k msgServer) UpdateEpochDuration(goCtx context.Context, req *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epoch, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	epoch.Duration = req.Duration
+	epoch.DurationBlocks = req.DurationBlocks
+	if err := epoch.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpochDuration,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, epoch.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochDurationBlocks, strconv.FormatInt(epoch.DurationBlocks, 10)),
+		),
+	)
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// UpdateEpochCatchUpPolicy updates how an existing epoch catches up on the
+// epochs missed while the chain was halted.
+func (k msgServer) UpdateEpochCatchUpPolicy(goCtx context.Context, req *types.MsgUpdateEpochCatchUpPolicy) (*types.MsgUpdateEpochCatchUpPolicyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epoch, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	epoch.CatchUpPolicy = req.CatchUpPolicy
+	if err := epoch.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpochCatchUpPolicy,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeCatchUpPolicy, epoch.CatchUpPolicy.String()),
+		),
+	)
+
+	return &types.MsgUpdateEpochCatchUpPolicyResponse{}, nil
+}
+
+// DeleteEpoch deletes an epoch. It fails if any module still references the
+// epoch identifier in its params or state.
+func (k msgServer) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if k.IsEpochReferenced(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s", req.Identifier)
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+	k.DeleteEpochHistory(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// UpdateParams updates the parameters of the x/epochs module. A shorter epoch
+// history length prunes the history of each epoch when it next ends.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,465 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/keeper"
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateEpoch
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgCreateEpoch{
+				Authority:  "invalid",
+				Identifier: "month",
+				Duration:   time.Hour * 24 * 30,
+			},
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - epoch already exists",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: types.DayEpochID,
+				Duration:   time.Hour * 24,
+			},
+			true,
+			types.ErrEpochAlreadyExists.Error(),
+		},
+		{
+			"fail - blank identifier",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: " ",
+				Duration:   time.Hour * 24,
+			},
+			true,
+			"epoch identifier cannot be blank",
+		},
+		{
+			"fail - zero duration",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: "month",
+			},
+			true,
+			"epoch duration cannot be 0",
+		},
+		{
+			"fail - negative duration",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: "month",
+				Duration:   -time.Hour,
+			},
+			true,
+			"epoch duration cannot be negative",
+		},
+		{
+			"fail - height-based epoch with time duration",
+			&types.MsgCreateEpoch{
+				Authority:      authority,
+				Identifier:     "month",
+				Duration:       time.Hour,
+				DurationBlocks: 1000,
+			},
+			true,
+			"height-based epoch cannot have a time duration",
+		},
+		{
+			"pass - height-based epoch",
+			&types.MsgCreateEpoch{
+				Authority:      authority,
+				Identifier:     "month",
+				DurationBlocks: 1000,
+			},
+			false,
+			"",
+		},
+		{
+			"pass - start time defaults to block time",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: "month",
+				Duration:   time.Hour * 24 * 30,
+			},
+			false,
+			"",
+		},
+		{
+			"pass - with start time",
+			&types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: "month",
+				StartTime:  startTime,
+				Duration:   time.Hour * 24 * 30,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+			_, err := msgServer.CreateEpoch(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.msg.Identifier)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg.Duration, epoch.Duration)
+			suite.Require().Equal(tc.msg.DurationBlocks, epoch.DurationBlocks)
+			if epoch.IsHeightBased() {
+				suite.Require().Equal(suite.ctx.BlockHeight(), epoch.StartHeight)
+			}
+			suite.Require().False(epoch.EpochCountingStarted)
+			suite.Require().Equal(int64(0), epoch.CurrentEpoch)
+			if tc.msg.StartTime.IsZero() {
+				suite.Require().Equal(suite.ctx.BlockTime(), epoch.StartTime)
+			} else {
+				suite.Require().Equal(tc.msg.StartTime, epoch.StartTime)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreatedEpochStartsCounting() {
+	suite.SetupTest()
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+	_, err := msgServer.CreateEpoch(suite.ctx, &types.MsgCreateEpoch{
+		Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Identifier: "month",
+		Duration:   time.Hour * 24 * 30,
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	suite.Require().NoError(suite.app.EpochsKeeper.BeginBlocker(suite.ctx))
+
+	epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "month")
+	suite.Require().True(found)
+	suite.Require().True(epoch.EpochCountingStarted)
+	suite.Require().Equal(int64(1), epoch.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateEpochDuration
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateEpochDuration{
+				Authority:  "invalid",
+				Identifier: types.WeekEpochID,
+				Duration:   time.Hour,
+			},
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - epoch not found",
+			&types.MsgUpdateEpochDuration{
+				Authority:  authority,
+				Identifier: "month",
+				Duration:   time.Hour,
+			},
+			true,
+			types.ErrEpochNotFound.Error(),
+		},
+		{
+			"fail - zero duration",
+			&types.MsgUpdateEpochDuration{
+				Authority:  authority,
+				Identifier: types.WeekEpochID,
+			},
+			true,
+			"epoch duration cannot be 0",
+		},
+		{
+			"pass - switch to height-based",
+			&types.MsgUpdateEpochDuration{
+				Authority:      authority,
+				Identifier:     types.WeekEpochID,
+				DurationBlocks: 1000,
+			},
+			false,
+			"",
+		},
+		{
+			"pass",
+			&types.MsgUpdateEpochDuration{
+				Authority:  authority,
+				Identifier: types.WeekEpochID,
+				Duration:   time.Hour * 24 * 14,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			before, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.WeekEpochID)
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+			_, err := msgServer.UpdateEpochDuration(suite.ctx, tc.msg)
+
+			after, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.WeekEpochID)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().Equal(before, after)
+				return
+			}
+			suite.Require().NoError(err)
+
+			before.Duration = tc.msg.Duration
+			before.DurationBlocks = tc.msg.DurationBlocks
+			suite.Require().Equal(before, after)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochCatchUpPolicy() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateEpochCatchUpPolicy
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateEpochCatchUpPolicy{
+				Authority:     "invalid",
+				Identifier:    types.DayEpochID,
+				CatchUpPolicy: types.CATCH_UP_POLICY_SKIP,
+			},
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - epoch not found",
+			&types.MsgUpdateEpochCatchUpPolicy{
+				Authority:     authority,
+				Identifier:    "month",
+				CatchUpPolicy: types.CATCH_UP_POLICY_SKIP,
+			},
+			true,
+			types.ErrEpochNotFound.Error(),
+		},
+		{
+			"fail - invalid policy",
+			&types.MsgUpdateEpochCatchUpPolicy{
+				Authority:     authority,
+				Identifier:    types.DayEpochID,
+				CatchUpPolicy: types.CatchUpPolicy(3),
+			},
+			true,
+			"invalid epoch catch-up policy",
+		},
+		{
+			"pass",
+			&types.MsgUpdateEpochCatchUpPolicy{
+				Authority:     authority,
+				Identifier:    types.DayEpochID,
+				CatchUpPolicy: types.CATCH_UP_POLICY_FIRE_ONCE,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+			_, err := msgServer.UpdateEpochCatchUpPolicy(suite.ctx, tc.msg)
+
+			epoch, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().Equal(types.CATCH_UP_POLICY_FIRE_EACH, epoch.CatchUpPolicy)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.msg.CatchUpPolicy, epoch.CatchUpPolicy)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	maxAmount := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		name       string
+		identifier string
+		malleate   func()
+		authority  string
+		expErr     bool
+		expErrMsg  string
+	}{
+		{
+			"fail - invalid authority",
+			"month",
+			func() {},
+			"invalid",
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - epoch not found",
+			"month",
+			func() {},
+			authority,
+			true,
+			types.ErrEpochNotFound.Error(),
+		},
+		{
+			"fail - referenced by the inflation epoch identifier",
+			"month",
+			func() {
+				suite.app.InflationKeeper.SetEpochIdentifier(suite.ctx, "month")
+			},
+			authority,
+			true,
+			types.ErrEpochInUse.Error(),
+		},
+		{
+			"fail - referenced by an erc20 rate limit",
+			"month",
+			func() {
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, erc20types.NewRateLimit("acoin", "month", &maxAmount, nil))
+			},
+			authority,
+			true,
+			types.ErrEpochInUse.Error(),
+		},
+		{
+			"pass",
+			"month",
+			func() {},
+			authority,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.expErrMsg != types.ErrEpochNotFound.Error() {
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, types.EpochInfo{
+					Identifier: tc.identifier,
+					StartTime:  suite.ctx.BlockTime(),
+					Duration:   time.Hour * 24 * 30,
+				})
+				suite.app.EpochsKeeper.SetEpochBoundary(suite.ctx, types.EpochBoundary{
+					Identifier:  tc.identifier,
+					EpochNumber: 1,
+				})
+			}
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+			_, err := msgServer.DeleteEpoch(suite.ctx, &types.MsgDeleteEpoch{
+				Authority:  tc.authority,
+				Identifier: tc.identifier,
+			})
+
+			_, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.identifier)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().Equal(tc.expErrMsg != types.ErrEpochNotFound.Error(), found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.app.EpochsKeeper.GetEpochHistory(suite.ctx, tc.identifier))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "invalid", Params: types.NewParams(10)},
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - epoch history length too long",
+			&types.MsgUpdateParams{Authority: authority, Params: types.NewParams(types.MaxEpochHistoryLength + 1)},
+			true,
+			"epoch history length cannot exceed",
+		},
+		{
+			"pass",
+			&types.MsgUpdateParams{Authority: authority, Params: types.NewParams(10)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.EpochsKeeper)
+			_, err := msgServer.UpdateParams(suite.ctx, tc.request)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().Equal(types.DefaultParams(), suite.app.EpochsKeeper.GetParams(suite.ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.request.Params, suite.app.EpochsKeeper.GetParams(suite.ctx))
+		})
+	}
+}
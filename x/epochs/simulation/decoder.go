@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
@@ -21,6 +22,17 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &eB)
 			return fmt.Sprintf("%v\n%v", eA, eB)
 
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpochHistory):
+			var bA, bB types.EpochBoundary
+			cdc.MustUnmarshal(kvA.Value, &bA)
+			cdc.MustUnmarshal(kvB.Value, &bB)
+			return fmt.Sprintf("%v\n%v", bA, bB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixHookFailures):
+			failuresA := sdk.BigEndianToUint64(kvA.Value)
+			failuresB := sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("%d\n%d", failuresA, failuresB)
+
 		default:
 			panic(fmt.Sprintf("invalid epochs key prefix %X", kvA.Key[:1]))
 		}
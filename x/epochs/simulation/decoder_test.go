@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/stretchr/testify/require"
 
@@ -29,9 +30,20 @@ func TestEpochsStore(t *testing.T) {
 		EpochCountingStarted:    false,
 	}
 
+	boundary := types.EpochBoundary{
+		Identifier:  types.DayEpochID,
+		EpochNumber: 1,
+		StartHeight: 1,
+		StartTime:   time.Time{},
+		EndHeight:   100,
+		EndTime:     time.Time{}.Add(time.Hour * 24),
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.KeyPrefixEpoch, Value: cdc.MustMarshal(&epoch)},
+			{Key: types.KeyPrefixHookFailures, Value: sdk.Uint64ToBigEndian(3)},
+			{Key: types.GetEpochHistoryPrefix(types.DayEpochID), Value: cdc.MustMarshal(&boundary)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -41,6 +53,8 @@ func TestEpochsStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Epoch", fmt.Sprintf("%v\n%v", epoch, epoch)},
+		{"HookFailures", "3\n3"},
+		{"EpochHistory", fmt.Sprintf("%v\n%v", boundary, boundary)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -47,6 +47,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	}
 
 	genesis.Epochs = epochs
+	genesis.Params = types.NewParams(generateEpochHistoryLength(simState.Rand))
 
 	bz, _ := json.MarshalIndent(&genesis, "", " ")
 	fmt.Printf("Selected randomly generated epochs parameters:\n%s\n", bz)
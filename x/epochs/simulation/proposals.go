@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgCreateEpoch              int = 20
+	DefaultWeightMsgUpdateEpochDuration      int = 20
+	DefaultWeightMsgUpdateEpochCatchUpPolicy int = 20
+	DefaultWeightMsgDeleteEpoch              int = 10
+	DefaultWeightMsgUpdateParams             int = 10
+
+	OpWeightMsgCreateEpoch              = "op_weight_msg_create_epoch"
+	OpWeightMsgUpdateEpochDuration      = "op_weight_msg_update_epoch_duration"
+	OpWeightMsgUpdateEpochCatchUpPolicy = "op_weight_msg_update_epoch_catch_up_policy"
+	OpWeightMsgDeleteEpoch              = "op_weight_msg_delete_epoch"
+	OpWeightMsgUpdateParams             = "op_weight_msg_update_params"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgCreateEpoch,
+			DefaultWeightMsgCreateEpoch,
+			SimulateMsgCreateEpoch,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateEpochDuration,
+			DefaultWeightMsgUpdateEpochDuration,
+			SimulateMsgUpdateEpochDuration,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateEpochCatchUpPolicy,
+			DefaultWeightMsgUpdateEpochCatchUpPolicy,
+			SimulateMsgUpdateEpochCatchUpPolicy,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgDeleteEpoch,
+			DefaultWeightMsgDeleteEpoch,
+			SimulateMsgDeleteEpoch,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+	}
+}
+
+// SimulateMsgCreateEpoch returns a random MsgCreateEpoch
+func SimulateMsgCreateEpoch(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgCreateEpoch{
+		Authority:     authority.String(),
+		Identifier:    simtypes.RandStringOfLength(r, 8),
+		StartTime:     ctx.BlockTime(),
+		Duration:      generateEpochDuration(r),
+		CatchUpPolicy: generateCatchUpPolicy(r),
+	}
+}
+
+// SimulateMsgUpdateEpochDuration returns a random MsgUpdateEpochDuration
+func SimulateMsgUpdateEpochDuration(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgUpdateEpochDuration{
+		Authority:  authority.String(),
+		Identifier: generateEpochIdentifier(r),
+		Duration:   generateEpochDuration(r),
+	}
+}
+
+// SimulateMsgUpdateEpochCatchUpPolicy returns a random MsgUpdateEpochCatchUpPolicy
+func SimulateMsgUpdateEpochCatchUpPolicy(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgUpdateEpochCatchUpPolicy{
+		Authority:     authority.String(),
+		Identifier:    generateEpochIdentifier(r),
+		CatchUpPolicy: generateCatchUpPolicy(r),
+	}
+}
+
+// SimulateMsgDeleteEpoch returns a random MsgDeleteEpoch
+func SimulateMsgDeleteEpoch(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgDeleteEpoch{
+		Authority:  authority.String(),
+		Identifier: generateEpochIdentifier(r),
+	}
+}
+
+// generateEpochIdentifier returns one of the epoch identifiers of the
+// simulation genesis
+func generateEpochIdentifier(r *rand.Rand) string {
+	identifiers := []string{types.WeekEpochID, types.DayEpochID, types.HourEpochID}
+	return identifiers[r.Intn(len(identifiers))]
+}
+
+// generateEpochDuration returns a random epoch duration between one hour and
+// one week
+func generateEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 24*7)) * time.Hour
+}
+
+// generateCatchUpPolicy returns a random epoch catch-up policy
+func generateCatchUpPolicy(r *rand.Rand) types.CatchUpPolicy {
+	return types.CatchUpPolicy(r.Intn(len(types.CatchUpPolicy_name)))
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    types.NewParams(generateEpochHistoryLength(r)),
+	}
+}
+
+// generateEpochHistoryLength returns a random epoch history length of up to
+// 200 epochs
+func generateEpochHistoryLength(r *rand.Rand) uint64 {
+	return uint64(r.Intn(201))
+}
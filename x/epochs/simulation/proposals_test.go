@@ -0,0 +1,78 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/simulation"
+	"github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	// initialize parameters
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, true, nil)
+	accounts := simtypes.RandomAccounts(r, 3)
+	authority := sdk.AccAddress(address.Module("gov")).String()
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs()
+	require.Equal(t, 5, len(weightedProposalMsgs))
+
+	w0 := weightedProposalMsgs[0]
+	require.Equal(t, simulation.OpWeightMsgCreateEpoch, w0.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgCreateEpoch, w0.DefaultWeight())
+
+	msgCreateEpoch, ok := w0.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgCreateEpoch)
+	require.True(t, ok)
+	require.Equal(t, authority, msgCreateEpoch.Authority)
+	require.Len(t, msgCreateEpoch.Identifier, 8)
+	require.Positive(t, msgCreateEpoch.Duration)
+	require.Contains(t, types.CatchUpPolicy_name, int32(msgCreateEpoch.CatchUpPolicy))
+
+	w1 := weightedProposalMsgs[1]
+	require.Equal(t, simulation.OpWeightMsgUpdateEpochDuration, w1.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateEpochDuration, w1.DefaultWeight())
+
+	msgUpdateEpochDuration, ok := w1.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgUpdateEpochDuration)
+	require.True(t, ok)
+	require.Equal(t, authority, msgUpdateEpochDuration.Authority)
+	require.Contains(t, []string{types.WeekEpochID, types.DayEpochID, types.HourEpochID}, msgUpdateEpochDuration.Identifier)
+	require.Positive(t, msgUpdateEpochDuration.Duration)
+
+	w2 := weightedProposalMsgs[2]
+	require.Equal(t, simulation.OpWeightMsgUpdateEpochCatchUpPolicy, w2.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateEpochCatchUpPolicy, w2.DefaultWeight())
+
+	msgUpdateEpochCatchUpPolicy, ok := w2.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgUpdateEpochCatchUpPolicy)
+	require.True(t, ok)
+	require.Equal(t, authority, msgUpdateEpochCatchUpPolicy.Authority)
+	require.Contains(t, []string{types.WeekEpochID, types.DayEpochID, types.HourEpochID}, msgUpdateEpochCatchUpPolicy.Identifier)
+	require.Contains(t, types.CatchUpPolicy_name, int32(msgUpdateEpochCatchUpPolicy.CatchUpPolicy))
+
+	w3 := weightedProposalMsgs[3]
+	require.Equal(t, simulation.OpWeightMsgDeleteEpoch, w3.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgDeleteEpoch, w3.DefaultWeight())
+
+	msgDeleteEpoch, ok := w3.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgDeleteEpoch)
+	require.True(t, ok)
+	require.Equal(t, authority, msgDeleteEpoch.Authority)
+	require.Contains(t, []string{types.WeekEpochID, types.DayEpochID, types.HourEpochID}, msgDeleteEpoch.Identifier)
+
+	w4 := weightedProposalMsgs[4]
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w4.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateParams, w4.DefaultWeight())
+
+	msgUpdateParams, ok := w4.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, authority, msgUpdateParams.Authority)
+	require.NoError(t, msgUpdateParams.Params.Validate())
+}
@@ -3,6 +3,40 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+// method required for x/epochs msg GetSignBytes methods
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgUpdateEpochCatchUpPolicy{},
+		&MsgDeleteEpoch{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// register epochs msg types for Amino Codec in adherence to EIP-712 signing conventions
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateEpoch{}, "canto/x/epochs/MsgCreateEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochDuration{}, "canto/x/epochs/MsgUpdateEpochDuration", nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochCatchUpPolicy{}, "canto/x/epochs/MsgUpdateEpochCatchUpPolicy", nil)
+	cdc.RegisterConcrete(&MsgDeleteEpoch{}, "canto/x/epochs/MsgDeleteEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "canto/x/epochs/MsgUpdateParams", nil)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NewEpochBoundary returns the boundaries of the current epoch of the epoch
+// info, ending at the given block
+func NewEpochBoundary(ei EpochInfo, endHeight int64, endTime time.Time) EpochBoundary {
+	return EpochBoundary{
+		Identifier:  ei.Identifier,
+		EpochNumber: ei.CurrentEpoch,
+		StartHeight: ei.CurrentEpochStartHeight,
+		StartTime:   ei.CurrentEpochStartTime,
+		EndHeight:   endHeight,
+		EndTime:     endTime,
+	}
+}
+
+// Validate performs a stateless validation of the epoch boundary fields
+func (eb EpochBoundary) Validate() error {
+	if strings.TrimSpace(eb.Identifier) == "" {
+		return errors.New("epoch identifier cannot be blank")
+	}
+	if eb.EpochNumber <= 0 {
+		return fmt.Errorf("epoch boundary number must be positive: %d", eb.EpochNumber)
+	}
+	if eb.StartHeight < 0 {
+		return fmt.Errorf("epoch boundary start height cannot be negative: %d", eb.StartHeight)
+	}
+	if eb.EndHeight < eb.StartHeight {
+		return fmt.Errorf("epoch boundary end height %d is lower than its start height %d", eb.EndHeight, eb.StartHeight)
+	}
+	return nil
+}
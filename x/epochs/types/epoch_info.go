@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // StartInitialEpoch sets the epoch info fields to their start values
@@ -19,13 +20,85 @@ func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
 }
 
+// RealignEpoch ends the given number of epochs at once. The start time of a
+// time-based epoch stays on the grid of epoch durations from its start time.
+func (ei *EpochInfo) RealignEpoch(endedEpochs int64) {
+	ei.CurrentEpoch += endedEpochs
+	if !ei.IsHeightBased() {
+		ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(time.Duration(endedEpochs) * ei.Duration)
+	}
+}
+
+// EndedEpochs returns the number of epoch ends the block reached since the
+// current epoch start, which is greater than one if epochs were missed
+func (ei EpochInfo) EndedEpochs(blockHeight int64, blockTime time.Time) int64 {
+	if !ei.ShouldEndEpoch(blockHeight, blockTime) {
+		return 0
+	}
+	if ei.IsHeightBased() {
+		return (blockHeight - ei.CurrentEpochStartHeight) / ei.DurationBlocks
+	}
+	// an epoch ends on the first block strictly after its end time
+	elapsed := blockTime.Sub(ei.CurrentEpochStartTime)
+	return int64((elapsed - 1) / ei.Duration)
+}
+
+// IsHeightBased returns true if the epoch ends every DurationBlocks blocks
+// instead of after its time duration
+func (ei EpochInfo) IsHeightBased() bool {
+	return ei.DurationBlocks > 0
+}
+
+// ShouldStartInitialEpoch returns true if the epoch counting has not started
+// and the block reached the epoch start time or start height
+func (ei EpochInfo) ShouldStartInitialEpoch(blockHeight int64, blockTime time.Time) bool {
+	if ei.EpochCountingStarted {
+		return false
+	}
+	if ei.IsHeightBased() {
+		return blockHeight >= ei.StartHeight
+	}
+	return !ei.StartTime.After(blockTime)
+}
+
+// ShouldEndEpoch returns true if the current epoch lasted for its duration,
+// in blocks for a height-based epoch and in time otherwise
+func (ei EpochInfo) ShouldEndEpoch(blockHeight int64, blockTime time.Time) bool {
+	if !ei.EpochCountingStarted {
+		return false
+	}
+	if ei.IsHeightBased() {
+		return blockHeight >= ei.CurrentEpochStartHeight+ei.DurationBlocks
+	}
+	epochEndTime := ei.CurrentEpochStartTime.Add(ei.Duration)
+	return blockTime.After(epochEndTime) && !ei.StartTime.After(blockTime)
+}
+
 // Validate performs a stateless validation of the epoch info fields
 func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
 		return errors.New("epoch identifier cannot be blank")
 	}
-	if ei.Duration == 0 {
-		return errors.New("epoch duration cannot be 0")
+	if ei.DurationBlocks < 0 {
+		return fmt.Errorf("epoch duration blocks cannot be negative: %d", ei.DurationBlocks)
+	}
+	if ei.StartHeight < 0 {
+		return fmt.Errorf("epoch start height cannot be negative: %d", ei.StartHeight)
+	}
+	if _, ok := CatchUpPolicy_name[int32(ei.CatchUpPolicy)]; !ok {
+		return fmt.Errorf("invalid epoch catch-up policy: %d", ei.CatchUpPolicy)
+	}
+	if ei.IsHeightBased() {
+		if ei.Duration != 0 {
+			return errors.New("height-based epoch cannot have a time duration")
+		}
+	} else {
+		if ei.Duration == 0 {
+			return errors.New("epoch duration cannot be 0")
+		}
+		if ei.Duration < 0 {
+			return errors.New("epoch duration cannot be negative")
+		}
 	}
 	if ei.CurrentEpoch < 0 {
 		return fmt.Errorf("current epoch cannot be negative: %d", ei.CurrentEpochStartHeight)
@@ -46,6 +46,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
 			},
 			false,
 		},
@@ -59,6 +62,25 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
+			},
+			false,
+		},
+		{
+			"invalid - epoch duration negative",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				-time.Hour,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
 			},
 			false,
 		},
@@ -72,6 +94,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
 			},
 			false,
 		},
@@ -85,9 +110,92 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
 			},
 			false,
 		},
+		{
+			"invalid - negative duration blocks",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				-1,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
+			},
+			false,
+		},
+		{
+			"invalid - negative start height",
+			EpochInfo{
+				"blocks",
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				100,
+				-1,
+				CATCH_UP_POLICY_FIRE_EACH,
+			},
+			false,
+		},
+		{
+			"invalid - height-based epoch with time duration",
+			EpochInfo{
+				"blocks",
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				100,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
+			},
+			false,
+		},
+		{
+			"invalid - catch-up policy",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				0,
+				CatchUpPolicy(3),
+			},
+			false,
+		},
+		{
+			"pass - height-based epoch",
+			EpochInfo{
+				"blocks",
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				100,
+				10,
+				CATCH_UP_POLICY_FIRE_EACH,
+			},
+			true,
+		},
 		{
 			"pass",
 			EpochInfo{
@@ -98,6 +206,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				0,
+				CATCH_UP_POLICY_FIRE_EACH,
 			},
 			true,
 		},
@@ -113,3 +224,44 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 		}
 	}
 }
+
+func (suite *EpochInfoTestSuite) TestHeightBasedEpoch() {
+	now := time.Now()
+	ei := EpochInfo{Identifier: "blocks", StartTime: now, DurationBlocks: 10, StartHeight: 5}
+	suite.Require().True(ei.IsHeightBased())
+
+	// the epoch starts at its start height regardless of the block time
+	suite.Require().False(ei.ShouldStartInitialEpoch(4, now.Add(time.Hour)))
+	suite.Require().True(ei.ShouldStartInitialEpoch(5, now.Add(-time.Hour)))
+	suite.Require().False(ei.ShouldEndEpoch(100, now))
+
+	ei.StartInitialEpoch()
+	ei.CurrentEpochStartHeight = 5
+	suite.Require().False(ei.ShouldStartInitialEpoch(5, now))
+
+	// the epoch ends after its duration blocks regardless of the block time
+	suite.Require().False(ei.ShouldEndEpoch(14, now.Add(time.Hour*24*365)))
+	suite.Require().True(ei.ShouldEndEpoch(15, now))
+}
+
+func (suite *EpochInfoTestSuite) TestEndedAndRealignEpochs() {
+	startTime := time.Now()
+	day := time.Hour * 24
+	ei := EpochInfo{StartTime: startTime, Duration: day}
+	ei.StartInitialEpoch()
+
+	suite.Require().Equal(int64(0), ei.EndedEpochs(10, startTime.Add(day)))
+	suite.Require().Equal(int64(1), ei.EndedEpochs(10, startTime.Add(day+time.Second)))
+	suite.Require().Equal(int64(1), ei.EndedEpochs(10, startTime.Add(2*day)))
+	suite.Require().Equal(int64(3), ei.EndedEpochs(10, startTime.Add(3*day+12*time.Hour)))
+
+	ei.RealignEpoch(3)
+	suite.Require().Equal(int64(4), ei.CurrentEpoch)
+	suite.Require().Equal(startTime.Add(3*day), ei.CurrentEpochStartTime)
+	suite.Require().Equal(int64(0), ei.EndedEpochs(11, startTime.Add(3*day+12*time.Hour)))
+
+	heightBased := EpochInfo{DurationBlocks: 10, EpochCountingStarted: true, CurrentEpoch: 1, CurrentEpochStartHeight: 5}
+	suite.Require().Equal(int64(0), heightBased.EndedEpochs(14, startTime))
+	suite.Require().Equal(int64(1), heightBased.EndedEpochs(15, startTime))
+	suite.Require().Equal(int64(3), heightBased.EndedEpochs(35, startTime))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrEpochAlreadyExists = errorsmod.Register(ModuleName, 2, "epoch already exists")
+	ErrEpochNotFound      = errorsmod.Register(ModuleName, 3, "epoch not found")
+	ErrEpochInUse         = errorsmod.Register(ModuleName, 4, "epoch is referenced by a module")
+	ErrInvalidEpoch       = errorsmod.Register(ModuleName, 5, "invalid epoch")
+)
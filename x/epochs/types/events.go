@@ -2,9 +2,22 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd                 = "epoch_end"
+	EventTypeEpochStart               = "epoch_start"
+	EventTypeCreateEpoch              = "create_epoch"
+	EventTypeUpdateEpochDuration      = "update_epoch_duration"
+	EventTypeDeleteEpoch              = "delete_epoch"
+	EventTypeUpdateEpochCatchUpPolicy = "update_epoch_catch_up_policy"
+	EventTypeEpochHookFailed          = "epoch_hook_failed"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber         = "epoch_number"
+	AttributeEpochStartTime      = "start_time"
+	AttributeEpochIdentifier     = "identifier"
+	AttributeEpochDuration       = "duration"
+	AttributeEpochDurationBlocks = "duration_blocks"
+	AttributeCatchUpPolicy       = "catch_up_policy"
+	AttributeSkippedEpochs       = "skipped_epochs"
+	AttributeHook                = "hook"
+	AttributeModule              = "module"
+	AttributeError               = "error"
 )
@@ -6,8 +6,12 @@ import (
 )
 
 // NewGenesisState creates a new genesis state instance
-func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+func NewGenesisState(params Params, epochs []EpochInfo, epochHistory []EpochBoundary) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Epochs:       epochs,
+		EpochHistory: epochHistory,
+	}
 }
 
 // DefaultGenesisState returns the default epochs genesis state
@@ -32,12 +36,16 @@ func DefaultGenesisState() *GenesisState {
 			EpochCountingStarted:    false,
 		},
 	}
-	return NewGenesisState(epochs)
+	return NewGenesisState(DefaultParams(), epochs, []EpochBoundary{})
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	epochIdentifiers := make(map[string]bool)
 
 	for _, epoch := range gs.Epochs {
@@ -50,5 +58,21 @@ func (gs GenesisState) Validate() error {
 		epochIdentifiers[epoch.Identifier] = true
 	}
 
+	epochBoundaries := make(map[string]bool)
+
+	for _, boundary := range gs.EpochHistory {
+		if !epochIdentifiers[boundary.Identifier] {
+			return fmt.Errorf("epoch boundary of unknown epoch %s", boundary.Identifier)
+		}
+		key := fmt.Sprintf("%s/%d", boundary.Identifier, boundary.EpochNumber)
+		if epochBoundaries[key] {
+			return fmt.Errorf("duplicated epoch boundary entry %s", key)
+		}
+		if err := boundary.Validate(); err != nil {
+			return err
+		}
+		epochBoundaries[key] = true
+	}
+
 	return nil
 }
@@ -28,6 +28,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CatchUpPolicy defines how an epoch catches up on the epochs that ended
+// while the chain was halted.
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_POLICY_FIRE_EACH ends one missed epoch per block and fires the
+	// hooks for each of them.
+	CATCH_UP_POLICY_FIRE_EACH CatchUpPolicy = 0
+	// CATCH_UP_POLICY_SKIP skips the missed epochs without firing the epoch end
+	// hooks and realigns the epoch on its start time grid.
+	CATCH_UP_POLICY_SKIP CatchUpPolicy = 1
+	// CATCH_UP_POLICY_FIRE_ONCE fires the epoch end hooks once for all the
+	// missed epochs and realigns the epoch on its start time grid.
+	CATCH_UP_POLICY_FIRE_ONCE CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_POLICY_FIRE_EACH",
+	1: "CATCH_UP_POLICY_SKIP",
+	2: "CATCH_UP_POLICY_FIRE_ONCE",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_POLICY_FIRE_EACH": 0,
+	"CATCH_UP_POLICY_SKIP":      1,
+	"CATCH_UP_POLICY_FIRE_ONCE": 2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_215c7e170263b152, []int{0}
+}
+
 type EpochInfo struct {
 	Identifier              string        `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	StartTime               time.Time     `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
@@ -36,6 +72,15 @@ type EpochInfo struct {
 	CurrentEpochStartTime   time.Time     `protobuf:"bytes,5,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	EpochCountingStarted    bool          `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	CurrentEpochStartHeight int64         `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// duration_blocks is the number of blocks after which a height-based epoch
+	// ends. The epoch is time-based and ends after its duration when zero.
+	DurationBlocks int64 `protobuf:"varint,8,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+	// start_height is the block height from which a height-based epoch starts
+	// counting
+	StartHeight int64 `protobuf:"varint,9,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// catch_up_policy defines how the epoch catches up on the epochs missed
+	// while the chain was halted
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,10,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=canto.epochs.v1.CatchUpPolicy" json:"catch_up_policy,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -120,16 +165,180 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetDurationBlocks() int64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CATCH_UP_POLICY_FIRE_EACH
+}
+
+// EpochBoundary defines the boundaries of an ended epoch. The epoch ends on
+// the block that starts the next epoch.
+type EpochBoundary struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// epoch_number of the ended epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// start_height is the block height at which the epoch started
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the start time of the epoch
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_height is the block height at which the epoch ended
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time is the time of the block at which the epoch ended
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *EpochBoundary) Reset()         { *m = EpochBoundary{} }
+func (m *EpochBoundary) String() string { return proto.CompactTextString(m) }
+func (*EpochBoundary) ProtoMessage()    {}
+func (*EpochBoundary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215c7e170263b152, []int{1}
+}
+func (m *EpochBoundary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBoundary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBoundary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBoundary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBoundary.Merge(m, src)
+}
+func (m *EpochBoundary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBoundary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBoundary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBoundary proto.InternalMessageInfo
+
+func (m *EpochBoundary) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochBoundary) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochBoundary) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochBoundary) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochBoundary) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochBoundary) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// Params defines the epochs module parameters
+type Params struct {
+	// epoch_history_length is the number of ended epochs whose boundaries are
+	// kept per epoch identifier. Older boundaries are pruned.
+	EpochHistoryLength uint64 `protobuf:"varint,1,opt,name=epoch_history_length,json=epochHistoryLength,proto3" json:"epoch_history_length,omitempty" yaml:"epoch_history_length"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215c7e170263b152, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochHistoryLength() uint64 {
+	if m != nil {
+		return m.EpochHistoryLength
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// epoch_history defines the boundaries of the recently ended epochs
+	EpochHistory []EpochBoundary `protobuf:"bytes,3,rep,name=epoch_history,json=epochHistory,proto3" json:"epoch_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_215c7e170263b152, []int{1}
+	return fileDescriptor_215c7e170263b152, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,45 +374,81 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEpochHistory() []EpochBoundary {
+	if m != nil {
+		return m.EpochHistory
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("canto.epochs.v1.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*EpochInfo)(nil), "canto.epochs.v1.EpochInfo")
+	proto.RegisterType((*EpochBoundary)(nil), "canto.epochs.v1.EpochBoundary")
+	proto.RegisterType((*Params)(nil), "canto.epochs.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "canto.epochs.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("canto/epochs/v1/genesis.proto", fileDescriptor_215c7e170263b152) }
 
 var fileDescriptor_215c7e170263b152 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0x91, 0x10, 0x9a, 0xa3, 0xa8, 0xe2, 0x54, 0xc0, 0x44, 0xaa, 0x6d, 0x99, 0x25, 0x12,
-	0xe0, 0x23, 0xc0, 0x50, 0xc1, 0x96, 0x82, 0x28, 0x0c, 0x0c, 0x0e, 0x03, 0x62, 0x89, 0x1c, 0xe7,
-	0x62, 0x9f, 0xa8, 0xef, 0x2c, 0xfb, 0x73, 0x20, 0x1b, 0x33, 0x53, 0x47, 0x7e, 0x52, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x1b, 0x63, 0x7f, 0x01, 0xba, 0x3b, 0x3b, 0x84, 0x16, 0xd4, 0xcd, 0x77, 0xef,
-	0x7d, 0xef, 0xdd, 0x7b, 0xfa, 0x8c, 0xf7, 0xa2, 0x50, 0x80, 0xa4, 0x2c, 0x93, 0x51, 0x52, 0xd0,
-	0x59, 0x9f, 0xc6, 0x4c, 0xb0, 0x82, 0x17, 0x7e, 0x96, 0x4b, 0x90, 0x64, 0x47, 0xc3, 0xbe, 0x81,
-	0xfd, 0x59, 0xbf, 0xbb, 0x1b, 0xcb, 0x58, 0x6a, 0x8c, 0xaa, 0x2f, 0x43, 0xeb, 0xda, 0xb1, 0x94,
-	0xf1, 0x11, 0xa3, 0xfa, 0x34, 0x2e, 0xa7, 0x74, 0x52, 0xe6, 0x21, 0x70, 0x29, 0x2a, 0xdc, 0x39,
-	0x8f, 0x03, 0x4f, 0x59, 0x01, 0x61, 0x9a, 0x19, 0x82, 0xf7, 0xb5, 0x85, 0x3b, 0x2f, 0x95, 0xc9,
-	0x6b, 0x31, 0x95, 0xc4, 0xc6, 0x98, 0x4f, 0x98, 0x00, 0x3e, 0xe5, 0x2c, 0xb7, 0x90, 0x8b, 0x7a,
-	0x9d, 0x60, 0xe3, 0x86, 0xbc, 0xc7, 0xb8, 0x80, 0x30, 0x87, 0x91, 0x92, 0xb1, 0xae, 0xb8, 0xa8,
-	0x77, 0xfd, 0x71, 0xd7, 0x37, 0x1e, 0x7e, 0xed, 0xe1, 0xbf, 0xab, 0x3d, 0x06, 0x7b, 0x27, 0x0b,
-	0xa7, 0x71, 0xb6, 0x70, 0x6e, 0xce, 0xc3, 0xf4, 0xe8, 0x99, 0xf7, 0x67, 0xd6, 0x3b, 0xfe, 0xe1,
-	0xa0, 0xa0, 0xa3, 0x2f, 0x14, 0x9d, 0x24, 0x78, 0xab, 0x7e, 0xba, 0xd5, 0xd4, 0xba, 0x77, 0x2f,
-	0xe8, 0xbe, 0xa8, 0x08, 0x83, 0xbe, 0x92, 0xfd, 0xb5, 0x70, 0x48, 0x3d, 0xf2, 0x40, 0xa6, 0x1c,
-	0x58, 0x9a, 0xc1, 0xfc, 0x6c, 0xe1, 0xec, 0x18, 0xb3, 0x1a, 0xf3, 0xbe, 0x29, 0xab, 0xb5, 0x3a,
-	0xb9, 0x87, 0x6f, 0x44, 0x65, 0x9e, 0x33, 0x01, 0x23, 0xdd, 0xae, 0xd5, 0x72, 0x51, 0xaf, 0x19,
-	0x6c, 0x57, 0x97, 0xba, 0x0c, 0xf2, 0x05, 0x61, 0xeb, 0x2f, 0xd6, 0x68, 0x23, 0xf7, 0xd5, 0x4b,
-	0x73, 0xdf, 0xaf, 0x72, 0x3b, 0xe6, 0x29, 0xff, 0x53, 0x32, 0x2d, 0xdc, 0xda, 0x74, 0x1e, 0xae,
-	0x1b, 0x79, 0x8a, 0x6f, 0x1b, 0x7e, 0x24, 0x4b, 0x01, 0x5c, 0xc4, 0x66, 0x90, 0x4d, 0xac, 0xb6,
-	0x8b, 0x7a, 0x5b, 0xc1, 0xae, 0x46, 0x0f, 0x2a, 0x70, 0x68, 0x30, 0xf2, 0x1c, 0x77, 0xff, 0xe5,
-	0x96, 0x30, 0x1e, 0x27, 0x60, 0x5d, 0xd3, 0x51, 0xef, 0x5c, 0x30, 0x3c, 0xd4, 0xb0, 0x77, 0x88,
-	0xb7, 0x5f, 0x99, 0x2d, 0x1c, 0x42, 0x08, 0x8c, 0xec, 0xe3, 0xb6, 0x59, 0x40, 0x0b, 0xb9, 0x4d,
-	0x1d, 0xf9, 0xdc, 0x56, 0xfa, 0xeb, 0xd5, 0x19, 0xb4, 0x54, 0xe4, 0xa0, 0xe2, 0x0f, 0xde, 0x9c,
-	0x2c, 0x6d, 0x74, 0xba, 0xb4, 0xd1, 0xcf, 0xa5, 0x8d, 0x8e, 0x57, 0x76, 0xe3, 0x74, 0x65, 0x37,
-	0xbe, 0xaf, 0xec, 0xc6, 0x87, 0x47, 0x31, 0x87, 0xa4, 0x1c, 0xfb, 0x91, 0x4c, 0xe9, 0x81, 0x52,
-	0x7b, 0xf8, 0x96, 0xc1, 0x27, 0x99, 0x7f, 0x34, 0x27, 0x3a, 0xdb, 0xa7, 0x9f, 0xeb, 0xbf, 0x02,
-	0xe6, 0x19, 0x2b, 0xc6, 0x6d, 0x5d, 0xf0, 0x93, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xb8,
-	0x44, 0x3c, 0x32, 0x03, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3d, 0x53, 0xdb, 0x58,
+	0x14, 0xb5, 0xb0, 0x31, 0xf6, 0xc3, 0xc6, 0xec, 0x1b, 0x76, 0x11, 0x66, 0x2c, 0x19, 0x6f, 0xb1,
+	0x9e, 0xfd, 0x90, 0x16, 0x76, 0x77, 0x86, 0xd9, 0xad, 0xb0, 0xd6, 0xc4, 0x4e, 0x18, 0x70, 0x64,
+	0x98, 0xc9, 0x47, 0xa1, 0x91, 0xe5, 0x87, 0xa4, 0xc1, 0xd2, 0xd3, 0x48, 0x4f, 0x24, 0xee, 0x52,
+	0xa6, 0xa4, 0x4c, 0x95, 0x26, 0xff, 0x24, 0x15, 0x93, 0x8a, 0x32, 0x95, 0x93, 0x81, 0x2e, 0x25,
+	0xbf, 0x20, 0xa3, 0xf7, 0x24, 0xe2, 0x0f, 0x18, 0x9a, 0x74, 0xd6, 0x3d, 0xe7, 0x9e, 0x73, 0xef,
+	0xd5, 0x19, 0x19, 0x54, 0x0c, 0xdd, 0x25, 0x58, 0x46, 0x1e, 0x36, 0xac, 0x40, 0x3e, 0xdd, 0x94,
+	0x4d, 0xe4, 0xa2, 0xc0, 0x0e, 0x24, 0xcf, 0xc7, 0x04, 0xc3, 0x12, 0x85, 0x25, 0x06, 0x4b, 0xa7,
+	0x9b, 0xe5, 0x15, 0x13, 0x9b, 0x98, 0x62, 0x72, 0xf4, 0x8b, 0xd1, 0xca, 0x82, 0x89, 0xb1, 0x39,
+	0x40, 0x32, 0x7d, 0xea, 0x85, 0xc7, 0x72, 0x3f, 0xf4, 0x75, 0x62, 0x63, 0x37, 0xc6, 0xc5, 0x69,
+	0x9c, 0xd8, 0x0e, 0x0a, 0x88, 0xee, 0x78, 0x8c, 0x50, 0x7b, 0x3b, 0x0f, 0xf2, 0xcd, 0xc8, 0xa4,
+	0xed, 0x1e, 0x63, 0x28, 0x00, 0x60, 0xf7, 0x91, 0x4b, 0xec, 0x63, 0x1b, 0xf9, 0x3c, 0x57, 0xe5,
+	0xea, 0x79, 0x75, 0xac, 0x02, 0x9f, 0x00, 0x10, 0x10, 0xdd, 0x27, 0x5a, 0x24, 0xc3, 0xcf, 0x55,
+	0xb9, 0xfa, 0xe2, 0x56, 0x59, 0x62, 0x1e, 0x52, 0xe2, 0x21, 0x1d, 0x26, 0x1e, 0x8d, 0xca, 0xf9,
+	0x48, 0x4c, 0x5d, 0x8f, 0xc4, 0x1f, 0x86, 0xba, 0x33, 0xf8, 0xb7, 0xf6, 0xad, 0xb7, 0x76, 0xf6,
+	0x49, 0xe4, 0xd4, 0x3c, 0x2d, 0x44, 0x74, 0x68, 0x81, 0x5c, 0x32, 0x3a, 0x9f, 0xa6, 0xba, 0x6b,
+	0x33, 0xba, 0xff, 0xc7, 0x84, 0xc6, 0x66, 0x24, 0xfb, 0x65, 0x24, 0xc2, 0xa4, 0xe5, 0x77, 0xec,
+	0xd8, 0x04, 0x39, 0x1e, 0x19, 0x5e, 0x8f, 0xc4, 0x12, 0x33, 0x4b, 0xb0, 0xda, 0x9b, 0xc8, 0xea,
+	0x46, 0x1d, 0xfe, 0x0c, 0x8a, 0x46, 0xe8, 0xfb, 0xc8, 0x25, 0x1a, 0xbd, 0x2e, 0x9f, 0xa9, 0x72,
+	0xf5, 0xb4, 0x5a, 0x88, 0x8b, 0xf4, 0x18, 0xf0, 0x15, 0x07, 0xf8, 0x09, 0x96, 0x36, 0xb6, 0xf7,
+	0xfc, 0xbd, 0x7b, 0xff, 0x16, 0xef, 0x2d, 0xb2, 0x51, 0xee, 0x52, 0x62, 0x57, 0xf8, 0x71, 0xdc,
+	0xb9, 0x7b, 0x73, 0x91, 0xbf, 0xc1, 0x4f, 0x8c, 0x6f, 0xe0, 0xd0, 0x25, 0xb6, 0x6b, 0xb2, 0x46,
+	0xd4, 0xe7, 0xb3, 0x55, 0xae, 0x9e, 0x53, 0x57, 0x28, 0xaa, 0xc4, 0x60, 0x97, 0x61, 0xf0, 0x3f,
+	0x50, 0xbe, 0xcd, 0xcd, 0x42, 0xb6, 0x69, 0x11, 0x7e, 0x81, 0xae, 0xba, 0x3a, 0x63, 0xd8, 0xa2,
+	0x30, 0xfc, 0x05, 0x94, 0x92, 0x33, 0x69, 0xbd, 0x01, 0x36, 0x4e, 0x02, 0x3e, 0x47, 0x3b, 0x96,
+	0x92, 0x72, 0x83, 0x56, 0xe1, 0x06, 0x28, 0x4c, 0xe8, 0xe6, 0x29, 0x6b, 0x31, 0x18, 0xd3, 0xda,
+	0x05, 0x25, 0x43, 0x27, 0x86, 0xa5, 0x85, 0x9e, 0xe6, 0xe1, 0x81, 0x6d, 0x0c, 0x79, 0x50, 0xe5,
+	0xea, 0x4b, 0x5b, 0x82, 0x34, 0x15, 0x6d, 0x49, 0x89, 0x78, 0x47, 0x5e, 0x87, 0xb2, 0xd4, 0xa2,
+	0x31, 0xfe, 0x58, 0x7b, 0x3f, 0x07, 0x8a, 0x74, 0xd0, 0x06, 0x0e, 0xdd, 0xbe, 0xee, 0x0f, 0xef,
+	0x0d, 0xe9, 0x06, 0x28, 0xb0, 0xd5, 0xdd, 0xd0, 0xe9, 0x21, 0x9f, 0xc6, 0x34, 0xad, 0x2e, 0xd2,
+	0xda, 0x3e, 0x2d, 0xcd, 0xcc, 0x9f, 0x9e, 0x9d, 0x7f, 0x32, 0xea, 0x99, 0xef, 0x18, 0xf5, 0x0a,
+	0x00, 0xc8, 0xed, 0x27, 0xd6, 0xf3, 0xd4, 0x3a, 0x8f, 0xdc, 0x7e, 0x6c, 0xac, 0x82, 0x5c, 0x04,
+	0x53, 0xdb, 0xec, 0xbd, 0xb6, 0xeb, 0xb1, 0x6d, 0x1c, 0xfa, 0xa4, 0x93, 0x99, 0x2e, 0x20, 0xb7,
+	0x1f, 0x51, 0x6b, 0xcf, 0x41, 0xb6, 0xa3, 0xfb, 0xba, 0x13, 0xc0, 0xc7, 0x80, 0xe5, 0x46, 0xb3,
+	0xec, 0x80, 0x60, 0x7f, 0xa8, 0x0d, 0x90, 0x6b, 0x12, 0x8b, 0x9e, 0x31, 0xd3, 0x10, 0xaf, 0x47,
+	0xe2, 0x7a, 0xac, 0x74, 0x0b, 0xab, 0xa6, 0x42, 0x5a, 0x6e, 0xb1, 0xea, 0x1e, 0x2b, 0x7e, 0xe0,
+	0x40, 0xe1, 0x01, 0xfb, 0x78, 0x75, 0x89, 0x4e, 0x10, 0xdc, 0x06, 0x59, 0xf6, 0x72, 0x79, 0xae,
+	0x9a, 0xa6, 0xf3, 0x4f, 0xbf, 0xf1, 0x9b, 0x2f, 0x4e, 0x23, 0x13, 0xcd, 0xaf, 0xc6, 0x7c, 0xf8,
+	0x0f, 0xc8, 0x7a, 0x74, 0xce, 0xf8, 0xdb, 0xb2, 0x3a, 0xd3, 0xc9, 0xd6, 0x48, 0xda, 0x18, 0x19,
+	0xb6, 0x41, 0x71, 0x62, 0x5c, 0x3e, 0x4d, 0x7d, 0x85, 0xdb, 0x7d, 0x93, 0x20, 0xc5, 0x22, 0x85,
+	0xf1, 0x95, 0x7e, 0x75, 0x40, 0x71, 0x22, 0x8e, 0xb0, 0x02, 0xd6, 0x94, 0x9d, 0x43, 0xa5, 0xa5,
+	0x1d, 0x75, 0xb4, 0xce, 0xc1, 0x5e, 0x5b, 0x79, 0xaa, 0xed, 0xb6, 0xd5, 0xa6, 0xd6, 0xdc, 0x51,
+	0x5a, 0xcb, 0x29, 0xc8, 0x83, 0x95, 0x69, 0xb8, 0xfb, 0xa8, 0xdd, 0x59, 0xe6, 0xee, 0x6c, 0x3c,
+	0xd8, 0x57, 0x9a, 0xcb, 0x73, 0xe5, 0xcc, 0xeb, 0x77, 0x42, 0xaa, 0xf1, 0xf0, 0xfc, 0x52, 0xe0,
+	0x2e, 0x2e, 0x05, 0xee, 0xf3, 0xa5, 0xc0, 0x9d, 0x5d, 0x09, 0xa9, 0x8b, 0x2b, 0x21, 0xf5, 0xf1,
+	0x4a, 0x48, 0x3d, 0xfb, 0xd3, 0xb4, 0x89, 0x15, 0xf6, 0x24, 0x03, 0x3b, 0xb2, 0x12, 0xad, 0xf1,
+	0xc7, 0x3e, 0x22, 0x2f, 0xb0, 0x7f, 0xc2, 0x9e, 0xe4, 0xd3, 0x6d, 0xf9, 0x65, 0xf2, 0xef, 0x41,
+	0x86, 0x1e, 0x0a, 0x7a, 0x59, 0x1a, 0x8f, 0xbf, 0xbe, 0x0e, 0x00, 0xa7, 0x1e, 0xce, 0xf7, 0x5a,
+	0x06, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -226,6 +471,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -280,6 +540,95 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochBoundary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBoundary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBoundary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochHistoryLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -300,6 +649,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochHistory) > 0 {
+		for iNdEx := len(m.EpochHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -353,6 +726,53 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.DurationBlocks))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpPolicy))
+	}
+	return n
+}
+
+func (m *EpochBoundary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochHistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochHistoryLength))
+	}
 	return n
 }
 
@@ -368,6 +788,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EpochHistory) > 0 {
+		for _, e := range m.EpochHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,20 +1023,351 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			iNdEx += skippy
-		}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochBoundary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochBoundary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochBoundary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHistoryLength", wireType)
+			}
+			m.EpochHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -679,6 +1438,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochHistory = append(m.EpochHistory, EpochBoundary{})
+			if err := m.EpochHistory[len(m.EpochHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -16,7 +16,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState([]EpochInfo{})
+	newGen := NewGenesisState(DefaultParams(), []EpochInfo{}, []EpochBoundary{})
 
 	testCases := []struct {
 		name     string
@@ -92,6 +92,42 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with height-based Epoch",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier:              "blocks",
+						StartTime:               time.Time{},
+						DurationBlocks:          1000,
+						StartHeight:             100,
+						CurrentEpoch:            0,
+						CurrentEpochStartHeight: 0,
+						CurrentEpochStartTime:   time.Time{},
+						EpochCountingStarted:    false,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - height-based Epoch with time duration",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier:              "blocks",
+						StartTime:               time.Time{},
+						Duration:                time.Hour * 24,
+						DurationBlocks:          1000,
+						CurrentEpoch:            0,
+						CurrentEpochStartHeight: 0,
+						CurrentEpochStartTime:   time.Time{},
+						EpochCountingStarted:    false,
+					},
+				},
+			},
+			false,
+		},
 		{
 			"invalid genesis - invalid Epoch",
 			&GenesisState{
@@ -109,6 +145,73 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with epoch history",
+			&GenesisState{
+				Params: DefaultParams(),
+				Epochs: []EpochInfo{
+					{
+						Identifier:   DayEpochID,
+						Duration:     time.Hour * 24,
+						CurrentEpoch: 3,
+					},
+				},
+				EpochHistory: []EpochBoundary{
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 100},
+					{Identifier: DayEpochID, EpochNumber: 2, StartHeight: 100, EndHeight: 200},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - epoch history length too long",
+			&GenesisState{
+				Params: NewParams(MaxEpochHistoryLength + 1),
+				Epochs: []EpochInfo{},
+			},
+			false,
+		},
+		{
+			"invalid genesis - epoch boundary of unknown epoch",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				EpochHistory: []EpochBoundary{
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 100},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated epoch boundary",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: DayEpochID,
+						Duration:   time.Hour * 24,
+					},
+				},
+				EpochHistory: []EpochBoundary{
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 100},
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 100},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - epoch boundary ends before its start",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: DayEpochID,
+						Duration:   time.Hour * 24,
+					},
+				},
+				EpochHistory: []EpochBoundary{
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 100, EndHeight: 1},
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
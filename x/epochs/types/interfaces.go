@@ -2,10 +2,24 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-// EpochHooks event hooks for epoch processing
+// EpochHooks event hooks for epoch processing. Each hook runs in a cached
+// context: the state changes of a hook returning an error or panicking are
+// discarded without affecting the other hooks.
 type EpochHooks interface {
 	// the first block whose timestamp is after the duration is counted as the end of the epoch
-	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 	// new epoch is next block of epoch end block
-	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+	// epochs missed while the chain was halted and skipped by the epoch
+	// catch-up policy, epochNumber is the number of the epoch that is starting
+	AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) error
+	// GetModuleName returns the name of the module receiving the hooks
+	GetModuleName() string
+}
+
+// EpochReferrer is implemented by the modules whose params or state reference
+// an epoch identifier, so that a referenced epoch cannot be deleted
+type EpochReferrer interface {
+	// IsEpochReferenced returns true if the module relies on the given epoch
+	IsEpochReferenced(ctx sdk.Context, epochIdentifier string) bool
 }
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "epochs"
@@ -14,7 +19,30 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixHookFailures
+	prefixEpochHistory
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+// KVStore key prefixes
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// KeyPrefixHookFailures defines prefix key for storing the number of
+	// failed hook executions per module
+	KeyPrefixHookFailures = []byte{prefixHookFailures}
+	// KeyPrefixEpochHistory defines prefix key for storing the boundaries of
+	// ended epochs per epoch identifier
+	KeyPrefixEpochHistory = []byte{prefixEpochHistory}
+)
+
+// GetEpochHistoryPrefix returns the store prefix of the epoch boundaries of
+// an epoch identifier
+func GetEpochHistoryPrefix(identifier string) []byte {
+	return append([]byte{prefixEpochHistory}, address.MustLengthPrefix([]byte(identifier))...)
+}
+
+// GetEpochBoundaryKey returns the key of an epoch boundary within the epoch
+// history prefix of its identifier
+func GetEpochBoundaryKey(epochNumber int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epochNumber))
+}
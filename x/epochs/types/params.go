@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultEpochHistoryLength is the default number of ended epochs kept per
+// epoch identifier
+const DefaultEpochHistoryLength uint64 = 100
+
+// MaxEpochHistoryLength bounds the number of ended epochs kept per epoch
+// identifier
+const MaxEpochHistoryLength uint64 = 10_000
+
+// Parameter store keys
+var ParamStoreKeyEpochHistoryLength = []byte("EpochHistoryLength")
+
+// ParamKeyTable returns the parameter key table for the epochs module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(epochHistoryLength uint64) Params {
+	return Params{
+		EpochHistoryLength: epochHistoryLength,
+	}
+}
+
+// DefaultParams returns the default epochs module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultEpochHistoryLength)
+}
+
+// ParamSetPairs returns the parameter set pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEpochHistoryLength, &p.EpochHistoryLength, validateEpochHistoryLength),
+	}
+}
+
+func validateEpochHistoryLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxEpochHistoryLength {
+		return fmt.Errorf("epoch history length cannot exceed %d: %d", MaxEpochHistoryLength, v)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the epochs module parameters
+func (p Params) Validate() error {
+	return validateEpochHistoryLength(p.EpochHistoryLength)
+}
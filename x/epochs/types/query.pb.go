@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -214,385 +219,2062 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "canto.epochs.v1.QueryEpochsInfoRequest")
-	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "canto.epochs.v1.QueryEpochsInfoResponse")
-	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "canto.epochs.v1.QueryCurrentEpochRequest")
-	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "canto.epochs.v1.QueryCurrentEpochResponse")
+// HookFailure defines the number of failed epoch hook executions of a module
+type HookFailure struct {
+	// module_name of the hook receiver
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// failures is the number of hook executions that failed or panicked
+	Failures uint64 `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func init() { proto.RegisterFile("canto/epochs/v1/query.proto", fileDescriptor_94315a0c94d7b69f) }
-
-var fileDescriptor_94315a0c94d7b69f = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x37, 0x5b, 0x2d, 0x98, 0x56, 0x84, 0x20, 0x76, 0x3b, 0xea, 0x74, 0x59, 0xa1, 0x5d,
-	0x0b, 0x26, 0xee, 0x7a, 0x29, 0x9e, 0xa4, 0x45, 0x45, 0x0f, 0xa2, 0x73, 0xf4, 0xa2, 0xd9, 0xf1,
-	0x35, 0x0d, 0xda, 0xbc, 0xe9, 0x24, 0xbb, 0xda, 0x9b, 0x78, 0x17, 0x04, 0x6f, 0xe2, 0x1f, 0xd4,
-	0x63, 0xc1, 0x8b, 0x27, 0x91, 0x5d, 0xff, 0x10, 0x99, 0x24, 0xd5, 0xfd, 0x05, 0xdb, 0xdb, 0x4c,
-	0xde, 0xf7, 0x7d, 0xdf, 0xe7, 0x7d, 0x13, 0x7a, 0x3d, 0x97, 0xc6, 0xa1, 0x80, 0x02, 0xf3, 0x03,
-	0x2b, 0x06, 0x1d, 0x71, 0xd4, 0x87, 0xf2, 0x98, 0x17, 0x25, 0x3a, 0x64, 0x57, 0x7c, 0x91, 0x87,
-	0x22, 0x1f, 0x74, 0x92, 0xab, 0x0a, 0x15, 0xfa, 0x9a, 0xa8, 0xbe, 0x82, 0x2c, 0xb9, 0xa1, 0x10,
-	0xd5, 0x3b, 0x10, 0xb2, 0xd0, 0x42, 0x1a, 0x83, 0x4e, 0x3a, 0x8d, 0xc6, 0xc6, 0xea, 0x76, 0x8e,
-	0xf6, 0x10, 0xad, 0xe8, 0x49, 0x0b, 0xc1, 0x5d, 0x0c, 0x3a, 0x3d, 0x70, 0xb2, 0x23, 0x0a, 0xa9,
-	0xb4, 0xf1, 0xe2, 0xa8, 0xbd, 0x39, 0x4d, 0xa3, 0xc0, 0x80, 0xd5, 0xd1, 0xaa, 0xf5, 0x9a, 0x5e,
-	0x7b, 0x51, 0x19, 0x3c, 0xf4, 0xf5, 0x27, 0x66, 0x1f, 0x33, 0x38, 0xea, 0x83, 0x75, 0xec, 0x11,
-	0xa5, 0xff, 0xcd, 0x1a, 0xa4, 0x49, 0xda, 0x2b, 0xdd, 0x4d, 0x1e, 0x26, 0xf3, 0x6a, 0x32, 0x0f,
-	0x7b, 0xc5, 0xc9, 0xfc, 0xb9, 0x54, 0x10, 0x7b, 0xb3, 0xb1, 0xce, 0xd6, 0x77, 0x42, 0xd7, 0x66,
-	0x46, 0xd8, 0x02, 0x8d, 0x05, 0xb6, 0x43, 0x97, 0x03, 0x58, 0x83, 0x34, 0x97, 0xda, 0x2b, 0xdd,
-	0x84, 0x4f, 0xc5, 0xc3, 0x7d, 0x53, 0xd5, 0xb3, 0x7b, 0xe1, 0xe4, 0xd7, 0x46, 0x2d, 0x8b, 0x7a,
-	0xf6, 0x78, 0x82, 0xae, 0xee, 0xe9, 0xb6, 0x16, 0xd2, 0x85, 0xb1, 0x13, 0x78, 0xf7, 0x69, 0xc3,
-	0xd3, 0xed, 0xf5, 0xcb, 0x12, 0x8c, 0xf3, 0xf3, 0xce, 0x22, 0x48, 0x29, 0xd5, 0x6f, 0xc0, 0x38,
-	0xbd, 0xaf, 0xa1, 0xf4, 0x11, 0x5c, 0xca, 0xc6, 0x4e, 0x5a, 0x0f, 0xe8, 0xfa, 0x9c, 0xde, 0xb8,
-	0xdb, 0x2d, 0x7a, 0x39, 0x0f, 0xe7, 0xaf, 0x3c, 0xb3, 0xef, 0x5f, 0xca, 0x56, 0xf3, 0x31, 0x71,
-	0xf7, 0x5b, 0x9d, 0x5e, 0xf4, 0x16, 0xec, 0x23, 0xa1, 0xf4, 0xdf, 0xb2, 0x96, 0x6d, 0xcd, 0x24,
-	0x31, 0xff, 0x9a, 0x92, 0xf6, 0x62, 0x61, 0x00, 0x6a, 0x6d, 0x7c, 0xfa, 0xf1, 0xe7, 0x6b, 0x7d,
-	0x9d, 0xad, 0x89, 0xe9, 0x27, 0x11, 0x33, 0xfd, 0x4c, 0xe8, 0xea, 0xf8, 0x2a, 0xec, 0xf6, 0x7c,
-	0xef, 0x39, 0x51, 0x25, 0xdb, 0xe7, 0x91, 0x46, 0x90, 0x4d, 0x0f, 0xd2, 0x64, 0xe9, 0x0c, 0xc8,
-	0x44, 0x60, 0xbb, 0x4f, 0x4f, 0x86, 0x29, 0x39, 0x1d, 0xa6, 0xe4, 0xf7, 0x30, 0x25, 0x5f, 0x46,
-	0x69, 0xed, 0x74, 0x94, 0xd6, 0x7e, 0x8e, 0xd2, 0xda, 0xcb, 0xbb, 0x4a, 0xbb, 0x83, 0x7e, 0x8f,
-	0xe7, 0x78, 0x28, 0xf6, 0x2a, 0x8f, 0x3b, 0xcf, 0xc0, 0xbd, 0xc7, 0xf2, 0x6d, 0xf8, 0x13, 0x83,
-	0x1d, 0xf1, 0xe1, 0xcc, 0xd6, 0x1d, 0x17, 0x60, 0x7b, 0xcb, 0xfe, 0xb9, 0xdf, 0xfb, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x87, 0x9e, 0x38, 0xd3, 0x9d, 0x03, 0x00, 0x00,
+func (m *HookFailure) Reset()         { *m = HookFailure{} }
+func (m *HookFailure) String() string { return proto.CompactTextString(m) }
+func (*HookFailure) ProtoMessage()    {}
+func (*HookFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{4}
+}
+func (m *HookFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookFailure.Merge(m, src)
+}
+func (m *HookFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookFailure.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_HookFailure proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// EpochInfos provide running epochInfos
-	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
-	// CurrentEpoch provide current epoch of specified identifier
-	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+func (m *HookFailure) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *HookFailure) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryHookFailuresRequest struct {
 }
 
-func (c *queryClient) EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error) {
-	out := new(QueryEpochsInfoResponse)
-	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/EpochInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHookFailuresRequest) Reset()         { *m = QueryHookFailuresRequest{} }
+func (m *QueryHookFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHookFailuresRequest) ProtoMessage()    {}
+func (*QueryHookFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{5}
+}
+func (m *QueryHookFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHookFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookFailuresRequest.Merge(m, src)
+}
+func (m *QueryHookFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookFailuresRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
-	out := new(QueryCurrentEpochResponse)
-	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/CurrentEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHookFailuresRequest proto.InternalMessageInfo
+
+type QueryHookFailuresResponse struct {
+	HookFailures []HookFailure `protobuf:"bytes,1,rep,name=hook_failures,json=hookFailures,proto3" json:"hook_failures"`
+}
+
+func (m *QueryHookFailuresResponse) Reset()         { *m = QueryHookFailuresResponse{} }
+func (m *QueryHookFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHookFailuresResponse) ProtoMessage()    {}
+func (*QueryHookFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{6}
+}
+func (m *QueryHookFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHookFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookFailuresResponse.Merge(m, src)
+}
+func (m *QueryHookFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookFailuresResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// EpochInfos provide running epochInfos
-	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
-	// CurrentEpoch provide current epoch of specified identifier
-	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+var xxx_messageInfo_QueryHookFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryHookFailuresResponse) GetHookFailures() []HookFailure {
+	if m != nil {
+		return m.HookFailures
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryEpochHistoryRequest struct {
+	Identifier string             `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochInfos not implemented")
+func (m *QueryEpochHistoryRequest) Reset()         { *m = QueryEpochHistoryRequest{} }
+func (m *QueryEpochHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochHistoryRequest) ProtoMessage()    {}
+func (*QueryEpochHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{7}
 }
-func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+func (m *QueryEpochHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEpochHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochHistoryRequest.Merge(m, src)
+}
+func (m *QueryEpochHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_EpochInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochsInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/canto.epochs.v1.Query/EpochInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochInfos(ctx, req.(*QueryEpochsInfoRequest))
+var xxx_messageInfo_QueryEpochHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEpochHistoryRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/canto.epochs.v1.Query/CurrentEpoch",
+func (m *QueryEpochHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	return nil
+}
+
+type QueryEpochHistoryResponse struct {
+	// epoch_history in ascending epoch number order
+	EpochHistory []EpochBoundary     `protobuf:"bytes,1,rep,name=epoch_history,json=epochHistory,proto3" json:"epoch_history"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochHistoryResponse) Reset()         { *m = QueryEpochHistoryResponse{} }
+func (m *QueryEpochHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochHistoryResponse) ProtoMessage()    {}
+func (*QueryEpochHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{8}
+}
+func (m *QueryEpochHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEpochHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochHistoryResponse.Merge(m, src)
+}
+func (m *QueryEpochHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochHistoryResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "canto.epochs.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EpochInfos",
-			Handler:    _Query_EpochInfos_Handler,
-		},
-		{
-			MethodName: "CurrentEpoch",
-			Handler:    _Query_CurrentEpoch_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "canto/epochs/v1/query.proto",
+var xxx_messageInfo_QueryEpochHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEpochHistoryResponse) GetEpochHistory() []EpochBoundary {
+	if m != nil {
+		return m.EpochHistory
+	}
+	return nil
 }
 
-func (m *QueryEpochsInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEpochsInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNextEpochEndRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
-func (m *QueryEpochsInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNextEpochEndRequest) Reset()         { *m = QueryNextEpochEndRequest{} }
+func (m *QueryNextEpochEndRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochEndRequest) ProtoMessage()    {}
+func (*QueryNextEpochEndRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{9}
+}
+func (m *QueryNextEpochEndRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochEndRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochEndRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNextEpochEndRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochEndRequest.Merge(m, src)
+}
+func (m *QueryNextEpochEndRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochEndRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochEndRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEpochsInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextEpochEndRequest proto.InternalMessageInfo
+
+func (m *QueryNextEpochEndRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEpochsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNextEpochEndResponse defines the expected end of the current epoch.
+// The end height of a time-based epoch and the end time of a height-based
+// epoch are estimated from the average block time.
+type QueryNextEpochEndResponse struct {
+	// current_epoch is the number of the epoch that ends next
+	CurrentEpoch int64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// end_time is the expected end time of the current epoch
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// end_height is the expected block height at which the current epoch ends
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// average_block_time used for the estimation, zero if unknown
+	AverageBlockTime time.Duration `protobuf:"bytes,4,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time"`
 }
 
-func (m *QueryEpochsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Epochs) > 0 {
-		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryNextEpochEndResponse) Reset()         { *m = QueryNextEpochEndResponse{} }
+func (m *QueryNextEpochEndResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochEndResponse) ProtoMessage()    {}
+func (*QueryNextEpochEndResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{10}
+}
+func (m *QueryNextEpochEndResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochEndResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochEndResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNextEpochEndResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochEndResponse.Merge(m, src)
+}
+func (m *QueryNextEpochEndResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochEndResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochEndResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextEpochEndResponse proto.InternalMessageInfo
+
+func (m *QueryNextEpochEndResponse) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNextEpochEndResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Identifier) > 0 {
-		i -= len(m.Identifier)
-		copy(dAtA[i:], m.Identifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryNextEpochEndResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNextEpochEndResponse) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CurrentEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{11}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
-func (m *QueryEpochsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94315a0c94d7b69f, []int{12}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEpochsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	if len(m.Epochs) > 0 {
-		for _, e := range m.Epochs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "canto.epochs.v1.QueryEpochsInfoRequest")
+	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "canto.epochs.v1.QueryEpochsInfoResponse")
+	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "canto.epochs.v1.QueryCurrentEpochRequest")
+	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "canto.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*HookFailure)(nil), "canto.epochs.v1.HookFailure")
+	proto.RegisterType((*QueryHookFailuresRequest)(nil), "canto.epochs.v1.QueryHookFailuresRequest")
+	proto.RegisterType((*QueryHookFailuresResponse)(nil), "canto.epochs.v1.QueryHookFailuresResponse")
+	proto.RegisterType((*QueryEpochHistoryRequest)(nil), "canto.epochs.v1.QueryEpochHistoryRequest")
+	proto.RegisterType((*QueryEpochHistoryResponse)(nil), "canto.epochs.v1.QueryEpochHistoryResponse")
+	proto.RegisterType((*QueryNextEpochEndRequest)(nil), "canto.epochs.v1.QueryNextEpochEndRequest")
+	proto.RegisterType((*QueryNextEpochEndResponse)(nil), "canto.epochs.v1.QueryNextEpochEndResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "canto.epochs.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "canto.epochs.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("canto/epochs/v1/query.proto", fileDescriptor_94315a0c94d7b69f) }
+
+var fileDescriptor_94315a0c94d7b69f = []byte{
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0xdb, 0x12, 0xba, 0x6f, 0x52, 0x81, 0x86, 0x15, 0x4d, 0xcc, 0xae, 0x53, 0x79, 0xd1,
+	0x6e, 0x09, 0xac, 0x4d, 0xba, 0x20, 0xad, 0xb8, 0x80, 0xb2, 0xec, 0x6e, 0x77, 0x85, 0xaa, 0x6d,
+	0xc4, 0x89, 0x4b, 0x98, 0xc4, 0x13, 0xc7, 0x4a, 0x3c, 0xe3, 0xda, 0xe3, 0xd0, 0x08, 0x21, 0xa1,
+	0xde, 0x91, 0x2a, 0x71, 0x41, 0xc0, 0x7f, 0xe0, 0x6f, 0xf4, 0x58, 0x89, 0x0b, 0x12, 0x12, 0xa0,
+	0x96, 0x7f, 0xc0, 0x1f, 0x40, 0x9e, 0x19, 0xa7, 0x76, 0xe2, 0x92, 0x80, 0xb8, 0xd9, 0xf3, 0x7e,
+	0x3c, 0xcf, 0xf3, 0xce, 0x3b, 0x0f, 0xbc, 0xd1, 0xc7, 0x94, 0x33, 0x9b, 0x04, 0xac, 0x3f, 0x8c,
+	0xec, 0x49, 0xcb, 0x3e, 0x8a, 0x49, 0x38, 0xb5, 0x82, 0x90, 0x71, 0x86, 0x5e, 0x11, 0x41, 0x4b,
+	0x06, 0xad, 0x49, 0x4b, 0xbf, 0xe9, 0x32, 0x97, 0x89, 0x98, 0x9d, 0x7c, 0xc9, 0x34, 0xfd, 0x96,
+	0xcb, 0x98, 0x3b, 0x26, 0x36, 0x0e, 0x3c, 0x1b, 0x53, 0xca, 0x38, 0xe6, 0x1e, 0xa3, 0x91, 0x8a,
+	0x1a, 0x2a, 0x2a, 0xfe, 0x7a, 0xf1, 0xc0, 0x76, 0xe2, 0x50, 0x24, 0xa8, 0x78, 0x63, 0x3e, 0xce,
+	0x3d, 0x9f, 0x44, 0x1c, 0xfb, 0x81, 0x4a, 0x68, 0xf6, 0x59, 0xe4, 0xb3, 0xc8, 0xee, 0xe1, 0x88,
+	0x48, 0x7a, 0xf6, 0xa4, 0xd5, 0x23, 0x1c, 0xb7, 0xec, 0x00, 0xbb, 0x1e, 0xcd, 0x36, 0xbb, 0x3d,
+	0x2f, 0xc7, 0x25, 0x94, 0x44, 0x9e, 0xe2, 0x62, 0x7e, 0x0e, 0xaf, 0x1f, 0x26, 0x0d, 0x1e, 0x8b,
+	0xf8, 0x33, 0x3a, 0x60, 0x1d, 0x72, 0x14, 0x93, 0x88, 0xa3, 0x27, 0x00, 0x57, 0xcd, 0x6a, 0xda,
+	0x8e, 0xb6, 0x5b, 0xd9, 0xbb, 0x6b, 0x49, 0x64, 0x2b, 0x41, 0xb6, 0xe4, 0x60, 0x14, 0xb2, 0xf5,
+	0x02, 0xbb, 0x44, 0xd5, 0x76, 0x32, 0x95, 0xe6, 0x8f, 0x1a, 0x6c, 0x2f, 0x40, 0x44, 0x01, 0xa3,
+	0x11, 0x41, 0x0f, 0xa1, 0x2c, 0x89, 0xd5, 0xb4, 0x9d, 0xf5, 0xdd, 0xca, 0x9e, 0x6e, 0xcd, 0xcd,
+	0xd7, 0x12, 0x45, 0x49, 0x4d, 0x7b, 0xe3, 0xec, 0xb7, 0x46, 0xa9, 0xa3, 0xf2, 0xd1, 0xd3, 0x1c,
+	0xbb, 0x35, 0xc1, 0xee, 0xde, 0x52, 0x76, 0x12, 0x36, 0x47, 0xef, 0x03, 0xa8, 0x09, 0x76, 0x8f,
+	0xe2, 0x30, 0x24, 0x94, 0x0b, 0xbc, 0x74, 0x04, 0x06, 0x80, 0xe7, 0x10, 0xca, 0xbd, 0x81, 0x47,
+	0x42, 0x31, 0x82, 0x1b, 0x9d, 0xcc, 0x89, 0xf9, 0x11, 0xd4, 0x0b, 0x6a, 0x95, 0xb6, 0x3b, 0xb0,
+	0xd5, 0x97, 0xe7, 0x5d, 0xc1, 0x59, 0xd4, 0xaf, 0x77, 0xaa, 0xfd, 0x4c, 0xb2, 0xf9, 0x1c, 0x2a,
+	0xfb, 0x8c, 0x8d, 0x9e, 0x60, 0x6f, 0x1c, 0x87, 0x04, 0x35, 0xa0, 0xe2, 0x33, 0x27, 0x1e, 0x93,
+	0x2e, 0xc5, 0x3e, 0x49, 0x11, 0xe5, 0xd1, 0x01, 0xf6, 0x09, 0xd2, 0x61, 0x73, 0x20, 0x73, 0x23,
+	0x21, 0x7a, 0xa3, 0x33, 0xfb, 0x37, 0x75, 0xa5, 0x24, 0xd3, 0x30, 0x52, 0x4a, 0x4c, 0x07, 0xea,
+	0x05, 0x31, 0xc5, 0xf4, 0x29, 0x6c, 0x0d, 0x19, 0x1b, 0x75, 0x67, 0x9d, 0xe5, 0x65, 0xdc, 0x5a,
+	0xb8, 0x8c, 0x4c, 0xb5, 0xba, 0x8e, 0xea, 0x30, 0xd3, 0xd0, 0x3c, 0xd1, 0x14, 0x05, 0x21, 0x6e,
+	0xdf, 0x8b, 0x38, 0x0b, 0xa7, 0x2b, 0x0e, 0x73, 0x6e, 0xdf, 0xd6, 0xfe, 0xf3, 0xbe, 0xfd, 0xa4,
+	0x41, 0xbd, 0x80, 0x84, 0xd2, 0xfa, 0x0c, 0xb6, 0x84, 0x9e, 0xee, 0x50, 0x06, 0x94, 0x56, 0xa3,
+	0x78, 0xf1, 0xda, 0x2c, 0xa6, 0x0e, 0x0e, 0xa7, 0xa9, 0x5a, 0x92, 0x69, 0xf9, 0xff, 0xaf, 0xe0,
+	0x01, 0x39, 0x96, 0x6b, 0xf1, 0x98, 0x3a, 0xab, 0xae, 0xe0, 0x5f, 0xa9, 0xda, 0x7c, 0xf1, 0xbf,
+	0xd8, 0x41, 0xf4, 0x21, 0x6c, 0x12, 0xea, 0x74, 0x13, 0x93, 0x51, 0x2a, 0x74, 0x4b, 0x3a, 0x90,
+	0x95, 0x3a, 0x90, 0xf5, 0x69, 0xea, 0x40, 0xed, 0xcd, 0x64, 0x12, 0xa7, 0xbf, 0x37, 0xb4, 0xce,
+	0xcb, 0x84, 0x3a, 0xc9, 0x39, 0xba, 0x0d, 0x90, 0x34, 0x18, 0x12, 0xcf, 0x1d, 0xf2, 0xda, 0xba,
+	0x80, 0xb8, 0x41, 0xa8, 0xb3, 0x2f, 0x0e, 0xd0, 0x21, 0x20, 0x3c, 0x21, 0x21, 0x76, 0x49, 0xb7,
+	0x37, 0x66, 0xfd, 0x91, 0x44, 0xda, 0x10, 0x48, 0xf5, 0x05, 0xa4, 0x8f, 0x95, 0x17, 0x4a, 0xa0,
+	0xef, 0x12, 0xa0, 0x57, 0x55, 0x79, 0x3b, 0xa9, 0x4e, 0x10, 0xcd, 0x9b, 0x80, 0x84, 0xe8, 0x17,
+	0x38, 0xc4, 0xfe, 0x6c, 0xc9, 0x3f, 0x81, 0xd7, 0x72, 0xa7, 0x6a, 0x08, 0xef, 0x43, 0x39, 0x10,
+	0x27, 0xca, 0xc4, 0xb6, 0x17, 0xee, 0x5a, 0x16, 0xa4, 0x0e, 0x23, 0x93, 0xf7, 0x7e, 0x2d, 0xc3,
+	0x4b, 0xa2, 0x1d, 0xfa, 0x5a, 0x03, 0x98, 0xf9, 0x50, 0x84, 0xee, 0x2d, 0xd4, 0x17, 0x3b, 0xa8,
+	0xbe, 0xbb, 0x3c, 0x51, 0x52, 0x34, 0x1b, 0x27, 0x3f, 0xff, 0xf9, 0xed, 0x5a, 0x1d, 0x6d, 0xdb,
+	0xf3, 0x6e, 0x2d, 0xbf, 0xd0, 0x37, 0x1a, 0x54, 0xb3, 0x2e, 0x83, 0xde, 0x2a, 0xee, 0x5d, 0xe0,
+	0x62, 0x7a, 0x73, 0x95, 0x54, 0x45, 0xe4, 0xae, 0x20, 0xb2, 0x83, 0x8c, 0x05, 0x22, 0xb9, 0x3d,
+	0x12, 0x7c, 0xb2, 0x5e, 0x72, 0x1d, 0x9f, 0x02, 0x2f, 0xd2, 0x9b, 0xab, 0xa4, 0x2e, 0xe5, 0x93,
+	0x73, 0x2c, 0xf4, 0xbd, 0x06, 0xd5, 0xec, 0x7b, 0xbf, 0x8e, 0x4f, 0x81, 0x31, 0xe9, 0xcd, 0x55,
+	0x52, 0x15, 0x9f, 0x07, 0x82, 0xcf, 0x7d, 0xf4, 0x76, 0xf1, 0x45, 0xa5, 0xae, 0x62, 0x7f, 0x79,
+	0xf5, 0x44, 0xbf, 0x42, 0x3f, 0x68, 0x50, 0xcd, 0x3e, 0xcf, 0xeb, 0xc8, 0x15, 0xbc, 0x7f, 0xbd,
+	0xb9, 0x4a, 0xaa, 0x22, 0xf7, 0x9e, 0x20, 0x67, 0xa1, 0x77, 0x16, 0xc8, 0x51, 0x72, 0xac, 0x6e,
+	0xae, 0x4b, 0xa8, 0x93, 0x67, 0xc7, 0xa1, 0x2c, 0xf7, 0x1f, 0xdd, 0x29, 0xc6, 0xca, 0x3d, 0x32,
+	0xfd, 0xcd, 0x7f, 0x4e, 0x5a, 0xba, 0xd0, 0xf2, 0x75, 0xb5, 0x9f, 0x9f, 0x5d, 0x18, 0xda, 0xf9,
+	0x85, 0xa1, 0xfd, 0x71, 0x61, 0x68, 0xa7, 0x97, 0x46, 0xe9, 0xfc, 0xd2, 0x28, 0xfd, 0x72, 0x69,
+	0x94, 0x3e, 0x7b, 0xd7, 0xf5, 0xf8, 0x30, 0xee, 0x59, 0x7d, 0xe6, 0xdb, 0x8f, 0x92, 0xe2, 0xfb,
+	0x07, 0x84, 0x7f, 0xc1, 0xc2, 0x91, 0xfc, 0xb3, 0x27, 0x0f, 0xed, 0xe3, 0xb4, 0x1f, 0x9f, 0x06,
+	0x24, 0xea, 0x95, 0x85, 0x79, 0x3c, 0xf8, 0x7b, 0x00, 0x1b, 0x78, 0xf1, 0x48, 0xba, 0x09, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// EpochInfos provide running epochInfos
+	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
+	// CurrentEpoch provide current epoch of specified identifier
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions of each
+	// hook receiver
+	HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error)
+	// EpochHistory provide the boundaries of the recently ended epochs of
+	// specified identifier
+	EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error)
+	// NextEpochEnd provide the expected end of the current epoch of specified
+	// identifier
+	NextEpochEnd(ctx context.Context, in *QueryNextEpochEndRequest, opts ...grpc.CallOption) (*QueryNextEpochEndResponse, error)
+	// Params retrieves the epochs module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error) {
+	out := new(QueryEpochsInfoResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/EpochInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error) {
+	out := new(QueryHookFailuresResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/HookFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error) {
+	out := new(QueryEpochHistoryResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/EpochHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) NextEpochEnd(ctx context.Context, in *QueryNextEpochEndRequest, opts ...grpc.CallOption) (*QueryNextEpochEndResponse, error) {
+	out := new(QueryNextEpochEndResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/NextEpochEnd", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/canto.epochs.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// EpochInfos provide running epochInfos
+	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
+	// CurrentEpoch provide current epoch of specified identifier
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions of each
+	// hook receiver
+	HookFailures(context.Context, *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error)
+	// EpochHistory provide the boundaries of the recently ended epochs of
+	// specified identifier
+	EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error)
+	// NextEpochEnd provide the expected end of the current epoch of specified
+	// identifier
+	NextEpochEnd(context.Context, *QueryNextEpochEndRequest) (*QueryNextEpochEndResponse, error)
+	// Params retrieves the epochs module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfos not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (*UnimplementedQueryServer) HookFailures(ctx context.Context, req *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookFailures not implemented")
+}
+func (*UnimplementedQueryServer) EpochHistory(ctx context.Context, req *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochHistory not implemented")
+}
+func (*UnimplementedQueryServer) NextEpochEnd(ctx context.Context, req *QueryNextEpochEndRequest) (*QueryNextEpochEndResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochEnd not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_EpochInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/EpochInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfos(ctx, req.(*QueryEpochsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/HookFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookFailures(ctx, req.(*QueryHookFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/EpochHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochHistory(ctx, req.(*QueryEpochHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextEpochEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochEndRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/NextEpochEnd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochEnd(ctx, req.(*QueryNextEpochEndRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.epochs.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "canto.epochs.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EpochInfos",
+			Handler:    _Query_EpochInfos_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "HookFailures",
+			Handler:    _Query_HookFailures_Handler,
+		},
+		{
+			MethodName: "EpochHistory",
+			Handler:    _Query_EpochHistory_Handler,
+		},
+		{
+			MethodName: "NextEpochEnd",
+			Handler:    _Query_NextEpochEnd_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "canto/epochs/v1/query.proto",
+}
+
+func (m *QueryEpochsInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochsInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookFailures) > 0 {
+		for iNdEx := len(m.HookFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochHistory) > 0 {
+		for iNdEx := len(m.EpochHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochEndRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochEndRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochEndRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochEndResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochEndResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochEndResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEpochsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *HookFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovQuery(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *QueryHookFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHookFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HookFailures) > 0 {
+		for _, e := range m.HookFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochHistory) > 0 {
+		for _, e := range m.EpochHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextEpochEndRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextEpochEndResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHookFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHookFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHookFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHookFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHookFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHookFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookFailures = append(m.HookFailures, HookFailure{})
+			if err := m.HookFailures[len(m.HookFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -615,13 +2297,45 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -678,7 +2392,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -701,15 +2415,15 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -736,8 +2450,8 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epochs = append(m.Epochs, EpochInfo{})
-			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochHistory = append(m.EpochHistory, EpochBoundary{})
+			if err := m.EpochHistory[len(m.EpochHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -798,7 +2512,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextEpochEndRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,10 +2535,10 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextEpochEndRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextEpochEndRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -880,7 +2594,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextEpochEndResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -903,10 +2617,10 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextEpochEndResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextEpochEndResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -928,6 +2642,224 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
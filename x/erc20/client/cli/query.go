@@ -24,6 +24,13 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
+		GetPendingRegistrationsCmd(),
+		GetTokenPairDriftsCmd(),
+		GetBalancesCmd(),
+		GetAutoDisabledTokenPairsCmd(),
+		GetUnrefundedDepositsCmd(),
 	)
 	return cmd
 }
@@ -126,3 +133,265 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRateLimitsCmd queries the conversion rate limits of all token pairs
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets the conversion rate limits of all token pairs",
+		Long:  "Gets the conversion rate limits of all token pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRateLimitCmd queries the conversion rate limit of a token pair
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [token]",
+		Short: "Get the conversion rate limit and remaining quota of a token pair",
+		Long:  "Get the conversion rate limit of a token pair and the quota left in the current epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPendingRegistrationsCmd queries the token pairs waiting for activation
+func GetPendingRegistrationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-registrations",
+		Short: "Gets the token pairs registered without governance that are waiting for activation",
+		Long:  "Gets the token pairs registered without governance that are waiting for activation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingRegistrationsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingRegistrations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairDriftsCmd queries the drift between the representations of the
+// registered token pairs
+func GetTokenPairDriftsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-drifts",
+		Short: "Gets the difference between the ERC20 and the Cosmos coin amounts backing the registered token pairs",
+		Long:  "Gets the difference between the ERC20 and the Cosmos coin amounts backing the registered token pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairDriftsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairDrifts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBalancesCmd queries the balances of an account for every registered token
+// pair
+func GetBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Gets the coin and ERC20 balances of an account for every registered token pair",
+		Long:  "Gets the coin and ERC20 balances of an account for every registered token pair. The address can be either hex ('0x...') or bech32.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBalancesRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Balances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balances")
+	return cmd
+}
+
+// GetAutoDisabledTokenPairsCmd queries the token pairs disabled after an escrow
+// balance mismatch
+func GetAutoDisabledTokenPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-disabled-token-pairs",
+		Short: "Gets the token pairs disabled after a conversion escrowed a different amount than requested",
+		Long:  "Gets the native ERC20 token pairs disabled after a conversion escrowed a different amount than requested, as happens with fee-on-transfer or rebasing tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAutoDisabledTokenPairsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AutoDisabledTokenPairs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto-disabled-token-pairs")
+	return cmd
+}
+
+// GetUnrefundedDepositsCmd queries the registration deposits that could not be
+// refunded at activation
+func GetUnrefundedDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unrefunded-deposits",
+		Short: "Gets the registration deposits that could not be refunded at activation",
+		Long:  "Gets the registration deposits that could not be refunded to the depositor when the token pair was activated. They are held on the module account until governance recovers them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnrefundedDepositsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UnrefundedDeposits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unrefunded-deposits")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -40,9 +41,17 @@ func NewTxCmd(ac addresscodec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
+		NewConvertCoinsCmd(),
+		NewConvertERC20sCmd(),
+		NewRegisterERC20PermissionlessCmd(),
 		NewRegisterCoinProposalCmd(ac),
 		NewRegisterERC20ProposalCmd(ac),
 		NewToggleTokenConversionProposalCmd(ac),
+		NewSetRateLimitProposalCmd(ac),
+		NewRemoveRateLimitProposalCmd(ac),
+		NewMigrateTokenPairProposalCmd(ac),
+		NewRefreshTokenPairMetadataProposalCmd(ac),
+		NewRecoverDepositProposalCmd(ac),
 	)
 	return txCmd
 }
@@ -173,6 +182,153 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewConvertCoinsCmd returns a CLI command handler for converting a batch of
+// Cosmos coins
+func NewConvertCoinsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coins [coins] [receiver_hex]",
+		Short: "Convert a batch of Cosmos coins to ERC20. When the receiver [optional] is omitted, the ERC20 tokens are transferred to the sender.",
+		Example: fmt.Sprintf(
+			"%s tx %s convert-coins 100ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,50erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoins{
+				Coins:    coins,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if msg.Coins.Empty() {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "no coins to convert")
+			}
+
+			for _, coin := range msg.Coins {
+				if err := types.ValidateErc20Denom(coin.Denom); err != nil {
+					if err := ibctransfertypes.ValidateIBCDenom(coin.Denom); err != nil {
+						return err
+					}
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20sCmd returns a CLI command handler for converting a batch of
+// ERC20 tokens
+func NewConvertERC20sCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20s [contract-address:amount,...] [receiver]",
+		Short: "Convert a batch of ERC20 tokens to Cosmos coins. When the receiver [optional] is omitted, the Cosmos coins are transferred to the sender.",
+		Example: fmt.Sprintf(
+			"%s tx %s convert-erc20s 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd:100,0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75:50",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tokens []types.ERC20Amount
+			for _, token := range strings.Split(args[0], ",") {
+				contract, amountStr, ok := strings.Cut(strings.TrimSpace(token), ":")
+				if !ok {
+					return fmt.Errorf("invalid token %s, expected format contract-address:amount", token)
+				}
+
+				if err := ethermint.ValidateAddress(contract); err != nil {
+					return fmt.Errorf("invalid ERC20 contract address %w", err)
+				}
+
+				amount, ok := sdkmath.NewIntFromString(amountStr)
+				if !ok || !amount.IsPositive() {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+
+				tokens = append(tokens, types.NewERC20Amount(common.HexToAddress(contract), amount))
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 2 {
+				receiver, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC20S(tokens, receiver, from)
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterERC20PermissionlessCmd returns a CLI command handler for
+// registering an ERC20 token without a governance proposal
+func NewRegisterERC20PermissionlessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address]",
+		Short: "Register an ERC20 token by escrowing the registration deposit. The token pair is activated after the challenge period unless governance disables it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRegisterERC20Permissionless{
+				Sender:       cliCtx.GetFromAddress().String(),
+				Erc20Address: contract,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterCoinProposalCmd implements the command to submit a register-coin proposal
 func NewRegisterCoinProposalCmd(ac addresscodec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -345,3 +501,279 @@ func NewToggleTokenConversionProposalCmd(ac addresscodec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// NewSetRateLimitProposalCmd implements the command to submit a set-rate-limit proposal
+func NewSetRateLimitProposalCmd(ac addresscodec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [token] [epoch_identifier] [max_erc20_to_coin] [max_coin_to_erc20]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to set the conversion rate limit of a token pair",
+		Long: `Submit a proposal to set the maximum amount of a token pair that can be converted in each direction per epoch along with an initial deposit.
+A maximum of "unlimited" leaves the conversion direction unlimited, while a maximum of zero blocks it.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-rate-limit <denom_or_contract> day 1000000 unlimited", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxERC20ToCoin, err := parseRateLimitMax(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max ERC20 to coin amount: %w", err)
+			}
+
+			maxCoinToERC20, err := parseRateLimitMax(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid max coin to ERC20 amount: %w", err)
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = ac.StringToBytes(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           args[0],
+					EpochIdentifier: args[1],
+					MaxErc20ToCoin:  maxERC20ToCoin,
+					MaxCoinToErc20:  maxCoinToERC20,
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit set rate limit proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRateLimitMax parses a rate limit maximum, where "unlimited" returns a
+// nil maximum
+func parseRateLimitMax(arg string) (*sdkmath.Int, error) {
+	if arg == "unlimited" {
+		return nil, nil
+	}
+
+	max, ok := sdkmath.NewIntFromString(arg)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an integer or unlimited", arg)
+	}
+	return &max, nil
+}
+
+// NewRemoveRateLimitProposalCmd implements the command to submit a remove-rate-limit proposal
+func NewRemoveRateLimitProposalCmd(ac addresscodec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to remove the conversion rate limit of a token pair",
+		Long:    "Submit a proposal to remove the conversion rate limit of a token pair along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal remove-rate-limit <denom_or_contract>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = ac.StringToBytes(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgRemoveRateLimit{
+					Authority: authority,
+					Token:     args[0],
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit remove rate limit proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMigrateTokenPairProposalCmd implements the command to submit a
+// migrate-token-pair proposal
+func NewMigrateTokenPairProposalCmd(ac addresscodec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-token-pair [token] [new_erc20_address] [escrow_recipient]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to point the denom of a token pair at a redeployed ERC20 contract",
+		Long:    "Submit a proposal to point the denom of a token pair at a redeployed ERC20 contract along with an initial deposit. The module address must hold enough new tokens to back the coin supply, and the tokens escrowed on the old contract are sent to the escrow recipient.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal migrate-token-pair <denom_or_contract> <new_contract> <escrow_recipient>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = ac.StringToBytes(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if err := ethermint.ValidateAddress(args[1]); err != nil {
+				return fmt.Errorf("invalid new ERC20 contract address %w", err)
+			}
+
+			if err := ethermint.ValidateAddress(args[2]); err != nil {
+				return fmt.Errorf("invalid escrow recipient address %w", err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgMigrateTokenPair{
+					Authority:       authority,
+					Token:           args[0],
+					NewErc20Address: args[1],
+					EscrowRecipient: args[2],
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit migrate token pair proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefreshTokenPairMetadataProposalCmd implements the command to submit a
+// refresh-token-pair-metadata proposal
+func NewRefreshTokenPairMetadataProposalCmd(ac addresscodec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refresh-token-pair-metadata [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to resync the bank metadata of a token pair with its ERC20 contract",
+		Long:    "Submit a proposal to resync the symbol and display unit of the bank metadata of a token pair with its ERC20 contract along with an initial deposit. The proposal fails if the contract decimals changed.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal refresh-token-pair-metadata <denom_or_contract>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = ac.StringToBytes(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgRefreshTokenPairMetadata{
+					Authority: authority,
+					Token:     args[0],
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit refresh token pair metadata proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRecoverDepositProposalCmd implements the command to submit a
+// recover-deposit proposal
+func NewRecoverDepositProposalCmd(ac addresscodec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recover-deposit [erc20_address] [recipient]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to send a registration deposit that could not be refunded to a recipient",
+		Long:    "Submit a proposal to send a registration deposit that could not be refunded to the depositor at activation to a recipient along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal recover-deposit <contract> <recipient>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = ac.StringToBytes(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if _, err := ac.StringToBytes(args[1]); err != nil {
+				return fmt.Errorf("invalid recipient address: %w", err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{
+				&types.MsgRecoverDeposit{
+					Authority:    authority,
+					Erc20Address: args[0],
+					Recipient:    args[1],
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to create submit recover deposit proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -40,14 +40,34 @@ func InitGenesis(
 		id := idx.GetTokenPairId()
 		k.SetTokenPairIdByERC20Addr(ctx, common.BytesToAddress(idx.Erc20Address), id)
 	}
+
+	for _, rl := range data.RateLimits {
+		k.SetRateLimit(ctx, rl)
+	}
+
+	for _, pending := range data.PendingRegistrations {
+		k.SetPendingRegistration(ctx, pending)
+	}
+
+	for _, adp := range data.AutoDisabledTokenPairs {
+		k.SetAutoDisabledTokenPair(ctx, adp)
+	}
+
+	for _, deposit := range data.UnrefundedDeposits {
+		k.SetUnrefundedDeposit(ctx, deposit)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:              k.GetParams(ctx),
-		TokenPairs:          k.GetTokenPairs(ctx),
-		DenomIndexes:        k.GetAllTokenPairDenomIndexes(ctx),
-		Erc20AddressIndexes: k.GetAllTokenPairERC20AddressIndexes(ctx),
+		Params:                 k.GetParams(ctx),
+		TokenPairs:             k.GetTokenPairs(ctx),
+		DenomIndexes:           k.GetAllTokenPairDenomIndexes(ctx),
+		Erc20AddressIndexes:    k.GetAllTokenPairERC20AddressIndexes(ctx),
+		RateLimits:             k.GetRateLimits(ctx),
+		PendingRegistrations:   k.GetPendingRegistrations(ctx),
+		AutoDisabledTokenPairs: k.GetAutoDisabledTokenPairs(ctx),
+		UnrefundedDeposits:     k.GetUnrefundedDeposits(ctx),
 	}
 }
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// EndBlocker of erc20 module
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ActivatePendingRegistrations(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
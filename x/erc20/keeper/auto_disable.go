@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// autoDisableTokenPair disables the conversions of a native ERC20 token pair
+// whose escrow transfer moved a different amount of tokens than requested and
+// records the mismatch. Governance can re-enable the token pair with a
+// MsgToggleTokenConversion.
+func (k Keeper) autoDisableTokenPair(ctx sdk.Context, pair types.TokenPair, expected, actual sdkmath.Int) {
+	pair.Enabled = false
+	k.SetTokenPair(ctx, pair)
+
+	k.SetAutoDisabledTokenPair(ctx, types.NewAutoDisabledTokenPair(pair, expected, actual, ctx.BlockHeight()))
+
+	k.Logger(ctx).Error(
+		"disabled token pair after escrow balance mismatch",
+		"contract", pair.Erc20Address,
+		"expected", expected.String(),
+		"actual", actual.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoDisableTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyExpectedAmount, expected.String()),
+			sdk.NewAttribute(types.AttributeKeyActualAmount, actual.String()),
+		),
+	)
+}
+
+// GetAutoDisabledTokenPairs returns all the auto-disabled token pair records
+func (k Keeper) GetAutoDisabledTokenPairs(ctx sdk.Context) []types.AutoDisabledTokenPair {
+	records := []types.AutoDisabledTokenPair{}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixAutoDisabledTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.AutoDisabledTokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAutoDisabledTokenPair returns the auto-disabled record of an ERC20 token
+func (k Keeper) GetAutoDisabledTokenPair(ctx sdk.Context, contract common.Address) (types.AutoDisabledTokenPair, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoDisabledTokenPair)
+	bz := prefixStore.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.AutoDisabledTokenPair{}, false
+	}
+
+	var record types.AutoDisabledTokenPair
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetAutoDisabledTokenPair stores an auto-disabled token pair record
+func (k Keeper) SetAutoDisabledTokenPair(ctx sdk.Context, record types.AutoDisabledTokenPair) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoDisabledTokenPair)
+	bz := k.cdc.MustMarshal(&record)
+	prefixStore.Set(record.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteAutoDisabledTokenPair removes the auto-disabled record of an ERC20
+// token
+func (k Keeper) DeleteAutoDisabledTokenPair(ctx sdk.Context, contract common.Address) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoDisabledTokenPair)
+	prefixStore.Delete(contract.Bytes())
+}
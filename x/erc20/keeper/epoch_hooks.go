@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+var (
+	_ epochstypes.EpochHooks    = Hooks{}
+	_ epochstypes.EpochReferrer = Hooks{}
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd resets the conversion rate limits tracked on the ended epoch
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
+	h.k.ResetRateLimits(ctx, epochIdentifier)
+	return nil
+}
+
+// AfterEpochsSkipped resets the conversion rate limits tracked on the epoch
+// whose missed epochs were skipped, as a new epoch starts
+func (h Hooks) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, _, _ int64) error {
+	h.k.ResetRateLimits(ctx, epochIdentifier)
+	return nil
+}
+
+// GetModuleName returns the erc20 module name
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// IsEpochReferenced returns true if a conversion rate limit is tracked on the
+// given epoch
+func (h Hooks) IsEpochReferenced(ctx sdk.Context, epochIdentifier string) bool {
+	referenced := false
+	h.k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		referenced = rateLimit.EpochIdentifier == epochIdentifier
+		return referenced
+	})
+	return referenced
+}
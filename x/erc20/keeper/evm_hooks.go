@@ -36,6 +36,10 @@ func (k Keeper) Hooks() Hooks {
 //   - coin -> burn tokens and transfer escrowed coins on module to sender
 //   - token -> escrow tokens on module account and mint & transfer coins to sender
 //
+// As with MsgConvertERC20, only the tokens actually escrowed are minted and a
+// token pair whose escrow doesn't cover the transferred amount is disabled.
+// Tokens minted to the module address are not converted.
+//
 // Note that the PostTxProcessing hook is only called by sending an EVM
 // transaction that triggers `ApplyTransaction`. A cosmos tx with a
 // `ConvertERC20` msg does not trigger the hook as it only calls `ApplyMessage`.
@@ -106,6 +110,13 @@ func (h Hooks) PostTxProcessing(
 			continue
 		}
 
+		// Skip tokens minted to the module address, as there's no sender to
+		// convert them for. Only need last 20 bytes from log.topics
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		if from == (common.Address{}) {
+			continue
+		}
+
 		// Check that conversion for the pair is enabled. Fail
 		if !pair.Enabled {
 			// continue to allow transfers for the ERC20 in case the token pair is
@@ -126,7 +137,17 @@ func (h Hooks) PostTxProcessing(
 		case types.OWNER_MODULE:
 			_, err = h.k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, true, "burn", tokens)
 		case types.OWNER_EXTERNAL:
-			err = h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			// Disable the token pair if the escrow doesn't cover the transferred
+			// amount and only mint the escrowed tokens
+			escrowed := h.k.unbackedEscrow(ctx, pair)
+			if escrowed.LT(coins[0].Amount) {
+				h.k.autoDisableTokenPair(ctx, pair, coins[0].Amount, escrowed)
+				coins[0].Amount = escrowed
+			}
+
+			if coins[0].Amount.IsPositive() {
+				err = h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			}
 		default:
 			err = types.ErrUndefinedOwner
 		}
@@ -139,8 +160,11 @@ func (h Hooks) PostTxProcessing(
 			continue
 		}
 
-		// Only need last 20 bytes from log.topics
-		from := common.BytesToAddress(log.Topics[1].Bytes())
+		// nothing was escrowed, so there are no coins to send
+		if !coins[0].Amount.IsPositive() {
+			continue
+		}
+
 		recipient := sdk.AccAddress(from.Bytes())
 
 		// transfer the tokens from ModuleAccount to sender address
@@ -152,7 +176,23 @@ func (h Hooks) PostTxProcessing(
 			)
 			continue
 		}
+
+		// Fail the tx if the conversion exceeds the rate limit of the pair so
+		// that the whole conversion, including the token transfer to the module
+		// address, is reverted
+		if err := h.k.consumeERC20ToCoinQuota(ctx, pair, coins.AmountOf(pair.Denom)); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// unbackedEscrow returns the ERC20 tokens escrowed on the module address for a
+// native ERC20 token pair that don't back any minted coin yet. The hook runs
+// after the transfer has been executed, so the escrow increase is measured
+// against the coin supply instead of the escrow balance before the transfer.
+func (k Keeper) unbackedEscrow(ctx sdk.Context, pair types.TokenPair) sdkmath.Int {
+	drift := k.GetTokenPairDrift(ctx, pair)
+	return sdkmath.MaxInt(drift.Drift, sdkmath.ZeroInt())
+}
@@ -12,6 +12,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 	"github.com/evmos/ethermint/tests"
 )
@@ -66,6 +67,14 @@ func (suite *KeeperTestSuite) ensureHooksSet() {
 	suite.app.EvmKeeper.SetHooks(suite.app.Erc20Keeper.Hooks())
 }
 
+// escrowERC20Token mints tokens to the module address without triggering the
+// EVM hooks, as if the transfer of a receipt had already been executed
+func (suite *KeeperTestSuite) escrowERC20Token(ctx sdk.Context, contractAddr common.Address, amount *big.Int) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	_, err := suite.app.Erc20Keeper.CallEVM(ctx, erc20, suite.address, contractAddr, true, "mint", types.ModuleAddress, amount)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 	testCases := []struct {
 		name     string
@@ -382,6 +391,7 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 
 				pair, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
 				suite.Require().NoError(err)
+				suite.escrowERC20Token(suite.ctx, contractAddr, big.NewInt(10))
 
 				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
 				log := ethtypes.Log{
@@ -471,3 +481,116 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksEscrowMismatch() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	coinName := types.CreateDenom(contractAddr.String())
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// the transfer event reports 10 tokens but only 4 reach the module address
+	account := tests.GenerateAddress()
+	transferData := make([]byte, 32)
+	transferData[31] = uint8(10)
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"]
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{
+			Address: contractAddr,
+			Topics:  []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()},
+			Data:    transferData,
+		}},
+	}
+	suite.escrowERC20Token(suite.ctx, contractAddr, big.NewInt(4))
+
+	maxERC20ToCoin := sdkmath.NewInt(100)
+	suite.app.Erc20Keeper.SetRateLimit(
+		suite.ctx,
+		types.NewRateLimit(coinName, epochstypes.DayEpochID, &maxERC20ToCoin, nil),
+	)
+
+	err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+
+	// only the escrowed tokens are minted and charged to the rate limit
+	suite.Require().Equal(sdkmath.NewInt(4), suite.app.BankKeeper.GetBalance(suite.ctx, account.Bytes(), coinName).Amount)
+	rateLimit, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, coinName)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(4), rateLimit.Erc20ToCoinConverted)
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().False(pair.Enabled)
+
+	record, found := suite.app.Erc20Keeper.GetAutoDisabledTokenPair(suite.ctx, contractAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewAutoDisabledTokenPair(pair, sdkmath.NewInt(10), sdkmath.NewInt(4), suite.ctx.BlockHeight()), record)
+
+	// the escrow still backs the coin supply
+	drift := suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, pair)
+	suite.Require().True(drift.Drift.IsZero())
+
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksSkipMint() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	coinName := types.CreateDenom(contractAddr.String())
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// tokens minted to the module address have no sender to convert them for
+	transferData := make([]byte, 32)
+	transferData[31] = uint8(10)
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"]
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{
+			Address: contractAddr,
+			Topics:  []common.Hash{transferEvent.ID, common.Address{}.Hash(), types.ModuleAddress.Hash()},
+			Data:    transferData,
+		}},
+	}
+	suite.escrowERC20Token(suite.ctx, contractAddr, big.NewInt(10))
+
+	err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, coinName).Amount.IsZero())
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().True(pair.Enabled)
+
+	suite.mintFeeCollector = false
+}
@@ -6,12 +6,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 )
 
@@ -84,3 +87,252 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// RateLimits returns the conversion rate limits of all token pairs
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixRateLimit)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit returns the conversion rate limit of a token pair together with
+// the quota left in the current epoch
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit for token '%s'", req.Token)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit:            rateLimit,
+		RemainingErc20ToCoin: rateLimit.RemainingERC20ToCoin(),
+		RemainingCoinToErc20: rateLimit.RemainingCoinToERC20(),
+	}, nil
+}
+
+// PendingRegistrations returns the ERC20 token pairs registered without a
+// governance proposal that are waiting for activation
+func (k Keeper) PendingRegistrations(c context.Context, req *types.QueryPendingRegistrationsRequest) (*types.QueryPendingRegistrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pendings []types.PendingRegistration
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistration)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var pending types.PendingRegistration
+		if err := k.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		pendings = append(pendings, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingRegistrationsResponse{
+		PendingRegistrations: pendings,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// AutoDisabledTokenPairs returns the native ERC20 token pairs disabled after a
+// conversion escrowed a different amount of tokens than requested
+func (k Keeper) AutoDisabledTokenPairs(c context.Context, req *types.QueryAutoDisabledTokenPairsRequest) (*types.QueryAutoDisabledTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.AutoDisabledTokenPair
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixAutoDisabledTokenPair)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var record types.AutoDisabledTokenPair
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAutoDisabledTokenPairsResponse{
+		AutoDisabledTokenPairs: records,
+		Pagination:             pageRes,
+	}, nil
+}
+
+// UnrefundedDeposits returns the activated registrations whose deposit could
+// not be refunded to the depositor
+func (k Keeper) UnrefundedDeposits(c context.Context, req *types.QueryUnrefundedDepositsRequest) (*types.QueryUnrefundedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var deposits []types.PendingRegistration
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnrefundedDeposit)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var deposit types.PendingRegistration
+		if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryUnrefundedDepositsResponse{
+		UnrefundedDeposits: deposits,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// TokenPairDrifts returns the difference between the ERC20 and the Cosmos coin
+// representations of the registered token pairs
+func (k Keeper) TokenPairDrifts(c context.Context, req *types.QueryTokenPairDriftsRequest) (*types.QueryTokenPairDriftsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxEVMQueryPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination limit %d exceeds the maximum of %d", req.Pagination.Limit, types.MaxEVMQueryPageLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var drifts []types.TokenPairDrift
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		drifts = append(drifts, k.GetTokenPairDrift(ctx, pair))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenPairDriftsResponse{
+		Drifts:     drifts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Balances returns the coin and ERC20 balances of an account for every
+// registered token pair
+func (k Keeper) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the address is a hex address, if not, check if it is a valid
+	// bech32 address
+	var account common.Address
+	if err := ethermint.ValidateAddress(req.Address); err == nil {
+		account = common.HexToAddress(req.Address)
+	} else {
+		accAddr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+		account = common.BytesToAddress(accAddr)
+	}
+
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxEVMQueryPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination limit %d exceeds the maximum of %d", req.Pagination.Limit, types.MaxEVMQueryPageLimit)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var balances []types.TokenPairBalance
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+
+		coinBalance := k.bankKeeper.GetBalance(ctx, account.Bytes(), pair.Denom).Amount
+		erc20Balance := sdkmath.ZeroInt()
+		if balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), account); balance != nil {
+			erc20Balance = sdkmath.NewIntFromBigInt(balance)
+		}
+
+		balances = append(balances, types.TokenPairBalance{
+			Erc20Address: pair.Erc20Address,
+			Denom:        pair.Denom,
+			CoinBalance:  coinBalance,
+			Erc20Balance: erc20Balance,
+			Total:        coinBalance.Add(erc20Balance),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
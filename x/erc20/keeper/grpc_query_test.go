@@ -2,7 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/evmos/ethermint/tests"
 
@@ -164,3 +167,72 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestBalances() {
+	testCases := []struct {
+		name    string
+		address func() string
+		expPass bool
+	}{
+		{
+			"invalid address",
+			func() string { return "invalid" },
+			false,
+		},
+		{
+			"hex address",
+			func() string { return suite.address.Hex() },
+			true,
+		},
+		{
+			"bech32 address",
+			func() string { return sdk.AccAddress(suite.address.Bytes()).String() },
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			_, err := suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(40), sender, contractAddr, suite.address))
+			suite.Require().NoError(err)
+
+			req := &types.QueryBalancesRequest{
+				Address:    tc.address(),
+				Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+			}
+			res, err := suite.app.Erc20Keeper.Balances(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&query.PageResponse{Total: 1}, res.Pagination)
+				suite.Require().Equal([]types.TokenPairBalance{
+					{
+						Erc20Address: contractAddr.String(),
+						Denom:        types.CreateDenom(contractAddr.String()),
+						CoinBalance:  sdkmath.NewInt(40),
+						Erc20Balance: sdkmath.NewInt(60),
+						Total:        sdkmath.NewInt(100),
+					},
+				}, res.Balances)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			suite.mintFeeCollector = false
+		})
+	}
+
+	_, err := suite.app.Erc20Keeper.Balances(suite.ctx, &types.QueryBalancesRequest{
+		Address:    suite.address.Hex(),
+		Pagination: &query.PageRequest{Limit: types.MaxEVMQueryPageLimit + 1},
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "native-erc20-escrow", NativeERC20EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-coin-escrow", NativeCoinEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow-equality", EscrowEqualityInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NativeERC20EscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = NativeCoinEscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return EscrowEqualityInvariant(k)(ctx)
+	}
+}
+
+// NativeERC20EscrowInvariant checks that the ERC20 tokens escrowed on the
+// module address cover the bank supply of the coins of every token pair owned
+// by an external contract.
+//
+// Tokens sent directly to the module address increase the escrow without
+// minting coins, so the invariant only breaks when the escrow falls below the
+// coin supply.
+func NativeERC20EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.IsNativeERC20() {
+				continue
+			}
+
+			drift := k.GetTokenPairDrift(ctx, pair)
+			if drift.Drift.IsNegative() {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s escrows %s tokens but the supply of %s is %s\n",
+					pair.Erc20Address, drift.Erc20Amount, pair.Denom, drift.CoinAmount,
+				)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "native-erc20-escrow",
+			fmt.Sprintf("amount of under-collateralized native ERC20 token pairs %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// NativeCoinEscrowInvariant checks that the coins escrowed on the module
+// account cover the total supply of the ERC20 contract of every token pair
+// owned by the module.
+//
+// Coins sent directly to the module account increase the escrow without
+// minting tokens, so the invariant only breaks when the escrow falls below the
+// token supply.
+func NativeCoinEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.IsNativeCoin() {
+				continue
+			}
+
+			drift := k.GetTokenPairDrift(ctx, pair)
+			if drift.Drift.IsPositive() {
+				count++
+				msg += fmt.Sprintf(
+					"\tmodule account escrows %s%s but the supply of %s is %s\n",
+					drift.CoinAmount, pair.Denom, pair.Erc20Address, drift.Erc20Amount,
+				)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "native-coin-escrow",
+			fmt.Sprintf("amount of under-collateralized native coin token pairs %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// EscrowEqualityInvariant checks that the escrow of every token pair matches
+// the supply of its other representation exactly.
+//
+// Unlike the collateralization invariants, tokens or coins sent directly to
+// the module address without a conversion break this invariant too.
+func EscrowEqualityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			drift := k.GetTokenPairDrift(ctx, pair)
+			if !drift.Drift.IsZero() {
+				count++
+				msg += fmt.Sprintf(
+					"\t%s and %s differ by %s: ERC20 amount %s, coin amount %s\n",
+					pair.Erc20Address, pair.Denom, drift.Drift, drift.Erc20Amount, drift.CoinAmount,
+				)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow-equality",
+			fmt.Sprintf("amount of token pairs with an escrow drift %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// GetTokenPairDrift returns the amounts backing both representations of a
+// token pair and their difference. A contract that cannot be queried is
+// accounted with a zero ERC20 amount.
+func (k Keeper) GetTokenPairDrift(ctx sdk.Context, pair types.TokenPair) types.TokenPairDrift {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	var (
+		erc20Amount *big.Int
+		coinAmount  sdkmath.Int
+	)
+
+	if pair.IsNativeCoin() {
+		erc20Amount = k.TotalSupply(ctx, erc20, contract)
+		coinAmount = k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), pair.Denom).Amount
+	} else {
+		erc20Amount = k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+		coinAmount = k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+	}
+
+	if erc20Amount == nil {
+		erc20Amount = big.NewInt(0)
+	}
+
+	return types.TokenPairDrift{
+		Erc20Address:  pair.Erc20Address,
+		Denom:         pair.Denom,
+		ContractOwner: pair.ContractOwner,
+		Erc20Amount:   sdkmath.NewIntFromBigInt(erc20Amount),
+		CoinAmount:    coinAmount,
+		Drift:         sdkmath.NewIntFromBigInt(erc20Amount).Sub(coinAmount),
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/keeper"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestNativeERC20EscrowInvariant() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Commit()
+
+	coinName := types.CreateDenom(contractAddr.String())
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	_, err := suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(40), sender, contractAddr, suite.address))
+	suite.Require().NoError(err)
+
+	_, broken := keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.EscrowEqualityInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, coinName))
+	suite.Require().True(found)
+	drift := suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, pair)
+	suite.Require().Equal(sdkmath.NewInt(40), drift.Erc20Amount)
+	suite.Require().Equal(sdkmath.NewInt(40), drift.CoinAmount)
+	suite.Require().True(drift.Drift.IsZero())
+
+	// coins minted without escrowing tokens break the invariant
+	coins := sdk.NewCoins(sdk.NewInt64Coin(coinName, 10))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+
+	_, broken = keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().True(broken)
+
+	res, err := suite.app.Erc20Keeper.TokenPairDrifts(suite.ctx, &types.QueryTokenPairDriftsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Drifts, 1)
+	suite.Require().Equal(sdkmath.NewInt(-10), res.Drifts[0].Drift)
+
+	_, err = suite.app.Erc20Keeper.TokenPairDrifts(suite.ctx, &types.QueryTokenPairDriftsRequest{
+		Pagination: &query.PageRequest{Limit: types.MaxEVMQueryPageLimit + 1},
+	})
+	suite.Require().Error(err)
+
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestNativeCoinEscrowInvariant() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	_, pair := suite.setupRegisterCoin()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	_, err := suite.app.Erc20Keeper.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 30), suite.address, sender))
+	suite.Require().NoError(err)
+
+	_, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.EscrowEqualityInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	drift := suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, *pair)
+	suite.Require().Equal(sdkmath.NewInt(30), drift.Erc20Amount)
+	suite.Require().Equal(sdkmath.NewInt(30), drift.CoinAmount)
+
+	// coins donated to the module account don't break the invariant
+	donation := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 5))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, donation))
+
+	_, broken = keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.EscrowEqualityInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal(sdkmath.NewInt(-5), suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, *pair).Drift)
+
+	// tokens no longer backed by escrowed coins break the invariant
+	burn := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 10))
+	suite.Require().NoError(suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, burn))
+
+	_, broken = keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal(sdkmath.NewInt(5), suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, *pair).Drift)
+
+	// the ERC20 escrow invariant ignores native coin pairs
+	_, broken = keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	suite.mintFeeCollector = false
+}
@@ -21,6 +21,7 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	evmKeeper     types.EVMKeeper
+	epochsKeeper  types.EpochsKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -35,6 +36,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	evmKeeper types.EVMKeeper,
+	epochsKeeper types.EpochsKeeper,
 	authority string,
 
 ) Keeper {
@@ -50,6 +52,7 @@ func NewKeeper(
 		accountKeeper: ak,
 		bankKeeper:    bk,
 		evmKeeper:     evmKeeper,
+		epochsKeeper:  epochsKeeper,
 		authority:     authority,
 	}
 }
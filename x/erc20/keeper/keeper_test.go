@@ -601,3 +601,8 @@ func (b *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, de
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Get(0).(sdk.Coin)
 }
+
+func (b *MockBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	v2 "github.com/TucanaProtocol/Tucana/v8/x/erc20/migrations/v2"
+	v3 "github.com/TucanaProtocol/Tucana/v8/x/erc20/migrations/v3"
 )
 
 var _ module.MigrationHandler = Migrator{}.Migrate1to2
@@ -25,3 +26,9 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.UpdateParams(ctx, &m.keeper.paramstore)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3. The registration
+// deposit is denominated in the EVM denom.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.UpdateParams(ctx, &m.keeper.paramstore, m.keeper.evmKeeper.GetParams(ctx).EvmDenom)
+}
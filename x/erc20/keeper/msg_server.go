@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -69,6 +70,10 @@ func (k Keeper) ConvertCoin(
 		return nil, nil
 	}
 
+	if err := k.consumeCoinToERC20Quota(ctx, pair, msg.Coin.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
@@ -131,6 +136,9 @@ func (k Keeper) ConvertERC20(
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
+		if err := k.consumeERC20ToCoinQuota(ctx, pair, msg.Amount); err != nil {
+			return nil, err
+		}
 		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
 		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
@@ -139,6 +147,91 @@ func (k Keeper) ConvertERC20(
 	}
 }
 
+// ConvertCoins converts a batch of native Cosmos coins into ERC20 tokens. Every
+// coin is converted as a MsgConvertCoin and the batch fails if any conversion
+// fails.
+func (k Keeper) ConvertCoins(
+	goCtx context.Context,
+	msg *types.MsgConvertCoins,
+) (*types.MsgConvertCoinsResponse, error) {
+	if msg.Coins.Empty() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "no coins to convert")
+	}
+
+	if err := msg.Coins.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	conversions := make([]types.Conversion, 0, len(msg.Coins))
+	for _, coin := range msg.Coins {
+		res, err := k.ConvertCoin(goCtx, &types.MsgConvertCoin{
+			Coin:     coin,
+			Receiver: msg.Receiver,
+			Sender:   msg.Sender,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s", coin)
+		}
+
+		// the token pair is deleted when its contract is selfdestructed
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+		if res == nil || !found {
+			return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "failed to convert %s", coin)
+		}
+
+		conversions = append(conversions, types.Conversion{
+			ContractAddress: pair.Erc20Address,
+			Coin:            coin,
+		})
+	}
+
+	return &types.MsgConvertCoinsResponse{Conversions: conversions}, nil
+}
+
+// ConvertERC20S converts a batch of ERC20 tokens into native Cosmos coins.
+// Every token is converted as a MsgConvertERC20 and the batch fails if any
+// conversion fails.
+func (k Keeper) ConvertERC20S(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20S,
+) (*types.MsgConvertERC20SResponse, error) {
+	if len(msg.Tokens) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "no tokens to convert")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	conversions := make([]types.Conversion, 0, len(msg.Tokens))
+	for _, token := range msg.Tokens {
+		res, err := k.ConvertERC20(goCtx, &types.MsgConvertERC20{
+			ContractAddress: token.ContractAddress,
+			Amount:          token.Amount,
+			Receiver:        msg.Receiver,
+			Sender:          msg.Sender,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s %s", token.Amount, token.ContractAddress)
+		}
+
+		// the token pair is deleted when its contract is selfdestructed
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token.ContractAddress))
+		if res == nil || !found {
+			return nil, errorsmod.Wrapf(
+				types.ErrTokenPairNotFound, "failed to convert %s %s", token.Amount, token.ContractAddress,
+			)
+		}
+
+		conversions = append(conversions, types.Conversion{
+			ContractAddress: pair.Erc20Address,
+			Coin:            sdk.Coin{Denom: pair.Denom, Amount: token.Amount},
+		})
+	}
+
+	return &types.MsgConvertERC20SResponse{Conversions: conversions}, nil
+}
+
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
 // token pair:
 //   - escrow coins on module account
@@ -328,11 +421,16 @@ func (k Keeper) convertERC20NativeCoin(
 // convertERC20NativeToken handles the erc20 conversion for a native erc20 token
 // pair:
 //   - escrow tokens on module account
-//   - mint coins on bank module
+//   - mint coins on bank module for the escrowed amount
+//   - charge the rate limit with the minted amount
 //   - send minted coins to the receiver
-//   - check if coin balance increased by amount
-//   - check if token balance decreased by amount
+//   - check if coin balance increased by the minted amount
+//   - disable the token pair if the escrow did not increase by amount
 //   - check for unexpected `Approval` event in logs
+//
+// Fee-on-transfer and rebasing tokens escrow a different amount than the one
+// transferred. Only the measured escrow increase, capped at the requested
+// amount, is minted so that every coin stays backed by escrowed tokens.
 func (k Keeper) convertERC20NativeToken(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -371,31 +469,36 @@ func (k Keeper) convertERC20NativeToken(
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to execute transfer")
 	}
 
-	// Check expected escrow balance after transfer execution
-	tokens := coins[0].Amount.BigInt()
+	// Measure the escrowed amount after transfer execution
 	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
 	if balanceTokenAfter == nil {
 		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
 
-	expToken := big.NewInt(0).Add(balanceToken, tokens)
+	escrowed := sdkmath.NewIntFromBigInt(big.NewInt(0).Sub(balanceTokenAfter, balanceToken))
 
-	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
-		return nil, errorsmod.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v",
-			expToken, balanceTokenAfter,
-		)
+	// Disable the token pair if the escrow doesn't match the requested amount
+	// and only mint the escrowed tokens
+	if !escrowed.Equal(msg.Amount) {
+		k.autoDisableTokenPair(ctx, pair, msg.Amount, escrowed)
+		coins[0].Amount = sdkmath.MinInt(sdkmath.MaxInt(escrowed, sdkmath.ZeroInt()), msg.Amount)
 	}
 
-	// Mint coins
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+	// Charge the rate limit with the minted amount rather than the requested one
+	if err := k.consumeERC20ToCoinQuota(ctx, pair, coins[0].Amount); err != nil {
 		return nil, err
 	}
 
-	// Send minted coins to the receiver
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
-		return nil, err
+	if coins[0].Amount.IsPositive() {
+		// Mint coins
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+
+		// Send minted coins to the receiver
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+			return nil, err
+		}
 	}
 
 	// Check expected receiver balance after transfer
@@ -443,7 +546,7 @@ func (k Keeper) convertERC20NativeToken(
 				types.EventTypeConvertERC20,
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins[0].Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
 			),
@@ -625,3 +728,192 @@ func (k Keeper) ToggleTokenConversionProposal(goCtx context.Context, req *types.
 
 	return &types.MsgToggleTokenConversionResponse{}, nil
 }
+
+// SetRateLimitProposal implements the gRPC MsgServer interface. When the proposal
+// passes, it sets the conversion rate limit of a token pair. The amounts
+// converted in the current epoch are kept if the token pair was already
+// limited.
+func (k Keeper) SetRateLimitProposal(goCtx context.Context, req *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", req.Token)
+	}
+
+	// the converted amounts are only reset at the end of an existing epoch
+	if _, found := k.epochsKeeper.GetEpochInfo(ctx, req.EpochIdentifier); !found {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "epoch '%s' not found", req.EpochIdentifier)
+	}
+
+	rateLimit := types.NewRateLimit(pair.Denom, req.EpochIdentifier, req.MaxErc20ToCoin, req.MaxCoinToErc20)
+	if existing, found := k.GetRateLimit(ctx, pair.Denom); found {
+		rateLimit.Erc20ToCoinConverted = existing.Erc20ToCoinConverted
+		rateLimit.CoinToErc20Converted = existing.CoinToErc20Converted
+	}
+
+	if err := rateLimit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEpochID, rateLimit.EpochIdentifier),
+		),
+	)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimitProposal implements the gRPC MsgServer interface. When the proposal
+// passes, it removes the conversion rate limit of a token pair.
+func (k Keeper) RemoveRateLimitProposal(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", req.Token)
+	}
+
+	if _, found := k.GetRateLimit(ctx, pair.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "token '%s'", req.Token)
+	}
+
+	k.DeleteRateLimit(ctx, pair.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// RecoverDepositProposal implements the gRPC MsgServer interface. When the
+// proposal passes, it sends a registration deposit that could not be refunded
+// at activation to the recipient.
+func (k Keeper) RecoverDepositProposal(goCtx context.Context, req *types.MsgRecoverDeposit) (*types.MsgRecoverDepositResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if !common.IsHexAddress(req.Erc20Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 contract address %s", req.Erc20Address)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract := common.HexToAddress(req.Erc20Address)
+	deposit, found := k.GetUnrefundedDeposit(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnrefundedDeposit, "token '%s'", req.Erc20Address)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, deposit.Deposit); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to send registration deposit")
+	}
+
+	k.DeleteUnrefundedDeposit(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverDeposit,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyReceiver, req.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Deposit.String()),
+		),
+	)
+
+	return &types.MsgRecoverDepositResponse{}, nil
+}
+
+// RegisterERC20Permissionless implements the gRPC MsgServer interface. It
+// escrows the registration deposit of the sender and registers a token pair
+// for the ERC20 token that is activated at the end of the challenge period.
+func (k Keeper) RegisterERC20Permissionless(goCtx context.Context, msg *types.MsgRegisterERC20Permissionless) (*types.MsgRegisterERC20PermissionlessResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if !common.IsHexAddress(msg.Erc20Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 contract address %s", msg.Erc20Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.RegisterPendingERC20(ctx, sender, common.HexToAddress(msg.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterERC20PermissionlessResponse{TokenPair: *pair}, nil
+}
+
+// MigrateTokenPairProposal implements the gRPC MsgServer interface. When a
+// MigrateTokenPair proposal passes, it points the token pair denom at the new
+// ERC20 contract.
+func (k Keeper) MigrateTokenPairProposal(goCtx context.Context, req *types.MsgMigrateTokenPair) (*types.MsgMigrateTokenPairResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if !common.IsHexAddress(req.NewErc20Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new ERC20 contract hex address '%s'", req.NewErc20Address)
+	}
+
+	if !common.IsHexAddress(req.EscrowRecipient) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid escrow recipient hex address '%s'", req.EscrowRecipient)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := k.MigrateTokenPair(
+		ctx, req.Token, common.HexToAddress(req.NewErc20Address), common.HexToAddress(req.EscrowRecipient),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateTokenPairResponse{TokenPair: pair}, nil
+}
+
+// RefreshTokenPairMetadataProposal implements the gRPC MsgServer interface.
+// When a RefreshTokenPairMetadata proposal passes, it resyncs the bank metadata
+// of the token pair with its ERC20 contract.
+func (k Keeper) RefreshTokenPairMetadataProposal(goCtx context.Context, req *types.MsgRefreshTokenPairMetadata) (*types.MsgRefreshTokenPairMetadataResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata, err := k.RefreshTokenPairMetadata(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefreshTokenPairMetadataResponse{Metadata: metadata}, nil
+}
@@ -20,6 +20,7 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
 	"github.com/TucanaProtocol/Tucana/v8/testutil"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/keeper"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
@@ -282,7 +283,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -298,7 +299,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -320,7 +321,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -412,7 +413,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -429,7 +430,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -452,7 +453,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -475,7 +476,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -489,7 +490,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -636,16 +637,6 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 			false,
 			false,
 		},
-		{
-			"fail - direct balance manipulation contract",
-			100,
-			10,
-			func(common.Address) {},
-			func() {},
-			contractDirectBalanceManipulation,
-			false,
-			false,
-		},
 		{
 			"fail - delayed malicious contract",
 			10,
@@ -689,7 +680,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -711,7 +702,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -734,7 +725,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -757,7 +748,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -779,7 +770,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to mint"))
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
@@ -799,7 +790,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
@@ -819,7 +810,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -967,7 +958,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -988,7 +979,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1009,7 +1000,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1031,7 +1022,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1226,7 +1217,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1242,7 +1233,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1264,7 +1255,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1356,7 +1347,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1373,7 +1364,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1396,7 +1387,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1419,7 +1410,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -1433,7 +1424,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockBankKeeper := &MockBankKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -1670,7 +1661,7 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 			"fail - MsgUpdateParams - authority check",
 			&types.MsgUpdateParams{
 				Authority: "canto1yrmjye0zyfvr0lthc6fwq7qlwg9e8muftxa630",
-				Params:    types.NewParams(false, false),
+				Params:    types.NewParams(false, false, false, types.DefaultRegistrationDeposit(sdk.DefaultBondDenom), types.DefaultRegistrationChallengePeriod),
 			},
 			func() {},
 			func(proposalId uint64) {},
@@ -1680,11 +1671,11 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 			"ok - MsgUpdateParams",
 			&types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				Params:    types.NewParams(false, false),
+				Params:    types.NewParams(false, false, false, types.DefaultRegistrationDeposit(sdk.DefaultBondDenom), types.DefaultRegistrationChallengePeriod),
 			},
 			func() {},
 			func(proposalId uint64) {
-				changeParams := types.NewParams(false, false)
+				changeParams := types.NewParams(false, false, false, types.DefaultRegistrationDeposit(sdk.DefaultBondDenom), types.DefaultRegistrationChallengePeriod)
 
 				proposal, err := suite.app.GovKeeper.Proposals.Get(suite.ctx, proposalId)
 				suite.Require().NoError(err)
@@ -1722,3 +1713,170 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) setupRegisterERC20Pairs() (common.Address, common.Address) {
+	contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+	contract2, err := suite.DeployContract("coin2", "token2", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract2)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+	suite.MintERC20Token(contract2, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+	return contract, contract2
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20s() {
+	testCases := []struct {
+		name    string
+		amount  int64
+		amount2 int64
+		expPass bool
+	}{
+		{"ok - sufficient funds", 10, 20, true},
+		{"fail - no tokens", 0, 0, false},
+		{"fail - insufficient funds on one token", 10, 200, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contract, contract2 := suite.setupRegisterERC20Pairs()
+			receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+			var tokens []types.ERC20Amount
+			if tc.amount != 0 {
+				tokens = []types.ERC20Amount{
+					types.NewERC20Amount(contract, sdkmath.NewInt(tc.amount)),
+					types.NewERC20Amount(contract2, sdkmath.NewInt(tc.amount2)),
+				}
+			}
+
+			cacheCtx, _ := suite.ctx.CacheContext()
+			res, err := suite.app.Erc20Keeper.ConvertERC20S(cacheCtx, types.NewMsgConvertERC20S(tokens, receiver, suite.address))
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				coin := sdk.NewInt64Coin(types.CreateDenom(contract.String()), tc.amount)
+				coin2 := sdk.NewInt64Coin(types.CreateDenom(contract2.String()), tc.amount2)
+				suite.Require().Equal([]types.Conversion{
+					{ContractAddress: contract.String(), Coin: coin},
+					{ContractAddress: contract2.String(), Coin: coin2},
+				}, res.Conversions)
+				suite.Require().Equal(sdk.NewCoins(coin, coin2), suite.app.BankKeeper.GetAllBalances(cacheCtx, receiver))
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertCoins() {
+	testCases := []struct {
+		name    string
+		amount  int64
+		amount2 int64
+		expPass bool
+	}{
+		{"ok - sufficient funds", 10, 20, true},
+		{"fail - no coins", 0, 0, false},
+		{"fail - insufficient funds on one coin", 10, 200, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contract, contract2 := suite.setupRegisterERC20Pairs()
+			sender := sdk.AccAddress(suite.address.Bytes())
+			receiver := tests.GenerateAddress()
+
+			// Precondition: Convert ERC20s to Coins
+			_, err := suite.app.Erc20Keeper.ConvertERC20S(suite.ctx, types.NewMsgConvertERC20S(
+				[]types.ERC20Amount{
+					types.NewERC20Amount(contract, sdkmath.NewInt(100)),
+					types.NewERC20Amount(contract2, sdkmath.NewInt(100)),
+				},
+				sender, suite.address,
+			))
+			suite.Require().NoError(err)
+
+			coin := sdk.NewInt64Coin(types.CreateDenom(contract.String()), tc.amount)
+			coin2 := sdk.NewInt64Coin(types.CreateDenom(contract2.String()), tc.amount2)
+			coins := sdk.NewCoins(coin, coin2)
+
+			cacheCtx, _ := suite.ctx.CacheContext()
+			res, err := suite.app.Erc20Keeper.ConvertCoins(cacheCtx, types.NewMsgConvertCoins(coins, receiver, sender))
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().ElementsMatch([]types.Conversion{
+					{ContractAddress: contract.String(), Coin: coin},
+					{ContractAddress: contract2.String(), Coin: coin2},
+				}, res.Conversions)
+
+				erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				suite.Require().Equal(big.NewInt(tc.amount), suite.app.Erc20Keeper.BalanceOf(cacheCtx, erc20, contract, receiver))
+				suite.Require().Equal(big.NewInt(tc.amount2), suite.app.Erc20Keeper.BalanceOf(cacheCtx, erc20, contract2, receiver))
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20AutoDisable() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	// the contract sends half of every transfer to a third party
+	contractAddr := suite.setupRegisterERC20Pair(contractDirectBalanceManipulation)
+	suite.Commit()
+
+	coinName := types.CreateDenom(contractAddr.String())
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	msg := types.NewMsgConvertERC20(sdkmath.NewInt(10), sender, contractAddr, suite.address)
+	_, err := suite.app.Erc20Keeper.ConvertERC20(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// only the escrowed tokens are minted
+	suite.Require().Equal(sdkmath.NewInt(5), suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName).Amount)
+	suite.Require().Equal(big.NewInt(5), suite.BalanceOf(contractAddr, types.ModuleAddress))
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().False(pair.Enabled)
+
+	record, found := suite.app.Erc20Keeper.GetAutoDisabledTokenPair(suite.ctx, contractAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewAutoDisabledTokenPair(pair, sdkmath.NewInt(10), sdkmath.NewInt(5), suite.ctx.BlockHeight()), record)
+
+	res, err := suite.app.Erc20Keeper.AutoDisabledTokenPairs(suite.ctx, &types.QueryAutoDisabledTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AutoDisabledTokenPair{record}, res.AutoDisabledTokenPairs)
+
+	// the escrow still backs the coin supply
+	drift := suite.app.Erc20Keeper.GetTokenPairDrift(suite.ctx, pair)
+	suite.Require().True(drift.Drift.IsZero())
+
+	// further conversions are rejected
+	_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	// re-enabling the token pair clears the record
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contractAddr.String())
+	suite.Require().NoError(err)
+	_, found = suite.app.Erc20Keeper.GetAutoDisabledTokenPair(suite.ctx, contractAddr)
+	suite.Require().False(found)
+
+	suite.mintFeeCollector = false
+}
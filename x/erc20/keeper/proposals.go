@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math/big"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -9,6 +10,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 )
 
@@ -136,32 +138,14 @@ func (k Keeper) CreateCoinMetadata(
 	// create a bank denom metadata based on the ERC20 token ABI details
 	// metadata name is should always be the contract since it's the key
 	// to the bank store
+	denomUnits, display := erc20DenomUnits(base, erc20Data)
 	metadata := banktypes.Metadata{
 		Description: types.CreateDenomDescription(strContract),
 		Base:        base,
-		// NOTE: Denom units MUST be increasing
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    base,
-				Exponent: 0,
-			},
-		},
-		Name:    types.CreateDenom(strContract),
-		Symbol:  erc20Data.Symbol,
-		Display: base,
-	}
-
-	// only append metadata if decimals > 0, otherwise validation fails
-	if erc20Data.Decimals > 0 {
-		nameSanitized := types.SanitizeERC20Name(erc20Data.Name)
-		metadata.DenomUnits = append(
-			metadata.DenomUnits,
-			&banktypes.DenomUnit{
-				Denom:    nameSanitized,
-				Exponent: uint32(erc20Data.Decimals),
-			},
-		)
-		metadata.Display = nameSanitized
+		DenomUnits:  denomUnits,
+		Name:        types.CreateDenom(strContract),
+		Symbol:      erc20Data.Symbol,
+		Display:     display,
 	}
 
 	if err := metadata.Validate(); err != nil {
@@ -194,12 +178,260 @@ func (k Keeper) ToggleConversion(
 		)
 	}
 
+	// Disabling a token pair during its challenge period rejects the
+	// permissionless registration
+	if pending, found := k.GetPendingRegistration(ctx, pair.GetERC20Contract()); found {
+		if err := k.rejectPendingRegistration(ctx, pair, pending); err != nil {
+			return types.TokenPair{}, err
+		}
+		return pair, nil
+	}
+
 	pair.Enabled = !pair.Enabled
 
+	// Re-enabling an auto-disabled token pair clears its record
+	if pair.Enabled {
+		k.DeleteAutoDisabledTokenPair(ctx, pair.GetERC20Contract())
+	}
+
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
 
+// MigrateTokenPair points the denom of a native ERC20 token pair at a new
+// ERC20 contract. The module address must already hold enough tokens of the
+// new contract to back the coin supply of the denom. The tokens escrowed on
+// the old contract are sent to the escrow recipient and the bank metadata is
+// updated with the data of the new contract.
+func (k Keeper) MigrateTokenPair(
+	ctx sdk.Context,
+	token string,
+	newContract, escrowRecipient common.Address,
+) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "token '%s' is not owned by an external contract", token,
+		)
+	}
+
+	oldContract := pair.GetERC20Contract()
+	if k.GetPendingRegistrationExists(ctx, oldContract) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "token '%s' registration is pending", token,
+		)
+	}
+
+	if k.IsERC20Registered(ctx, newContract) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", newContract,
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, newContract)
+	if acc == nil || !acc.IsContract() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "%s is not a contract", newContract,
+		)
+	}
+
+	// the new contract must keep the decimals of the denom metadata
+	erc20Data, err := k.QueryERC20(ctx, newContract)
+	if err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(types.ErrTokenPairMigration, err.Error())
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "denom metadata not found for %s", pair.Denom,
+		)
+	}
+
+	var decimals uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit.Exponent > decimals {
+			decimals = unit.Exponent
+		}
+	}
+
+	if uint32(erc20Data.Decimals) != decimals {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "decimals mismatch, expected %d, got %d", decimals, erc20Data.Decimals,
+		)
+	}
+
+	// the metadata describes the new contract while keeping the denom as base
+	metadata.Description = types.CreateDenomDescription(newContract.String())
+	metadata.DenomUnits, metadata.Display = erc20DenomUnits(metadata.Base, erc20Data)
+	metadata.Name = erc20Data.Name
+	metadata.Symbol = erc20Data.Symbol
+
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			err, "ERC20 token data is invalid for contract %s", newContract,
+		)
+	}
+
+	// the new escrow must back every coin in circulation
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	escrow := k.BalanceOf(ctx, erc20, newContract, types.ModuleAddress)
+	if escrow == nil || escrow.Cmp(supply.Amount.BigInt()) < 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "module escrow on %s does not cover the supply %s", newContract, supply,
+		)
+	}
+
+	// release the escrow of the old contract unless it was selfdestructed
+	oldEscrow := big.NewInt(0)
+	if acc := k.evmKeeper.GetAccountWithoutBalance(ctx, oldContract); acc != nil && acc.IsContract() {
+		if balance := k.BalanceOf(ctx, erc20, oldContract, types.ModuleAddress); balance != nil {
+			oldEscrow = balance
+		}
+	}
+
+	if oldEscrow.Sign() == 1 {
+		res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, oldContract, true, "transfer", escrowRecipient, oldEscrow)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+
+		var unpackedRet types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil || !unpackedRet.Value {
+			return types.TokenPair{}, errorsmod.Wrap(types.ErrTokenPairMigration, "failed to release the old escrow")
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	// the token pair id depends on the contract address so the pair and both
+	// indexes are replaced
+	k.DeleteTokenPair(ctx, pair)
+
+	migrated := types.NewTokenPair(newContract, pair.Denom, pair.Enabled, pair.ContractOwner)
+	k.SetTokenPair(ctx, migrated)
+	k.SetTokenPairIdByDenom(ctx, migrated.Denom, migrated.GetID())
+	k.SetTokenPairIdByERC20Addr(ctx, newContract, migrated.GetID())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyNewERC20Token, migrated.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyReceiver, escrowRecipient.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEscrowMoved, oldEscrow.String()),
+		),
+	)
+
+	return migrated, nil
+}
+
+// erc20DenomUnits returns the denom units and the display denom of the bank
+// metadata representing an ERC20 token
+func erc20DenomUnits(base string, erc20Data types.ERC20Data) ([]*banktypes.DenomUnit, string) {
+	// NOTE: Denom units MUST be increasing
+	denomUnits := []*banktypes.DenomUnit{
+		{
+			Denom:    base,
+			Exponent: 0,
+		},
+	}
+
+	// only append metadata if decimals > 0, otherwise validation fails
+	if erc20Data.Decimals == 0 {
+		return denomUnits, base
+	}
+
+	nameSanitized := types.SanitizeERC20Name(erc20Data.Name)
+	denomUnits = append(
+		denomUnits,
+		&banktypes.DenomUnit{
+			Denom:    nameSanitized,
+			Exponent: uint32(erc20Data.Decimals),
+		},
+	)
+	return denomUnits, nameSanitized
+}
+
+// RefreshTokenPairMetadata queries the ERC20 contract of a native ERC20 token
+// pair and updates the name, the symbol and the display unit of the bank
+// metadata. The metadata base remains the token pair denom. It fails if the
+// contract decimals differ from the registered ones.
+func (k Keeper) RefreshTokenPairMetadata(ctx sdk.Context, token string) (banktypes.Metadata, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return banktypes.Metadata{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeERC20() {
+		return banktypes.Metadata{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "token '%s' is not owned by an external contract", token,
+		)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return banktypes.Metadata{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "denom metadata not found for %s", pair.Denom,
+		)
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, pair.GetERC20Contract())
+	if err != nil {
+		return banktypes.Metadata{}, err
+	}
+
+	var decimals uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit.Exponent > decimals {
+			decimals = unit.Exponent
+		}
+	}
+
+	// changing the decimals would reprice every existing balance
+	if uint32(erc20Data.Decimals) != decimals {
+		return banktypes.Metadata{}, errorsmod.Wrapf(
+			types.ErrDecimalsChanged,
+			"decimals of %s changed from %d to %d", pair.Erc20Address, decimals, erc20Data.Decimals,
+		)
+	}
+
+	metadata.DenomUnits, metadata.Display = erc20DenomUnits(metadata.Base, erc20Data)
+	metadata.Name = erc20Data.Name
+	metadata.Symbol = erc20Data.Symbol
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrapf(
+			err, "ERC20 token data is invalid for contract %s", pair.Erc20Address,
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefreshTokenPairMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyName, metadata.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, metadata.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDisplay, metadata.Display),
+		),
+	)
+
+	return metadata, nil
+}
+
 // verifyMetadata verifies if the metadata matches the existing one, if not it
 // sets it to the store
 func (k Keeper) verifyMetadata(
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -15,6 +17,7 @@ import (
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/keeper"
 	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 	inflationtypes "github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
@@ -137,7 +140,7 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -256,7 +259,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 				mockEVMKeeper := &MockEVMKeeper{}
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
-				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+				suite.app.Erc20Keeper = keeper.NewKeeper(runtime.NewKVStoreService(suite.app.GetKey("erc20")), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.EpochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -385,3 +388,201 @@ func (suite KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrateTokenPair() {
+	var (
+		token       string
+		newContract common.Address
+	)
+
+	recipient := tests.GenerateAddress()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	newName, newSymbol := "Coin Token V2", "CTKN2"
+
+	testCases := []struct {
+		name     string
+		malleate func(oldContract common.Address)
+		expPass  bool
+	}{
+		{
+			"fail - token not registered",
+			func(_ common.Address) {
+				token = "coin"
+			},
+			false,
+		},
+		{
+			"fail - new contract already registered",
+			func(oldContract common.Address) {
+				newContract = oldContract
+			},
+			false,
+		},
+		{
+			"fail - new contract is not a contract",
+			func(_ common.Address) {
+				newContract = tests.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - decimals mismatch",
+			func(_ common.Address) {
+				var err error
+				newContract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals+1)
+				suite.Require().NoError(err)
+				suite.Commit()
+				suite.MintERC20Token(newContract, suite.address, types.ModuleAddress, big.NewInt(40))
+			},
+			false,
+		},
+		{
+			"fail - new escrow doesn't cover the supply",
+			func(_ common.Address) {
+				suite.MintERC20Token(newContract, suite.address, types.ModuleAddress, big.NewInt(39))
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ common.Address) {
+				var err error
+				newContract, err = suite.DeployContract(newName, newSymbol, erc20Decimals)
+				suite.Require().NoError(err)
+				suite.Commit()
+				suite.MintERC20Token(newContract, suite.address, types.ModuleAddress, big.NewInt(40))
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			oldContract := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.MintERC20Token(oldContract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			_, err := suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(40), sender, oldContract, suite.address))
+			suite.Require().NoError(err)
+
+			denom := types.CreateDenom(oldContract.String())
+			token = denom
+			newContract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			tc.malleate(oldContract)
+
+			pair, err := suite.app.Erc20Keeper.MigrateTokenPair(suite.ctx, token, newContract, recipient)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(types.NewTokenPair(newContract, denom, true, types.OWNER_EXTERNAL), pair)
+
+				// indexes point at the new contract
+				suite.Require().Equal(pair.GetID(), suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom))
+				suite.Require().Equal(pair.GetID(), suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, newContract.String()))
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, oldContract))
+				suite.Require().Len(suite.app.Erc20Keeper.GetTokenPairs(suite.ctx), 1)
+
+				// the metadata describes the new contract and keeps the denom
+				metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				suite.Require().True(found)
+				suite.Require().Equal(denom, metadata.Base)
+				suite.Require().Equal(types.CreateDenomDescription(newContract.String()), metadata.Description)
+				suite.Require().Equal(newName, metadata.Name)
+				suite.Require().Equal(newSymbol, metadata.Symbol)
+				suite.Require().Equal(types.SanitizeERC20Name(newName), metadata.Display)
+				suite.Require().Equal(types.SanitizeERC20Name(newName), metadata.DenomUnits[1].Denom)
+
+				// the old escrow is released to the recipient
+				suite.Require().Equal(big.NewInt(40), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, oldContract, recipient))
+				suite.Require().Equal(int64(0), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, oldContract, types.ModuleAddress).Int64())
+
+				// coins are now converted into the new token
+				_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(denom, 10), suite.address, sender))
+				suite.Require().NoError(err)
+				suite.Require().Equal(big.NewInt(10), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, newContract, suite.address))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, oldContract))
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRefreshTokenPairMetadata() {
+	var token string
+
+	testCases := []struct {
+		name     string
+		malleate func(denom string)
+		expErr   error
+	}{
+		{
+			"fail - token not registered",
+			func(_ string) {
+				token = "coin"
+			},
+			types.ErrTokenPairNotFound,
+		},
+		{
+			"fail - native coin token pair",
+			func(_ string) {
+				_, pair := suite.setupRegisterCoin()
+				token = pair.Denom
+			},
+			types.ErrInternalTokenPair,
+		},
+		{
+			"fail - decimals changed",
+			func(denom string) {
+				metadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				metadata.Symbol = "OLD"
+				metadata.DenomUnits[1].Exponent = 6
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+			},
+			types.ErrDecimalsChanged,
+		},
+		{
+			"ok - stale metadata",
+			func(denom string) {
+				metadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				metadata.Name = "old"
+				metadata.Symbol = "OLD"
+				metadata.DenomUnits[1].Denom = "old"
+				metadata.Display = "old"
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+			},
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+			denom := types.CreateDenom(contractAddr.String())
+			token = contractAddr.String()
+			expMetadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+			suite.Require().True(found)
+			expMetadata.Name = erc20Name
+
+			tc.malleate(denom)
+
+			metadata, err := suite.app.Erc20Keeper.RefreshTokenPairMetadata(suite.ctx, token)
+			if tc.expErr == nil {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(expMetadata, metadata)
+
+				stored, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				suite.Require().Equal(expMetadata, stored)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr, tc.name)
+			}
+		})
+	}
+}
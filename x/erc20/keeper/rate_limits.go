@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// GetRateLimits returns all the token pair rate limits
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the token pair rate limits
+func (k Keeper) IterateRateLimits(ctx sdk.Context, handlerFn func(rateLimit types.RateLimit) (stop bool)) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if handlerFn(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetRateLimit returns the rate limit of the token pair with the given denom
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom string) (types.RateLimit, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixRateLimit)
+	bz := prefixStore.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit of a token pair
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	prefixStore.Set([]byte(rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit of the token pair with the given denom
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixRateLimit)
+	prefixStore.Delete([]byte(denom))
+}
+
+// consumeERC20ToCoinQuota records an ERC20 -> Cosmos coin conversion against
+// the rate limit of the token pair, if any. It fails if the conversion exceeds
+// the quota left in the current epoch.
+func (k Keeper) consumeERC20ToCoinQuota(ctx sdk.Context, pair types.TokenPair, amount sdkmath.Int) error {
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.AddERC20ToCoin(amount); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// consumeCoinToERC20Quota records a Cosmos coin -> ERC20 conversion against
+// the rate limit of the token pair, if any. It fails if the conversion exceeds
+// the quota left in the current epoch.
+func (k Keeper) consumeCoinToERC20Quota(ctx sdk.Context, pair types.TokenPair, amount sdkmath.Int) error {
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.AddCoinToERC20(amount); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// ResetRateLimits resets the converted amounts of all the rate limits that
+// are tracked on the epoch with the given identifier
+func (k Keeper) ResetRateLimits(ctx sdk.Context, epochIdentifier string) {
+	for _, rateLimit := range k.GetRateLimits(ctx) {
+		if rateLimit.EpochIdentifier != epochIdentifier {
+			continue
+		}
+
+		rateLimit.ResetConverted()
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
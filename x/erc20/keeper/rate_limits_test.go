@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestRateLimitProposals() {
+	var contractAddr common.Address
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	maxAmount := sdkmath.NewInt(100)
+	negativeAmount := sdkmath.NewInt(-1)
+
+	testCases := []struct {
+		name     string
+		malleate func() error
+		expPass  bool
+	}{
+		{
+			"fail - invalid authority",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       "canto1yrmjye0zyfvr0lthc6fwq7qlwg9e8muftxa630",
+					Token:           contractAddr.String(),
+					EpochIdentifier: epochstypes.DayEpochID,
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - token pair not registered",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           "unregistered",
+					EpochIdentifier: epochstypes.DayEpochID,
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - invalid epoch identifier",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           contractAddr.String(),
+					EpochIdentifier: "",
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - epoch not found",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           contractAddr.String(),
+					EpochIdentifier: "fortnight",
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - negative maximum",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           contractAddr.String(),
+					EpochIdentifier: epochstypes.DayEpochID,
+					MaxErc20ToCoin:  &negativeAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - remove missing rate limit",
+			func() error {
+				_, err := suite.app.Erc20Keeper.RemoveRateLimitProposal(suite.ctx, &types.MsgRemoveRateLimit{
+					Authority: authority,
+					Token:     contractAddr.String(),
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"ok - set rate limit",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           contractAddr.String(),
+					EpochIdentifier: epochstypes.DayEpochID,
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"ok - remove rate limit",
+			func() error {
+				_, err := suite.app.Erc20Keeper.SetRateLimitProposal(suite.ctx, &types.MsgSetRateLimit{
+					Authority:       authority,
+					Token:           contractAddr.String(),
+					EpochIdentifier: epochstypes.DayEpochID,
+					MaxErc20ToCoin:  &maxAmount,
+					MaxCoinToErc20:  nil,
+				})
+				suite.Require().NoError(err)
+
+				_, err = suite.app.Erc20Keeper.RemoveRateLimitProposal(suite.ctx, &types.MsgRemoveRateLimit{
+					Authority: authority,
+					Token:     contractAddr.String(),
+				})
+				suite.Require().NoError(err)
+
+				denom := types.CreateDenom(contractAddr.String())
+				_, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, denom)
+				suite.Require().False(found)
+				return nil
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			err := tc.malleate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20RateLimit() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Commit()
+
+	coinName := types.CreateDenom(contractAddr.String())
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	maxERC20ToCoin, maxCoinToERC20 := sdkmath.NewInt(30), sdkmath.NewInt(10)
+	suite.app.Erc20Keeper.SetRateLimit(
+		suite.ctx,
+		types.NewRateLimit(coinName, epochstypes.DayEpochID, &maxERC20ToCoin, &maxCoinToERC20),
+	)
+
+	// ERC20 -> coin conversions are limited per epoch
+	_, err := suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(20), sender, contractAddr, suite.address))
+	suite.Require().NoError(err)
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = suite.app.Erc20Keeper.ConvertERC20(cacheCtx, types.NewMsgConvertERC20(sdkmath.NewInt(20), sender, contractAddr, suite.address))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	rateLimit, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, coinName)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(20), rateLimit.Erc20ToCoinConverted)
+	suite.Require().Equal(sdkmath.NewInt(10), *rateLimit.RemainingERC20ToCoin())
+
+	// coin -> ERC20 conversions are limited independently
+	_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(coinName, 15), suite.address, sender))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(coinName, 10), suite.address, sender))
+	suite.Require().NoError(err)
+
+	// epochs with other identifiers don't reset the rate limit
+	suite.app.Erc20Keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = suite.app.Erc20Keeper.ConvertERC20(cacheCtx, types.NewMsgConvertERC20(sdkmath.NewInt(20), sender, contractAddr, suite.address))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// the quota is restored at the end of the tracked epoch
+	suite.app.Erc20Keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(20), sender, contractAddr, suite.address))
+	suite.Require().NoError(err)
+
+	res, err := suite.app.Erc20Keeper.RateLimit(suite.ctx, &types.QueryRateLimitRequest{Token: contractAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(10), *res.RemainingErc20ToCoin)
+	suite.Require().Equal(sdkmath.NewInt(10), *res.RemainingCoinToErc20)
+
+	// a zero maximum blocks the direction while an unset one is unlimited
+	zero := sdkmath.ZeroInt()
+	suite.app.Erc20Keeper.SetRateLimit(
+		suite.ctx,
+		types.NewRateLimit(coinName, epochstypes.DayEpochID, nil, &zero),
+	)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(coinName, 1), suite.address, sender))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, types.NewMsgConvertERC20(sdkmath.NewInt(40), sender, contractAddr, suite.address))
+	suite.Require().NoError(err)
+
+	res, err = suite.app.Erc20Keeper.RateLimit(suite.ctx, &types.QueryRateLimitRequest{Token: contractAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.RemainingErc20ToCoin)
+	suite.Require().Equal(sdkmath.ZeroInt(), *res.RemainingCoinToErc20)
+
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksRateLimit() {
+	suite.SetupTest()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	coinName := types.CreateDenom(contractAddr.String())
+
+	account := tests.GenerateAddress()
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// transfer of 10 tokens to the module address
+	transferData := make([]byte, 32)
+	transferData[31] = uint8(10)
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"]
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{
+			Address: contractAddr,
+			Topics:  []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()},
+			Data:    transferData,
+		}},
+	}
+
+	maxERC20ToCoin := sdkmath.NewInt(15)
+	suite.app.Erc20Keeper.SetRateLimit(
+		suite.ctx,
+		types.NewRateLimit(coinName, epochstypes.DayEpochID, &maxERC20ToCoin, nil),
+	)
+
+	suite.escrowERC20Token(suite.ctx, contractAddr, big.NewInt(10))
+	err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(10), suite.app.BankKeeper.GetBalance(suite.ctx, account.Bytes(), coinName).Amount)
+
+	// the second transfer exceeds the limit and fails the tx
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.escrowERC20Token(cacheCtx, contractAddr, big.NewInt(10))
+	err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(cacheCtx, msg, receipt)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	rateLimit, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, coinName)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(10), rateLimit.Erc20ToCoinConverted)
+}
@@ -0,0 +1,395 @@
+package keeper
+
+import (
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// maxRegistrationDecimals is the maximum number of decimals of an ERC20 token
+// registered without a governance proposal
+const maxRegistrationDecimals = 18
+
+// RegisterPendingERC20 escrows the registration deposit of the
+// depositor and registers a disabled token pair for the ERC20 token. The token
+// pair is activated once the challenge period ends unless governance disables
+// it before.
+func (k Keeper) RegisterPendingERC20(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	contract common.Address,
+) (*types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, errorsmod.Wrap(
+			types.ErrERC20Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	if !params.EnablePermissionlessRegistration {
+		return nil, errorsmod.Wrap(
+			types.ErrRegistrationDisabled, "registration requires a governance proposal",
+		)
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract.String(),
+		)
+	}
+
+	if err := k.validateERC20Token(ctx, contract, common.BytesToAddress(depositor.Bytes())); err != nil {
+		return nil, err
+	}
+
+	if !params.RegistrationDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, params.RegistrationDeposit); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow registration deposit")
+		}
+	}
+
+	metadata, err := k.CreateCoinMetadata(ctx, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			err, "failed to create wrapped coin denom metadata for ERC20",
+		)
+	}
+
+	// the token pair stays disabled until the end of the challenge period
+	pair := types.NewTokenPair(contract, metadata.Name, false, types.OWNER_EXTERNAL)
+	// NOTE: NewTokenPair always enables the pair
+	pair.Enabled = false
+	k.SetTokenPair(ctx, pair)
+	k.SetTokenPairIdByDenom(ctx, pair.Denom, pair.GetID())
+	k.SetTokenPairIdByERC20Addr(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+
+	pending := types.NewPendingRegistration(
+		contract, depositor, params.RegistrationDeposit, ctx.BlockTime().Add(params.RegistrationChallengePeriod),
+	)
+	k.SetPendingRegistration(ctx, pending)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterPermissionless,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, pending.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, pending.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, pending.ActivationTime.String()),
+		),
+	)
+
+	return &pair, nil
+}
+
+// validateERC20Token performs sanity checks on an ERC20 token before it is
+// registered without a governance proposal. It checks the token decimals, that
+// the token has a non-zero supply and that a transfer of the sender's balance
+// moves exactly the transferred amount. The transfer is simulated on a cached
+// context and never committed.
+func (k Keeper) validateERC20Token(ctx sdk.Context, contract, sender common.Address) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20, err.Error())
+	}
+
+	if erc20Data.Decimals > maxRegistrationDecimals {
+		return errorsmod.Wrapf(
+			types.ErrInvalidERC20, "decimals %d exceed the maximum %d", erc20Data.Decimals, maxRegistrationDecimals,
+		)
+	}
+
+	supply := k.TotalSupply(ctx, erc20, contract)
+	if supply == nil || supply.Sign() != 1 {
+		return errorsmod.Wrap(types.ErrInvalidERC20, "total supply must be positive")
+	}
+
+	balance := k.BalanceOf(ctx, erc20, contract, sender)
+	if balance == nil || balance.Sign() != 1 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidERC20, "sender %s must hold tokens to simulate a transfer", sender,
+		)
+	}
+
+	// simulate a transfer of the whole sender balance to the module address
+	cacheCtx, _ := ctx.CacheContext()
+	balanceModule := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceModule == nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20, "failed to retrieve balance")
+	}
+
+	res, err := k.CallEVM(cacheCtx, erc20, sender, contract, true, "transfer", types.ModuleAddress, balance)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20, err.Error())
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil || !unpackedRet.Value {
+		return errorsmod.Wrap(types.ErrInvalidERC20, "transfer did not return true")
+	}
+
+	balanceAfter := k.BalanceOf(cacheCtx, erc20, contract, sender)
+	balanceModuleAfter := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceAfter == nil || balanceModuleAfter == nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20, "failed to retrieve balance")
+	}
+
+	expModule := new(big.Int).Add(balanceModule, balance)
+	if balanceAfter.Sign() != 0 || balanceModuleAfter.Cmp(expModule) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidERC20,
+			"non-standard transfer behavior - expected balances: (0, %v), actual: (%v, %v)",
+			expModule, balanceAfter, balanceModuleAfter,
+		)
+	}
+
+	return nil
+}
+
+// ActivatePendingRegistrations enables the token pairs whose challenge period
+// has ended and refunds their registration deposits. Only the registrations
+// due at the block time are read, through the activation time index. A failed
+// refund, e.g. to a depositor that became a blocked address, never halts the
+// chain: the deposit is left in the module account and recorded as an
+// unrefunded deposit that governance can recover.
+func (k Keeper) ActivatePendingRegistrations(ctx sdk.Context) {
+	for _, pending := range k.GetDuePendingRegistrations(ctx, ctx.BlockTime()) {
+		// refund in a cached context to discard the writes of a failed refund
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.refundDeposit(cacheCtx, pending); err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund registration deposit",
+				"erc20", pending.Erc20Address, "depositor", pending.Depositor, "error", err.Error(),
+			)
+
+			k.SetUnrefundedDeposit(ctx, pending)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRefundDepositFailed,
+					sdk.NewAttribute(types.AttributeKeyERC20Token, pending.Erc20Address),
+					sdk.NewAttribute(types.AttributeKeyDepositor, pending.Depositor),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, pending.Deposit.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+		} else {
+			writeCache()
+		}
+		k.DeletePendingRegistration(ctx, pending.GetERC20Contract())
+
+		// the token pair might have been deleted in the meantime
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairIdByERC20Addr(ctx, pending.GetERC20Contract()))
+		if !found {
+			continue
+		}
+
+		pair.Enabled = true
+		k.SetTokenPair(ctx, pair)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeActivateTokenPair,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyDepositor, pending.Depositor),
+			),
+		)
+	}
+}
+
+// rejectPendingRegistration removes a pending registration disabled by
+// governance during its challenge period. The token pair stays disabled and
+// the registration deposit is burned.
+func (k Keeper) rejectPendingRegistration(ctx sdk.Context, pair types.TokenPair, pending types.PendingRegistration) error {
+	if !pending.Deposit.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, pending.Deposit); err != nil {
+			return errorsmod.Wrap(err, "failed to burn registration deposit")
+		}
+	}
+
+	k.DeletePendingRegistration(ctx, pending.GetERC20Contract())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, pending.Depositor),
+		),
+	)
+
+	return nil
+}
+
+// refundDeposit returns the escrowed registration deposit to the depositor
+func (k Keeper) refundDeposit(ctx sdk.Context, pending types.PendingRegistration) error {
+	if pending.Deposit.IsZero() {
+		return nil
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(pending.Depositor)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, pending.Deposit)
+}
+
+// GetPendingRegistrations returns all the pending registrations
+func (k Keeper) GetPendingRegistrations(ctx sdk.Context) []types.PendingRegistration {
+	pendings := []types.PendingRegistration{}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPendingRegistration)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+
+		pendings = append(pendings, pending)
+	}
+
+	return pendings
+}
+
+// GetPendingRegistration returns the pending registration of an ERC20 token
+func (k Keeper) GetPendingRegistration(ctx sdk.Context, contract common.Address) (types.PendingRegistration, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistration)
+	bz := prefixStore.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.PendingRegistration{}, false
+	}
+
+	var pending types.PendingRegistration
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// GetPendingRegistrationExists returns true if the ERC20 token is waiting for
+// activation
+func (k Keeper) GetPendingRegistrationExists(ctx sdk.Context, contract common.Address) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistration)
+	return prefixStore.Has(contract.Bytes())
+}
+
+// GetDuePendingRegistrations returns the pending registrations whose
+// activation time is not after the given time, in activation order
+func (k Keeper) GetDuePendingRegistrations(ctx sdk.Context, blockTime time.Time) []types.PendingRegistration {
+	pendings := []types.PendingRegistration{}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistrationByTime)
+	iterator := prefixStore.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(blockTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pending, found := k.GetPendingRegistration(ctx, common.BytesToAddress(iterator.Value()))
+		if !found {
+			continue
+		}
+
+		pendings = append(pendings, pending)
+	}
+
+	return pendings
+}
+
+// SetPendingRegistration stores a pending registration and indexes it by
+// activation time
+func (k Keeper) SetPendingRegistration(ctx sdk.Context, pending types.PendingRegistration) {
+	// drop the index entry of a previous activation time
+	k.DeletePendingRegistration(ctx, pending.GetERC20Contract())
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistration)
+	bz := k.cdc.MustMarshal(&pending)
+	prefixStore.Set(pending.GetERC20Contract().Bytes(), bz)
+
+	indexStore := prefix.NewStore(store, types.KeyPrefixPendingRegistrationByTime)
+	indexStore.Set(
+		types.PendingRegistrationByTimeKey(pending.ActivationTime, pending.GetERC20Contract()),
+		pending.GetERC20Contract().Bytes(),
+	)
+}
+
+// DeletePendingRegistration removes the pending registration of an ERC20 token
+// and its activation time index entry
+func (k Keeper) DeletePendingRegistration(ctx sdk.Context, contract common.Address) {
+	pending, found := k.GetPendingRegistration(ctx, contract)
+	if !found {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPendingRegistration)
+	prefixStore.Delete(contract.Bytes())
+
+	indexStore := prefix.NewStore(store, types.KeyPrefixPendingRegistrationByTime)
+	indexStore.Delete(types.PendingRegistrationByTimeKey(pending.ActivationTime, contract))
+}
+
+// GetUnrefundedDeposits returns all the activated registrations whose deposit
+// could not be refunded
+func (k Keeper) GetUnrefundedDeposits(ctx sdk.Context) []types.PendingRegistration {
+	deposits := []types.PendingRegistration{}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixUnrefundedDeposit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.PendingRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetUnrefundedDeposit returns the unrefunded registration deposit of an
+// ERC20 token
+func (k Keeper) GetUnrefundedDeposit(ctx sdk.Context, contract common.Address) (types.PendingRegistration, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnrefundedDeposit)
+	bz := prefixStore.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.PendingRegistration{}, false
+	}
+
+	var deposit types.PendingRegistration
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetUnrefundedDeposit records a registration deposit that could not be
+// refunded
+func (k Keeper) SetUnrefundedDeposit(ctx sdk.Context, deposit types.PendingRegistration) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnrefundedDeposit)
+	bz := k.cdc.MustMarshal(&deposit)
+	prefixStore.Set(deposit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteUnrefundedDeposit removes the unrefunded registration deposit record
+// of an ERC20 token
+func (k Keeper) DeleteUnrefundedDeposit(ctx sdk.Context, contract common.Address) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.KeyPrefixUnrefundedDeposit)
+	prefixStore.Delete(contract.Bytes())
+}
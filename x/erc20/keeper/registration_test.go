@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/TucanaProtocol/Tucana/v8/testutil"
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) setupPermissionlessRegistration(enabled bool) sdk.Coins {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("atuc", 1000))
+
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.EnablePermissionlessRegistration = enabled
+	params.RegistrationDeposit = deposit
+	params.RegistrationChallengePeriod = time.Hour
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), deposit)
+	suite.Require().NoError(err)
+
+	return deposit
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		enabled  bool
+		expPass  bool
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				contractAddr, _ = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			},
+			false,
+			false,
+		},
+		{
+			"fail - token already registered",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			},
+			true,
+			false,
+		},
+		{
+			"fail - too many decimals",
+			func() {
+				contractAddr, _ = suite.DeployContract(erc20Name, erc20Symbol, 19)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			},
+			true,
+			false,
+		},
+		{
+			"fail - zero supply",
+			func() {
+				contractAddr, _ = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			},
+			true,
+			false,
+		},
+		{
+			"fail - non-standard transfer",
+			func() {
+				contractAddr = suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			},
+			true,
+			false,
+		},
+		{
+			"ok",
+			func() {
+				contractAddr, _ = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			deposit := suite.setupPermissionlessRegistration(tc.enabled)
+			tc.malleate()
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			res, err := suite.app.Erc20Keeper.RegisterERC20Permissionless(suite.ctx, &types.MsgRegisterERC20Permissionless{
+				Sender:       sender.String(),
+				Erc20Address: contractAddr.String(),
+			})
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().False(res.TokenPair.Enabled)
+
+				pending, found := suite.app.Erc20Keeper.GetPendingRegistration(suite.ctx, contractAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(deposit, pending.Deposit)
+				suite.Require().Equal(suite.ctx.BlockTime().Add(time.Hour), pending.ActivationTime)
+
+				// the deposit is escrowed and the simulated transfer is discarded
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sender, "atuc").IsZero())
+				suite.Require().Equal(big.NewInt(100), suite.BalanceOf(contractAddr, suite.address))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().False(suite.app.Erc20Keeper.GetPendingRegistrationExists(suite.ctx, contractAddr))
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestActivatePendingRegistrations() {
+	testCases := []struct {
+		name        string
+		veto        bool
+		failRefund  bool
+		elapsed     time.Duration
+		expEnabled  bool
+		expPending  bool
+		expRefunded bool
+	}{
+		{"pending - challenge period not over", false, false, time.Minute, false, true, false},
+		{"ok - activated after the challenge period", false, false, time.Hour, true, false, true},
+		{"ok - vetoed by governance", true, false, time.Hour, false, false, false},
+		{"ok - activated when the deposit refund fails", false, true, time.Hour, true, false, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			deposit := suite.setupPermissionlessRegistration(true)
+
+			contractAddr, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			pair, err := suite.app.Erc20Keeper.RegisterPendingERC20(suite.ctx, sender, contractAddr)
+			suite.Require().NoError(err)
+
+			if tc.veto {
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+				suite.Require().NoError(err)
+			}
+
+			if tc.failRefund {
+				// remove the escrowed deposit so that the refund fails
+				err := suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+			}
+
+			ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.elapsed)).WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(suite.app.Erc20Keeper.EndBlocker(ctx))
+
+			refundFailed := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRefundDepositFailed {
+					refundFailed = true
+				}
+			}
+			suite.Require().Equal(tc.failRefund, refundFailed)
+
+			// the unrefunded deposit is tracked for recovery
+			unrefunded, found := suite.app.Erc20Keeper.GetUnrefundedDeposit(ctx, contractAddr)
+			suite.Require().Equal(tc.failRefund, found)
+			if tc.failRefund {
+				suite.Require().Equal(deposit, unrefunded.Deposit)
+				suite.Require().Equal(sender.String(), unrefunded.Depositor)
+			}
+
+			updated, found := suite.app.Erc20Keeper.GetTokenPair(ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expEnabled, updated.Enabled)
+			suite.Require().Equal(tc.expPending, suite.app.Erc20Keeper.GetPendingRegistrationExists(ctx, contractAddr))
+
+			balance := suite.app.BankKeeper.GetBalance(ctx, sender, "atuc")
+			if tc.expRefunded {
+				suite.Require().Equal(deposit.AmountOf("atuc"), balance.Amount)
+			} else {
+				suite.Require().Equal(sdkmath.ZeroInt(), balance.Amount)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestGetDuePendingRegistrations() {
+	suite.SetupTest()
+
+	now := suite.ctx.BlockTime()
+	depositor := sdk.AccAddress(suite.address.Bytes())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("atuc", 1))
+	first := types.NewPendingRegistration(common.HexToAddress("0x1"), depositor, deposit, now.Add(time.Hour))
+	second := types.NewPendingRegistration(common.HexToAddress("0x2"), depositor, deposit, now.Add(time.Minute))
+	third := types.NewPendingRegistration(common.HexToAddress("0x3"), depositor, deposit, now.Add(2*time.Hour))
+	for _, pending := range []types.PendingRegistration{first, second, third} {
+		suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, pending)
+	}
+
+	suite.Require().Empty(suite.app.Erc20Keeper.GetDuePendingRegistrations(suite.ctx, now))
+	suite.Require().Equal(
+		[]types.PendingRegistration{second, first},
+		suite.app.Erc20Keeper.GetDuePendingRegistrations(suite.ctx, now.Add(time.Hour)),
+	)
+
+	// deleting a registration removes it from the index
+	suite.app.Erc20Keeper.DeletePendingRegistration(suite.ctx, second.GetERC20Contract())
+	suite.Require().Equal(
+		[]types.PendingRegistration{first},
+		suite.app.Erc20Keeper.GetDuePendingRegistrations(suite.ctx, now.Add(time.Hour)),
+	)
+
+	// moving the activation time replaces the index entry
+	first.ActivationTime = now.Add(3 * time.Hour)
+	suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, first)
+	suite.Require().Equal(
+		[]types.PendingRegistration{third, first},
+		suite.app.Erc20Keeper.GetDuePendingRegistrations(suite.ctx, now.Add(3*time.Hour)),
+	)
+}
+
+func (suite *KeeperTestSuite) TestRecoverDepositProposal() {
+	suite.SetupTest()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contractAddr := common.HexToAddress("0x1")
+	depositor := sdk.AccAddress(suite.address.Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("atuc", 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		msg      *types.MsgRecoverDeposit
+		expErr   bool
+	}{
+		{
+			"fail - invalid authority",
+			func() {},
+			&types.MsgRecoverDeposit{Authority: "invalid", Erc20Address: contractAddr.String(), Recipient: recipient.String()},
+			true,
+		},
+		{
+			"fail - invalid recipient",
+			func() {},
+			&types.MsgRecoverDeposit{Authority: authority, Erc20Address: contractAddr.String(), Recipient: "invalid"},
+			true,
+		},
+		{
+			"fail - no unrefunded deposit",
+			func() {},
+			&types.MsgRecoverDeposit{Authority: authority, Erc20Address: contractAddr.String(), Recipient: recipient.String()},
+			true,
+		},
+		{
+			"ok",
+			func() {
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, deposit))
+				suite.app.Erc20Keeper.SetUnrefundedDeposit(
+					suite.ctx, types.NewPendingRegistration(contractAddr, depositor, deposit, suite.ctx.BlockTime()),
+				)
+			},
+			&types.MsgRecoverDeposit{Authority: authority, Erc20Address: contractAddr.String(), Recipient: recipient.String()},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, err := suite.app.Erc20Keeper.RecoverDepositProposal(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+			_, found := suite.app.Erc20Keeper.GetUnrefundedDeposit(suite.ctx, contractAddr)
+			suite.Require().False(found)
+
+			res, err := suite.app.Erc20Keeper.UnrefundedDeposits(suite.ctx, &types.QueryUnrefundedDepositsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.UnrefundedDeposits)
+		})
+	}
+}
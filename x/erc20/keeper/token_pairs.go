@@ -70,6 +70,7 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteTokenPair(ctx, id)
 	k.deleteTokenPairIdByERC20Addr(ctx, tokenPair.GetERC20Contract())
 	k.deleteTokenPairIdByDenom(ctx, tokenPair.Denom)
+	k.DeleteAutoDisabledTokenPair(ctx, tokenPair.GetERC20Contract())
 }
 
 // deleteTokenPair deletes the token pair for the given id
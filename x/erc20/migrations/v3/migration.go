@@ -0,0 +1,22 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+// UpdateParams sets the permissionless registration parameters to their
+// default values.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace, depositDenom string) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyEnablePermissionlessRegistration, false)
+	paramstore.Set(ctx, types.ParamStoreKeyRegistrationDeposit, types.DefaultRegistrationDeposit(depositDenom))
+	paramstore.Set(ctx, types.ParamStoreKeyRegistrationChallengePeriod, types.DefaultRegistrationChallengePeriod)
+	return nil
+}
@@ -0,0 +1,58 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v3 "github.com/TucanaProtocol/Tucana/v8/x/erc20/migrations/v3"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	erc20Key := storetypes.NewKVStoreKey(erc20types.StoreKey)
+	tErc20Key := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", erc20types.StoreKey))
+	ctx := testutil.DefaultContext(erc20Key, tErc20Key)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, erc20Key, tErc20Key, "erc20",
+	)
+	paramstore = paramstore.WithKeyTable(erc20types.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyEnablePermissionlessRegistration))
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyRegistrationDeposit))
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyRegistrationChallengePeriod))
+
+	// Run migrations
+	err := v3.UpdateParams(ctx, &paramstore, "atuc")
+	require.NoError(t, err)
+
+	var (
+		enable  bool
+		deposit sdk.Coins
+		period  time.Duration
+	)
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, erc20types.ParamStoreKeyEnablePermissionlessRegistration, &enable)
+		paramstore.Get(ctx, erc20types.ParamStoreKeyRegistrationDeposit, &deposit)
+		paramstore.Get(ctx, erc20types.ParamStoreKeyRegistrationChallengePeriod, &period)
+	})
+
+	// check the params are set to their defaults
+	require.False(t, enable)
+	require.Equal(t, erc20types.DefaultRegistrationDeposit("atuc"), deposit)
+	require.Equal(t, erc20types.DefaultRegistrationChallengePeriod, period)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewAutoDisabledTokenPair returns an instance of AutoDisabledTokenPair
+func NewAutoDisabledTokenPair(pair TokenPair, expected, actual sdkmath.Int, height int64) AutoDisabledTokenPair {
+	return AutoDisabledTokenPair{
+		Erc20Address:   pair.Erc20Address,
+		Denom:          pair.Denom,
+		ExpectedAmount: expected,
+		ActualAmount:   actual,
+		Height:         height,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (adp AutoDisabledTokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(adp.Erc20Address)
+}
+
+// Validate performs a stateless validation of an AutoDisabledTokenPair
+func (adp AutoDisabledTokenPair) Validate() error {
+	if err := ethermint.ValidateAddress(adp.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(adp.Denom); err != nil {
+		return err
+	}
+
+	if adp.ExpectedAmount.IsNil() || !adp.ExpectedAmount.IsPositive() {
+		return fmt.Errorf("expected amount must be positive: %s", adp.ExpectedAmount)
+	}
+
+	if adp.ActualAmount.IsNil() {
+		return fmt.Errorf("actual amount cannot be nil")
+	}
+
+	if adp.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", adp.Height)
+	}
+
+	return nil
+}
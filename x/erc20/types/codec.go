@@ -36,10 +36,18 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
+		&MsgConvertCoins{},
+		&MsgConvertERC20S{},
 		&MsgUpdateParams{},
 		&MsgRegisterCoin{},
 		&MsgRegisterERC20{},
 		&MsgToggleTokenConversion{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgRegisterERC20Permissionless{},
+		&MsgMigrateTokenPair{},
+		&MsgRefreshTokenPairMetadata{},
+		&MsgRecoverDeposit{},
 	)
 
 	registry.RegisterImplementations(
@@ -60,6 +68,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, "canto/MsgRegisterERC20", nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, "canto/MsgConvertCoin", nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, "canto/MsgConvertERC20", nil)
+	cdc.RegisterConcrete(&MsgConvertCoins{}, "canto/MsgConvertCoins", nil)
+	cdc.RegisterConcrete(&MsgConvertERC20S{}, "canto/MsgConvertERC20s", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "canto/x/erc20/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "canto/x/erc20/MsgSetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "canto/x/erc20/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20Permissionless{}, "canto/x/erc20/MsgRegisterERC20Permissionless", nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenPair{}, "canto/x/erc20/MsgMigrateTokenPair", nil)
+	cdc.RegisterConcrete(&MsgRefreshTokenPairMetadata{}, "canto/x/erc20/MsgRefreshTokenPairMetadata", nil)
+	cdc.RegisterConcrete(&MsgRecoverDeposit{}, "canto/x/erc20/MsgRecoverDeposit", nil)
 	cdc.RegisterConcrete(&Params{}, "canto/x/erc20/Params", nil)
 }
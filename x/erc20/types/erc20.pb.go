@@ -5,19 +5,27 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -240,6 +248,219 @@ func (m *TokenPairERC20AddressIndex) GetTokenPairId() []byte {
 	return nil
 }
 
+// RateLimit defines the maximum amount of a token pair that can be converted
+// in each direction during an epoch. The converted amounts are reset at the end
+// of every epoch with the given identifier. An unset maximum means that the
+// conversion direction is not limited, while a zero maximum blocks it.
+type RateLimit struct {
+	// cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// identifier of the epoch after which the converted amounts are reset
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// maximum amount of ERC20 tokens that can be converted to Cosmos coins per
+	// epoch, unset means unlimited
+	MaxErc20ToCoin *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_erc20_to_coin,json=maxErc20ToCoin,proto3,customtype=cosmossdk.io/math.Int" json:"max_erc20_to_coin,omitempty"`
+	// maximum amount of Cosmos coins that can be converted to ERC20 tokens per
+	// epoch, unset means unlimited
+	MaxCoinToErc20 *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_coin_to_erc20,json=maxCoinToErc20,proto3,customtype=cosmossdk.io/math.Int" json:"max_coin_to_erc20,omitempty"`
+	// amount of ERC20 tokens converted to Cosmos coins in the current epoch
+	Erc20ToCoinConverted cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=erc20_to_coin_converted,json=erc20ToCoinConverted,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_to_coin_converted"`
+	// amount of Cosmos coins converted to ERC20 tokens in the current epoch
+	CoinToErc20Converted cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=coin_to_erc20_converted,json=coinToErc20Converted,proto3,customtype=cosmossdk.io/math.Int" json:"coin_to_erc20_converted"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c364669f6882b8b, []int{3}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// PendingRegistration defines an ERC20 token pair registered without a
+// governance proposal. The token pair is activated and the deposit refunded at
+// the activation time unless governance disables it before.
+type PendingRegistration struct {
+	// address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// bech32 address of the account that registered the token pair
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// deposit escrowed on the module account
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// time at which the token pair is activated
+	ActivationTime time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *PendingRegistration) Reset()         { *m = PendingRegistration{} }
+func (m *PendingRegistration) String() string { return proto.CompactTextString(m) }
+func (*PendingRegistration) ProtoMessage()    {}
+func (*PendingRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c364669f6882b8b, []int{4}
+}
+func (m *PendingRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRegistration.Merge(m, src)
+}
+func (m *PendingRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRegistration proto.InternalMessageInfo
+
+func (m *PendingRegistration) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *PendingRegistration) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+// AutoDisabledTokenPair records a native ERC20 token pair that was disabled
+// because a conversion escrowed a different amount of tokens than requested,
+// as happens with fee-on-transfer or rebasing tokens.
+type AutoDisabledTokenPair struct {
+	// address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount of tokens the conversion requested to escrow
+	ExpectedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=expected_amount,json=expectedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"expected_amount"`
+	// change of the module address balance measured during the escrow transfer
+	ActualAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=actual_amount,json=actualAmount,proto3,customtype=cosmossdk.io/math.Int" json:"actual_amount"`
+	// block height at which the token pair was disabled
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AutoDisabledTokenPair) Reset()         { *m = AutoDisabledTokenPair{} }
+func (m *AutoDisabledTokenPair) String() string { return proto.CompactTextString(m) }
+func (*AutoDisabledTokenPair) ProtoMessage()    {}
+func (*AutoDisabledTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c364669f6882b8b, []int{5}
+}
+func (m *AutoDisabledTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDisabledTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDisabledTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoDisabledTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDisabledTokenPair.Merge(m, src)
+}
+func (m *AutoDisabledTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDisabledTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDisabledTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDisabledTokenPair proto.InternalMessageInfo
+
+func (m *AutoDisabledTokenPair) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *AutoDisabledTokenPair) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AutoDisabledTokenPair) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 // Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
@@ -252,14 +473,14 @@ type RegisterCoinProposal struct {
 	// proposal description
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata of the native Cosmos coin
-	Metadata types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	Metadata types1.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c364669f6882b8b, []int{3}
+	return fileDescriptor_5c364669f6882b8b, []int{6}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,11 +523,11 @@ func (m *RegisterCoinProposal) GetDescription() string {
 	return ""
 }
 
-func (m *RegisterCoinProposal) GetMetadata() types.Metadata {
+func (m *RegisterCoinProposal) GetMetadata() types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
-	return types.Metadata{}
+	return types1.Metadata{}
 }
 
 // RegisterERC20Proposal is a gov Content type to register a token pair for an
@@ -328,7 +549,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c364669f6882b8b, []int{4}
+	return fileDescriptor_5c364669f6882b8b, []int{7}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +619,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c364669f6882b8b, []int{5}
+	return fileDescriptor_5c364669f6882b8b, []int{8}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +674,9 @@ func init() {
 	proto.RegisterType((*TokenPair)(nil), "canto.erc20.v1.TokenPair")
 	proto.RegisterType((*TokenPairDenomIndex)(nil), "canto.erc20.v1.TokenPairDenomIndex")
 	proto.RegisterType((*TokenPairERC20AddressIndex)(nil), "canto.erc20.v1.TokenPairERC20AddressIndex")
+	proto.RegisterType((*RateLimit)(nil), "canto.erc20.v1.RateLimit")
+	proto.RegisterType((*PendingRegistration)(nil), "canto.erc20.v1.PendingRegistration")
+	proto.RegisterType((*AutoDisabledTokenPair)(nil), "canto.erc20.v1.AutoDisabledTokenPair")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "canto.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "canto.erc20.v1.RegisterERC20Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "canto.erc20.v1.ToggleTokenConversionProposal")
@@ -461,42 +685,66 @@ func init() {
 func init() { proto.RegisterFile("canto/erc20/v1/erc20.proto", fileDescriptor_5c364669f6882b8b) }
 
 var fileDescriptor_5c364669f6882b8b = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0xd3, 0xda, 0x4e, 0x93, 0x10, 0xc7, 0x04, 0x96, 0x40, 0x37, 0x21, 0x5e, 0x82,
-	0xe0, 0xae, 0x89, 0x17, 0x29, 0x82, 0xb4, 0xc9, 0x0a, 0x91, 0x36, 0x09, 0x6b, 0x82, 0xe2, 0x25,
-	0x4c, 0x76, 0x87, 0x75, 0x48, 0x32, 0x13, 0x66, 0xa7, 0x69, 0xc5, 0x3f, 0xe0, 0xd1, 0x9b, 0xd7,
-	0x82, 0xfe, 0x98, 0x1e, 0x7b, 0xf4, 0x24, 0x92, 0x5c, 0xfa, 0x33, 0x64, 0x67, 0x76, 0x63, 0x14,
-	0x0f, 0x42, 0x6f, 0xf3, 0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0xfb, 0xde, 0x0c, 0x2c, 0xfb, 0x98, 0x49,
-	0xee, 0x10, 0xe1, 0x37, 0x9f, 0x38, 0x8b, 0x86, 0x3e, 0xd8, 0x73, 0xc1, 0x25, 0x47, 0x79, 0x15,
-	0xb3, 0x35, 0xb5, 0x68, 0x94, 0x8b, 0x21, 0x0f, 0xb9, 0x0a, 0x39, 0xf1, 0x49, 0x67, 0x95, 0x2d,
-	0x9f, 0x47, 0x33, 0x1e, 0x39, 0x63, 0xcc, 0x26, 0xce, 0xa2, 0x31, 0x26, 0x12, 0x37, 0x14, 0xd0,
-	0xf1, 0xda, 0x37, 0x00, 0xf7, 0x07, 0x7c, 0x42, 0x58, 0x1f, 0x53, 0x81, 0x1e, 0xc2, 0x9c, 0xaa,
-	0x37, 0xc2, 0x41, 0x20, 0x48, 0x14, 0x99, 0xa0, 0x0a, 0xea, 0xfb, 0x5e, 0x56, 0x91, 0xc7, 0x9a,
-	0x43, 0x45, 0xb8, 0x13, 0x10, 0xc6, 0x67, 0xe6, 0x96, 0x0a, 0x6a, 0x80, 0x4c, 0x78, 0x8f, 0x30,
-	0x3c, 0x9e, 0x92, 0xc0, 0xdc, 0xae, 0x82, 0xfa, 0x9e, 0x97, 0x42, 0xf4, 0x1c, 0xe6, 0x7d, 0xce,
-	0xa4, 0xc0, 0xbe, 0x1c, 0xf1, 0x0b, 0x46, 0x84, 0x99, 0xa9, 0x82, 0x7a, 0xbe, 0x59, 0xb2, 0xff,
-	0x9c, 0xc0, 0xee, 0xc5, 0x41, 0x2f, 0x97, 0x26, 0x2b, 0x78, 0x94, 0xb9, 0xbd, 0xaa, 0x80, 0xda,
-	0x10, 0x3e, 0x58, 0xab, 0x6c, 0xc7, 0xfd, 0x3a, 0x2c, 0x20, 0x97, 0xbf, 0xa5, 0x80, 0x4d, 0x29,
-	0x35, 0x98, 0x93, 0x71, 0xf2, 0x68, 0x8e, 0xa9, 0x18, 0xd1, 0x40, 0x09, 0xcd, 0x7a, 0x07, 0x32,
-	0xad, 0xd0, 0x09, 0x92, 0xb2, 0x13, 0x58, 0x5e, 0x97, 0x75, 0xbd, 0xd6, 0x7a, 0x46, 0x5d, 0xfd,
-	0x9f, 0x6e, 0x64, 0xff, 0x72, 0xe3, 0xff, 0x9b, 0x7d, 0x01, 0xb0, 0xe8, 0x91, 0x90, 0x46, 0x92,
-	0x88, 0x16, 0xa7, 0xac, 0x2f, 0xf8, 0x9c, 0x47, 0x78, 0x1a, 0x4f, 0x21, 0xa9, 0x9c, 0x92, 0x74,
-	0x0a, 0x05, 0x50, 0x15, 0x1e, 0x04, 0x24, 0xf2, 0x05, 0x9d, 0x4b, 0xca, 0x59, 0x62, 0xf6, 0x26,
-	0x85, 0x5e, 0xc0, 0xbd, 0x19, 0x91, 0x38, 0xc0, 0x12, 0x2b, 0xcf, 0x0f, 0x9a, 0x87, 0xb6, 0x5e,
-	0xb7, 0xad, 0x36, 0x9c, 0xac, 0xdb, 0x3e, 0x4b, 0x92, 0x4e, 0x32, 0xd7, 0x3f, 0x2a, 0x86, 0xb7,
-	0xbe, 0x74, 0xb4, 0x7b, 0x7b, 0x55, 0x31, 0x4c, 0x50, 0xfb, 0x08, 0x4b, 0xa9, 0x30, 0xe5, 0xc2,
-	0x9d, 0x95, 0xd5, 0xa0, 0x36, 0x29, 0x35, 0x6e, 0x7b, 0xe3, 0x19, 0x25, 0xdc, 0xba, 0xf9, 0x39,
-	0x3c, 0x1c, 0xf0, 0x30, 0x9c, 0x12, 0xb5, 0x89, 0x16, 0x67, 0x0b, 0x22, 0x22, 0xca, 0xef, 0x6e,
-	0x4f, 0x7c, 0x2f, 0x2e, 0x99, 0x74, 0xd7, 0x20, 0x6d, 0xfb, 0xe8, 0x15, 0xdc, 0x51, 0x0f, 0x0c,
-	0x95, 0xe0, 0xfd, 0xde, 0x9b, 0xae, 0xeb, 0x8d, 0x86, 0xdd, 0xd7, 0x7d, 0xb7, 0xd5, 0x79, 0xd9,
-	0x71, 0xdb, 0x05, 0x03, 0x15, 0x60, 0x56, 0xd3, 0x67, 0xbd, 0xf6, 0xf0, 0xd4, 0x2d, 0x00, 0x84,
-	0x60, 0x5e, 0x33, 0xee, 0xdb, 0x81, 0xeb, 0x75, 0x8f, 0x4f, 0x0b, 0x5b, 0xe5, 0xcc, 0xa7, 0xaf,
-	0x96, 0x71, 0xd2, 0xb9, 0x5e, 0x5a, 0xe0, 0x66, 0x69, 0x81, 0x9f, 0x4b, 0x0b, 0x7c, 0x5e, 0x59,
-	0xc6, 0xcd, 0xca, 0x32, 0xbe, 0xaf, 0x2c, 0xe3, 0x9d, 0x13, 0x52, 0xf9, 0xfe, 0x7c, 0x6c, 0xfb,
-	0x7c, 0xe6, 0xb4, 0xe2, 0xd7, 0xfe, 0xb8, 0x4b, 0xe4, 0x05, 0x17, 0x13, 0x8d, 0x9c, 0xc5, 0x33,
-	0xe7, 0x32, 0xf9, 0xde, 0xf2, 0xc3, 0x9c, 0x44, 0xe3, 0x5d, 0xf5, 0x2d, 0x9f, 0xfe, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0x96, 0x3a, 0xfa, 0x94, 0xfa, 0x03, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x4e, 0x1a, 0x8f, 0x1d, 0xc7, 0xdd, 0xc6, 0xb0, 0xb5, 0xa8, 0x6d, 0x99, 0x8b,
+	0x01, 0x65, 0xb7, 0x31, 0x17, 0x54, 0x21, 0xa1, 0xd8, 0x31, 0xd2, 0xa2, 0x7c, 0x58, 0x8b, 0x03,
+	0x88, 0xcb, 0x6a, 0xbc, 0x3b, 0x5d, 0x8f, 0xec, 0x9d, 0x59, 0xed, 0x8e, 0x5d, 0x23, 0xfe, 0x01,
+	0x8e, 0x3d, 0x20, 0xc1, 0xb1, 0x12, 0x9c, 0x38, 0xf3, 0x47, 0x54, 0x9c, 0x2a, 0x4e, 0x88, 0x43,
+	0x8b, 0x92, 0x4b, 0xff, 0x0c, 0x34, 0x1f, 0xbb, 0x76, 0xf8, 0x90, 0xd2, 0xa6, 0x27, 0xef, 0x7b,
+	0x6f, 0xde, 0xef, 0xfd, 0xe6, 0x7d, 0x8d, 0x41, 0xdd, 0x83, 0x84, 0x51, 0x0b, 0xc5, 0x5e, 0xf7,
+	0xbe, 0xb5, 0x38, 0x90, 0x1f, 0x66, 0x14, 0x53, 0x46, 0xf5, 0x8a, 0xb0, 0x99, 0x52, 0xb5, 0x38,
+	0xa8, 0xef, 0x05, 0x34, 0xa0, 0xc2, 0x64, 0xf1, 0x2f, 0x79, 0xaa, 0xde, 0xf0, 0x68, 0x12, 0xd2,
+	0xc4, 0x1a, 0x43, 0x32, 0xb5, 0x16, 0x07, 0x63, 0xc4, 0xe0, 0x81, 0x10, 0x94, 0xfd, 0xae, 0xb4,
+	0xbb, 0xd2, 0x51, 0x0a, 0xff, 0x72, 0x4d, 0x50, 0xe6, 0xea, 0x51, 0x4c, 0x94, 0xbd, 0x19, 0x50,
+	0x1a, 0xcc, 0x90, 0x25, 0xa4, 0xf1, 0xfc, 0xa1, 0xc5, 0x70, 0x88, 0x12, 0x06, 0xc3, 0x48, 0x1e,
+	0x68, 0xff, 0xac, 0x81, 0xe2, 0x88, 0x4e, 0x11, 0x19, 0x42, 0x1c, 0xeb, 0xef, 0x82, 0x1d, 0xc1,
+	0xd5, 0x85, 0xbe, 0x1f, 0xa3, 0x24, 0x31, 0xb4, 0x96, 0xd6, 0x29, 0x3a, 0x65, 0xa1, 0x3c, 0x94,
+	0x3a, 0x7d, 0x0f, 0x6c, 0xfa, 0x88, 0xd0, 0xd0, 0xd8, 0x10, 0x46, 0x29, 0xe8, 0x06, 0xb8, 0x85,
+	0x08, 0x1c, 0xcf, 0x90, 0x6f, 0xe4, 0x5b, 0x5a, 0x67, 0xdb, 0x49, 0x45, 0xfd, 0x63, 0x50, 0xf1,
+	0x28, 0x61, 0x31, 0xf4, 0x98, 0x4b, 0x1f, 0x11, 0x14, 0x1b, 0x85, 0x96, 0xd6, 0xa9, 0x74, 0x6b,
+	0xe6, 0xd5, 0xec, 0x98, 0x67, 0xdc, 0xe8, 0xec, 0xa4, 0x87, 0x85, 0xf8, 0xa0, 0xf0, 0xf2, 0x49,
+	0x53, 0x6b, 0x9f, 0x83, 0x3b, 0x19, 0xcb, 0x23, 0x1e, 0xcf, 0x26, 0x3e, 0x5a, 0xae, 0xa8, 0x68,
+	0xeb, 0x54, 0xda, 0x60, 0x87, 0xf1, 0xc3, 0x6e, 0x04, 0x71, 0xec, 0x62, 0x5f, 0x10, 0x2d, 0x3b,
+	0x25, 0x96, 0x22, 0xd8, 0xbe, 0x82, 0x9d, 0x82, 0x7a, 0x06, 0x3b, 0x70, 0xfa, 0xd9, 0x1d, 0x25,
+	0xfa, 0x7f, 0x66, 0xa3, 0xfc, 0x8f, 0x6c, 0x5c, 0x3f, 0xd8, 0x6f, 0x79, 0x50, 0x74, 0x20, 0x43,
+	0xc7, 0x38, 0xc4, 0xec, 0x7f, 0xa8, 0xbf, 0x07, 0xaa, 0x28, 0xa2, 0xde, 0xc4, 0xc5, 0x3e, 0x22,
+	0x0c, 0x3f, 0xc4, 0x28, 0x56, 0x69, 0xde, 0x15, 0x7a, 0x3b, 0x53, 0xeb, 0x5f, 0x80, 0xdb, 0x21,
+	0x5c, 0xba, 0x92, 0x21, 0xa3, 0x2e, 0xaf, 0xba, 0x48, 0x7d, 0xb1, 0xf7, 0xc1, 0xd3, 0xe7, 0x4d,
+	0xed, 0xcf, 0xe7, 0xcd, 0x9a, 0xec, 0x8e, 0xc4, 0x9f, 0x9a, 0x98, 0x5a, 0x21, 0x64, 0x13, 0xd3,
+	0x26, 0xec, 0xf7, 0x5f, 0xf7, 0x81, 0x6a, 0x22, 0x9b, 0x30, 0xa7, 0x12, 0xc2, 0xe5, 0x80, 0x83,
+	0x8c, 0x68, 0x9f, 0x62, 0x92, 0xe2, 0x72, 0x38, 0x0e, 0x2b, 0xf0, 0x8d, 0xc2, 0xeb, 0xe1, 0x72,
+	0xc0, 0x11, 0x15, 0xe8, 0xfa, 0x18, 0xbc, 0x7d, 0x85, 0xab, 0xeb, 0x51, 0xb2, 0x40, 0x31, 0x43,
+	0xbe, 0xb1, 0x99, 0xa1, 0xe7, 0xae, 0x8b, 0xbe, 0x87, 0x56, 0x94, 0xfb, 0x29, 0x10, 0x8f, 0x71,
+	0x85, 0xf7, 0x5a, 0x8c, 0xad, 0xd7, 0x88, 0xe1, 0xad, 0xe8, 0x67, 0x31, 0x54, 0x31, 0xbf, 0xdf,
+	0x00, 0x77, 0x86, 0x88, 0xf8, 0x98, 0x04, 0x0e, 0x0a, 0x70, 0xc2, 0x62, 0xc8, 0x30, 0x25, 0xd7,
+	0x9b, 0xa0, 0x77, 0x40, 0xd1, 0x47, 0x11, 0x4d, 0x30, 0xa3, 0x69, 0x79, 0x57, 0x0a, 0x1d, 0x81,
+	0x5b, 0x4a, 0x30, 0xf2, 0xad, 0x7c, 0xa7, 0xd4, 0xbd, 0x6b, 0x2a, 0x52, 0x7c, 0xca, 0x4d, 0x35,
+	0xe5, 0x26, 0xbf, 0x79, 0xef, 0x3e, 0xbf, 0xcf, 0x2f, 0x2f, 0x9a, 0x9d, 0x00, 0xb3, 0xc9, 0x7c,
+	0x6c, 0x7a, 0x34, 0x54, 0x0b, 0x42, 0xfd, 0xec, 0x27, 0xfe, 0xd4, 0x62, 0xdf, 0x44, 0x28, 0x11,
+	0x0e, 0x89, 0x93, 0x62, 0xeb, 0x27, 0x60, 0x17, 0x7a, 0x0c, 0x2f, 0x04, 0x6f, 0x97, 0xef, 0x05,
+	0x51, 0xe5, 0x52, 0xb7, 0x6e, 0xca, 0xa5, 0x61, 0xa6, 0x4b, 0xc3, 0x1c, 0xa5, 0x4b, 0xa3, 0xb7,
+	0xcd, 0xe3, 0x3d, 0x7e, 0xd1, 0xd4, 0x9c, 0xca, 0xca, 0x99, 0x9b, 0x55, 0x5a, 0x7e, 0xdc, 0x00,
+	0xb5, 0xc3, 0x39, 0xa3, 0x47, 0x38, 0x11, 0xc3, 0xff, 0x46, 0x56, 0xcb, 0x08, 0xec, 0xa2, 0x65,
+	0x84, 0x3c, 0x86, 0x7c, 0x17, 0x86, 0x74, 0x4e, 0x98, 0x91, 0x7f, 0xf5, 0x6a, 0x56, 0x52, 0x8c,
+	0x43, 0x01, 0xa1, 0x0f, 0xc1, 0x0e, 0xf4, 0xd8, 0x1c, 0xce, 0x52, 0xcc, 0xc2, 0xab, 0x63, 0x96,
+	0x25, 0x82, 0x42, 0x7c, 0x0b, 0x6c, 0x4d, 0x10, 0x0e, 0x26, 0x4c, 0x34, 0x74, 0xde, 0x51, 0x92,
+	0x4a, 0xcd, 0x0f, 0x1a, 0xd8, 0x93, 0xad, 0x82, 0x62, 0x5e, 0x8a, 0x61, 0x4c, 0x23, 0x9a, 0xc0,
+	0x19, 0xbf, 0x34, 0xc3, 0x6c, 0x86, 0xd2, 0x4d, 0x20, 0x04, 0xbd, 0x05, 0x4a, 0x3e, 0x4a, 0xbc,
+	0x18, 0x47, 0x3c, 0xc5, 0x2a, 0x21, 0xeb, 0x2a, 0xfd, 0x13, 0xb0, 0x1d, 0x22, 0x06, 0x7d, 0xc8,
+	0xa0, 0xc8, 0x47, 0xa9, 0x7b, 0x6f, 0xd5, 0x28, 0x64, 0x9a, 0x35, 0xca, 0x89, 0x3a, 0xd4, 0x2b,
+	0xf0, 0xab, 0x39, 0x99, 0xd3, 0x83, 0xad, 0x97, 0x4f, 0x9a, 0x39, 0x43, 0x6b, 0x7f, 0x0b, 0x6a,
+	0x29, 0x31, 0xb1, 0x04, 0x6f, 0xcc, 0xac, 0x0d, 0x64, 0x59, 0xd3, 0x52, 0xe7, 0xd7, 0x4a, 0xad,
+	0x74, 0x59, 0xf0, 0x39, 0xb8, 0x37, 0xa2, 0x41, 0x30, 0x43, 0xa2, 0x55, 0xe4, 0x98, 0x25, 0x98,
+	0xde, 0x3c, 0x3d, 0xdc, 0x8f, 0x43, 0xaa, 0xe8, 0x52, 0x48, 0xc3, 0xbe, 0xff, 0x19, 0xd8, 0x14,
+	0xef, 0x8b, 0x5e, 0x03, 0xb7, 0xcf, 0xbe, 0x3c, 0x1d, 0x38, 0xee, 0xf9, 0xe9, 0xe7, 0xc3, 0x41,
+	0xdf, 0xfe, 0xd4, 0x1e, 0x1c, 0x55, 0x73, 0x7a, 0x15, 0x94, 0xa5, 0xfa, 0xe4, 0xec, 0xe8, 0xfc,
+	0x78, 0x50, 0xd5, 0x74, 0x1d, 0x54, 0xa4, 0x66, 0xf0, 0xd5, 0x68, 0xe0, 0x9c, 0x1e, 0x1e, 0x57,
+	0x37, 0xea, 0x85, 0xef, 0x7e, 0x6a, 0xe4, 0x7a, 0xf6, 0xd3, 0x8b, 0x86, 0xf6, 0xec, 0xa2, 0xa1,
+	0xfd, 0x75, 0xd1, 0xd0, 0x1e, 0x5f, 0x36, 0x72, 0xcf, 0x2e, 0x1b, 0xb9, 0x3f, 0x2e, 0x1b, 0xb9,
+	0xaf, 0xad, 0xb5, 0xb1, 0xec, 0xf3, 0xc7, 0x6e, 0xff, 0x14, 0xb1, 0x47, 0x34, 0x9e, 0x4a, 0xc9,
+	0x5a, 0x7c, 0x64, 0x2d, 0xd5, 0x3f, 0x07, 0x31, 0xa3, 0xe3, 0x2d, 0x31, 0x73, 0x1f, 0xfe, 0x3d,
+	0x00, 0x8f, 0x46, 0xd5, 0x2d, 0x55, 0x08, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -586,6 +834,127 @@ func (this *TokenPairERC20AddressIndex) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if that1.MaxErc20ToCoin == nil {
+		if this.MaxErc20ToCoin != nil {
+			return false
+		}
+	} else if !this.MaxErc20ToCoin.Equal(*that1.MaxErc20ToCoin) {
+		return false
+	}
+	if that1.MaxCoinToErc20 == nil {
+		if this.MaxCoinToErc20 != nil {
+			return false
+		}
+	} else if !this.MaxCoinToErc20.Equal(*that1.MaxCoinToErc20) {
+		return false
+	}
+	if !this.Erc20ToCoinConverted.Equal(that1.Erc20ToCoinConverted) {
+		return false
+	}
+	if !this.CoinToErc20Converted.Equal(that1.CoinToErc20Converted) {
+		return false
+	}
+	return true
+}
+func (this *PendingRegistration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingRegistration)
+	if !ok {
+		that2, ok := that.(PendingRegistration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	if !this.ActivationTime.Equal(that1.ActivationTime) {
+		return false
+	}
+	return true
+}
+func (this *AutoDisabledTokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoDisabledTokenPair)
+	if !ok {
+		that2, ok := that.(AutoDisabledTokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.ExpectedAmount.Equal(that1.ExpectedAmount) {
+		return false
+	}
+	if !this.ActualAmount.Equal(that1.ActualAmount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *TokenPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -712,7 +1081,7 @@ func (m *TokenPairERC20AddressIndex) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -722,44 +1091,78 @@ func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.CoinToErc20Converted.Size()
+		i -= size
+		if _, err := m.CoinToErc20Converted.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Erc20ToCoinConverted.Size()
 		i -= size
+		if _, err := m.Erc20ToCoinConverted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintErc20(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+	dAtA[i] = 0x2a
+	if m.MaxCoinToErc20 != nil {
+		{
+			size := m.MaxCoinToErc20.Size()
+			i -= size
+			if _, err := m.MaxCoinToErc20.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxErc20ToCoin != nil {
+		{
+			size := m.MaxErc20ToCoin.Size()
+			i -= size
+			if _, err := m.MaxErc20ToCoin.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+func (m *PendingRegistration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -769,22 +1172,190 @@ func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingRegistration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintErc20(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Erc20Address) > 0 {
 		i -= len(m.Erc20Address)
 		copy(dAtA[i:], m.Erc20Address)
 		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoDisabledTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDisabledTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDisabledTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ActualAmount.Size()
+		i -= size
+		if _, err := m.ActualAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExpectedAmount.Size()
+		i -= size
+		if _, err := m.ExpectedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -915,6 +1486,84 @@ func (m *TokenPairERC20AddressIndex) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.MaxErc20ToCoin != nil {
+		l = m.MaxErc20ToCoin.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.MaxCoinToErc20 != nil {
+		l = m.MaxCoinToErc20.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Erc20ToCoinConverted.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinToErc20Converted.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *PendingRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *AutoDisabledTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.ExpectedAmount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ActualAmount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovErc20(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1369,6 +2018,642 @@ func (m *TokenPairERC20AddressIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErc20ToCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxErc20ToCoin = &v
+			if err := m.MaxErc20ToCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoinToErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxCoinToErc20 = &v
+			if err := m.MaxCoinToErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToCoinConverted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20ToCoinConverted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToErc20Converted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToErc20Converted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoDisabledTokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoDisabledTokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoDisabledTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,11 @@ var (
 	ErrEVMDenom               = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
 	ErrEVMCall                = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
 	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrRateLimitExceeded      = errorsmod.Register(ModuleName, 14, "token pair conversion rate limit exceeded")
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 15, "token pair rate limit not found")
+	ErrRegistrationDisabled   = errorsmod.Register(ModuleName, 16, "permissionless registration is disabled")
+	ErrInvalidERC20           = errorsmod.Register(ModuleName, 17, "ERC20 token failed the registration checks")
+	ErrTokenPairMigration     = errorsmod.Register(ModuleName, 18, "token pair migration failed")
+	ErrDecimalsChanged        = errorsmod.Register(ModuleName, 19, "ERC20 token decimals changed")
+	ErrUnrefundedDeposit      = errorsmod.Register(ModuleName, 20, "unrefunded registration deposit not found")
 )
@@ -8,19 +8,40 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock             = "token_lock"
-	EventTypeTokenUnlock           = "token_unlock"
-	EventTypeMint                  = "mint"
-	EventTypeConvertCoin           = "convert_coin"
-	EventTypeConvertERC20          = "convert_erc20"
-	EventTypeBurn                  = "burn"
-	EventTypeRegisterCoin          = "register_coin"
-	EventTypeRegisterERC20         = "register_erc20"
-	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeTokenLock                = "token_lock"
+	EventTypeTokenUnlock              = "token_unlock"
+	EventTypeMint                     = "mint"
+	EventTypeConvertCoin              = "convert_coin"
+	EventTypeConvertERC20             = "convert_erc20"
+	EventTypeBurn                     = "burn"
+	EventTypeRegisterCoin             = "register_coin"
+	EventTypeRegisterERC20            = "register_erc20"
+	EventTypeToggleTokenConversion    = "toggle_token_conversion" // #nosec
+	EventTypeSetRateLimit             = "set_rate_limit"
+	EventTypeRemoveRateLimit          = "remove_rate_limit"
+	EventTypeRegisterPermissionless   = "register_erc20_permissionless"
+	EventTypeActivateTokenPair        = "activate_token_pair"
+	EventTypeRejectTokenPair          = "reject_token_pair"
+	EventTypeMigrateTokenPair         = "migrate_token_pair"
+	EventTypeRefreshTokenPairMetadata = "refresh_token_pair_metadata"
+	EventTypeAutoDisableTokenPair     = "auto_disable_token_pair"
+	EventTypeRefundDepositFailed      = "refund_deposit_failed"
+	EventTypeRecoverDeposit           = "recover_deposit"
 
-	AttributeKeyCosmosCoin = "cosmos_coin"
-	AttributeKeyERC20Token = "erc20_token" // #nosec
-	AttributeKeyReceiver   = "receiver"
+	AttributeKeyCosmosCoin     = "cosmos_coin"
+	AttributeKeyERC20Token     = "erc20_token" // #nosec
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyEpochID        = "epoch_identifier"
+	AttributeKeyDepositor      = "depositor"
+	AttributeKeyActivationTime = "activation_time"
+	AttributeKeyNewERC20Token  = "new_erc20_token" // #nosec
+	AttributeKeyEscrowMoved    = "escrow_moved"
+	AttributeKeyName           = "name"
+	AttributeKeySymbol         = "symbol"
+	AttributeKeyDisplay        = "display"
+	AttributeKeyExpectedAmount = "expected_amount"
+	AttributeKeyActualAmount   = "actual_amount"
+	AttributeKeyError          = "error"
 
 	ERC20EventTransfer = "Transfer"
 )
@@ -44,5 +44,66 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenRateLimit := make(map[string]bool)
+	for _, rl := range gs.RateLimits {
+		if seenRateLimit[rl.Denom] {
+			return fmt.Errorf("rate limit duplicated on genesis: '%s'", rl.Denom)
+		}
+		if !seenDenom[rl.Denom] {
+			return fmt.Errorf("rate limit for unregistered token pair on genesis: '%s'", rl.Denom)
+		}
+
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		seenRateLimit[rl.Denom] = true
+	}
+
+	seenPending := make(map[string]bool)
+	for _, pr := range gs.PendingRegistrations {
+		if seenPending[pr.Erc20Address] {
+			return fmt.Errorf("pending registration duplicated on genesis: '%s'", pr.Erc20Address)
+		}
+		if !seenErc20[pr.Erc20Address] {
+			return fmt.Errorf("pending registration for unregistered token pair on genesis: '%s'", pr.Erc20Address)
+		}
+
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+
+		seenPending[pr.Erc20Address] = true
+	}
+
+	seenAutoDisabled := make(map[string]bool)
+	for _, adp := range gs.AutoDisabledTokenPairs {
+		if seenAutoDisabled[adp.Erc20Address] {
+			return fmt.Errorf("auto-disabled token pair duplicated on genesis: '%s'", adp.Erc20Address)
+		}
+		if !seenErc20[adp.Erc20Address] {
+			return fmt.Errorf("auto-disabled record for unregistered token pair on genesis: '%s'", adp.Erc20Address)
+		}
+
+		if err := adp.Validate(); err != nil {
+			return err
+		}
+
+		seenAutoDisabled[adp.Erc20Address] = true
+	}
+
+	seenUnrefunded := make(map[string]bool)
+	for _, deposit := range gs.UnrefundedDeposits {
+		if seenUnrefunded[deposit.Erc20Address] {
+			return fmt.Errorf("unrefunded deposit duplicated on genesis: '%s'", deposit.Erc20Address)
+		}
+
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+
+		seenUnrefunded[deposit.Erc20Address] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,18 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +42,16 @@ type GenesisState struct {
 	// list of mappings from ERC20 addresses to token pair IDs, used for indexing
 	// token pairs by their ERC20 address
 	Erc20AddressIndexes []TokenPairERC20AddressIndex `protobuf:"bytes,4,rep,name=erc20_address_indexes,json=erc20AddressIndexes,proto3" json:"erc20_address_indexes"`
+	// conversion rate limits of the registered token pairs
+	RateLimits []RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// ERC20 token pairs registered without governance that are waiting for the
+	// end of their challenge period
+	PendingRegistrations []PendingRegistration `protobuf:"bytes,6,rep,name=pending_registrations,json=pendingRegistrations,proto3" json:"pending_registrations"`
+	// native ERC20 token pairs disabled after an escrow balance mismatch
+	AutoDisabledTokenPairs []AutoDisabledTokenPair `protobuf:"bytes,7,rep,name=auto_disabled_token_pairs,json=autoDisabledTokenPairs,proto3" json:"auto_disabled_token_pairs"`
+	// activated registrations whose deposit could not be refunded and is held
+	// on the module account until governance recovers it
+	UnrefundedDeposits []PendingRegistration `protobuf:"bytes,8,rep,name=unrefunded_deposits,json=unrefundedDeposits,proto3" json:"unrefunded_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -99,6 +115,34 @@ func (m *GenesisState) GetErc20AddressIndexes() []TokenPairERC20AddressIndex {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRegistrations() []PendingRegistration {
+	if m != nil {
+		return m.PendingRegistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoDisabledTokenPairs() []AutoDisabledTokenPair {
+	if m != nil {
+		return m.AutoDisabledTokenPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnrefundedDeposits() []PendingRegistration {
+	if m != nil {
+		return m.UnrefundedDeposits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -107,6 +151,15 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the
 	// ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// parameter to enable the registration of ERC20 tokens without a
+	// governance proposal.
+	EnablePermissionlessRegistration bool `protobuf:"varint,3,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// deposit escrowed when registering an ERC20 token without a governance
+	// proposal. It is refunded once the token pair is activated.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// period during which governance can disable a token pair registered
+	// without a governance proposal before it is activated.
+	RegistrationChallengePeriod time.Duration `protobuf:"bytes,5,opt,name=registration_challenge_period,json=registrationChallengePeriod,proto3,stdduration" json:"registration_challenge_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -156,6 +209,27 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetEnablePermissionlessRegistration() bool {
+	if m != nil {
+		return m.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationChallengePeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationChallengePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "canto.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "canto.erc20.v1.Params")
@@ -164,33 +238,51 @@ func init() {
 func init() { proto.RegisterFile("canto/erc20/v1/genesis.proto", fileDescriptor_6af5bf0eee46eaa1) }
 
 var fileDescriptor_6af5bf0eee46eaa1 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x5b, 0x20, 0xc4, 0x5c, 0x41, 0x43, 0x45, 0x53, 0x88, 0x29, 0x88, 0x0b, 0x21, 0xb1,
-	0x07, 0xd5, 0x41, 0x9d, 0x14, 0x24, 0xca, 0x20, 0x21, 0xd5, 0x38, 0xb8, 0x34, 0x07, 0xbd, 0x94,
-	0xa6, 0xb6, 0xd7, 0xf4, 0xce, 0x8a, 0xa3, 0xab, 0x93, 0x1f, 0xc5, 0x0f, 0xe0, 0x07, 0x60, 0x64,
-	0x74, 0x22, 0xa6, 0x0c, 0x7e, 0x0d, 0x73, 0x77, 0x85, 0xf0, 0x27, 0x61, 0x69, 0xee, 0x9e, 0xe7,
-	0xf7, 0x3c, 0x97, 0xbc, 0x6f, 0xc1, 0x83, 0x15, 0x8a, 0x19, 0x81, 0x38, 0x5d, 0xd9, 0x43, 0x98,
-	0x8d, 0xa0, 0x8f, 0x63, 0x4c, 0x03, 0x6a, 0x25, 0x29, 0x61, 0x44, 0xbf, 0x2d, 0x5c, 0x4b, 0xb8,
-	0x56, 0x36, 0x6a, 0xb7, 0x2f, 0x68, 0x69, 0x08, 0xb6, 0xdd, 0xf4, 0x89, 0x4f, 0xc4, 0x11, 0xf2,
-	0x53, 0xa1, 0x36, 0x50, 0x14, 0xc4, 0x04, 0x8a, 0xaf, 0x94, 0x7a, 0xbf, 0x4b, 0xa0, 0xf6, 0x46,
-	0x3e, 0xf3, 0x9e, 0x21, 0x86, 0xf5, 0xa7, 0xa0, 0x9a, 0xa0, 0x14, 0x45, 0xd4, 0x50, 0xbb, 0x6a,
-	0x5f, 0xb3, 0xef, 0x5b, 0x37, 0x9f, 0xb5, 0x16, 0xc2, 0x1d, 0x57, 0xb6, 0xfb, 0x8e, 0xe2, 0x14,
-	0xac, 0xfe, 0x12, 0x68, 0x8c, 0x84, 0x38, 0x76, 0x13, 0x14, 0xa4, 0xd4, 0x28, 0x75, 0xcb, 0x7d,
-	0xcd, 0x6e, 0x5d, 0x46, 0x3f, 0x70, 0x64, 0x81, 0x82, 0xb4, 0x48, 0x03, 0x76, 0x14, 0xa8, 0x3e,
-	0x07, 0x75, 0x0f, 0xc7, 0x24, 0x72, 0x83, 0xd8, 0xc3, 0x1b, 0x4c, 0x8d, 0xb2, 0xe8, 0x78, 0x74,
-	0xb5, 0xe3, 0x35, 0xa7, 0x67, 0x1c, 0x2e, 0xda, 0x6a, 0xde, 0x49, 0xc1, 0x54, 0xf7, 0xc0, 0x3d,
-	0x91, 0x71, 0x91, 0xe7, 0xa5, 0x98, 0xd2, 0x53, 0x6f, 0x45, 0xf4, 0x0e, 0xae, 0xf6, 0x4e, 0x9d,
-	0x89, 0x3d, 0x7c, 0x25, 0x43, 0xe7, 0xf5, 0x77, 0x05, 0x7a, 0x6e, 0x60, 0xda, 0xfb, 0xae, 0x82,
-	0xaa, 0x1c, 0x88, 0xfe, 0x10, 0xd4, 0x70, 0x8c, 0x96, 0x9f, 0xb1, 0x2b, 0x40, 0x31, 0xbe, 0x5b,
-	0x8e, 0x26, 0xb5, 0x29, 0x97, 0xf4, 0xe7, 0xe0, 0xce, 0x11, 0xc9, 0x22, 0x77, 0x4d, 0x48, 0x68,
-	0x94, 0x38, 0x35, 0x6e, 0xe4, 0xfb, 0x4e, 0x7d, 0x2a, 0xc9, 0x8f, 0xef, 0xde, 0x12, 0x12, 0x3a,
-	0xf5, 0x22, 0x98, 0x45, 0xfc, 0xfa, 0xa2, 0xf5, 0xe3, 0xdf, 0xaf, 0x41, 0x53, 0x6e, 0x7c, 0x53,
-	0xec, 0xbc, 0xd8, 0xc4, 0x6c, 0x9b, 0x9b, 0xea, 0x2e, 0x37, 0xd5, 0xbf, 0xb9, 0xa9, 0xfe, 0x3c,
-	0x98, 0xca, 0xee, 0x60, 0x2a, 0x7f, 0x0e, 0xa6, 0xf2, 0x09, 0xfa, 0x01, 0x5b, 0x7f, 0x59, 0x5a,
-	0x2b, 0x12, 0xc1, 0x09, 0x8f, 0x3e, 0x9e, 0x63, 0xf6, 0x95, 0xa4, 0xa1, 0xbc, 0xc1, 0xec, 0xd9,
-	0xa9, 0x8b, 0x7d, 0x4b, 0x30, 0x5d, 0x56, 0xc5, 0x4f, 0xf1, 0xe4, 0x7f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf5, 0xb1, 0x42, 0x69, 0x89, 0x02, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0x6e, 0x29, 0xf4, 0x47, 0xa6, 0xf0, 0x33, 0x2c, 0x85, 0x6c, 0x51, 0x5b, 0xc4, 0x98, 0x10,
+	0x12, 0x76, 0x68, 0xf5, 0xa0, 0x9e, 0xa4, 0x2d, 0x51, 0x12, 0x24, 0xcd, 0x6a, 0x3c, 0x70, 0x70,
+	0x33, 0xed, 0x0c, 0xcb, 0xa4, 0xdd, 0x99, 0xcd, 0xce, 0xb4, 0xe2, 0xc5, 0x0f, 0xe0, 0xc9, 0xa3,
+	0x67, 0x8f, 0x9e, 0x3c, 0xfa, 0x11, 0x38, 0x72, 0xf4, 0x04, 0xa6, 0x1c, 0xfc, 0x1a, 0x66, 0xfe,
+	0x2c, 0x2e, 0x15, 0x0e, 0x5e, 0xda, 0x9d, 0xf7, 0x7d, 0x9e, 0xe7, 0x9d, 0x67, 0x66, 0xde, 0x17,
+	0xdc, 0xe9, 0x21, 0x26, 0x39, 0x24, 0x49, 0xaf, 0xb1, 0x05, 0x47, 0x75, 0x18, 0x12, 0x46, 0x04,
+	0x15, 0x5e, 0x9c, 0x70, 0xc9, 0x9d, 0xff, 0x75, 0xd6, 0xd3, 0x59, 0x6f, 0x54, 0x5f, 0x59, 0x99,
+	0x40, 0x9b, 0x84, 0xc6, 0xae, 0x94, 0x43, 0x1e, 0x72, 0xfd, 0x09, 0xd5, 0x97, 0x8d, 0x2e, 0xa0,
+	0x88, 0x32, 0x0e, 0xf5, 0xaf, 0x0d, 0x55, 0x7b, 0x5c, 0x44, 0x5c, 0xc0, 0x2e, 0x12, 0x04, 0x8e,
+	0xea, 0x5d, 0x22, 0x51, 0x1d, 0xf6, 0x38, 0x65, 0x69, 0x3e, 0xe4, 0x3c, 0x1c, 0x10, 0xa8, 0x57,
+	0xdd, 0xe1, 0x21, 0xc4, 0xc3, 0x04, 0x49, 0xca, 0x6d, 0x7e, 0xed, 0xcb, 0x0c, 0x98, 0x7b, 0x6e,
+	0xb6, 0xf9, 0x4a, 0x22, 0x49, 0x9c, 0x47, 0xa0, 0x18, 0xa3, 0x04, 0x45, 0xc2, 0xcd, 0xaf, 0xe6,
+	0xd7, 0x4b, 0x8d, 0x65, 0xef, 0xea, 0xb6, 0xbd, 0x8e, 0xce, 0x36, 0xa7, 0x4f, 0xce, 0x6a, 0x39,
+	0xdf, 0x62, 0x9d, 0x67, 0xa0, 0x24, 0x79, 0x9f, 0xb0, 0x20, 0x46, 0x34, 0x11, 0xee, 0xd4, 0x6a,
+	0x61, 0xbd, 0xd4, 0xa8, 0x4c, 0x52, 0x5f, 0x2b, 0x48, 0x07, 0xd1, 0xc4, 0xb2, 0x81, 0x4c, 0x03,
+	0xc2, 0xd9, 0x07, 0xf3, 0x98, 0x30, 0x1e, 0x05, 0x94, 0x61, 0x72, 0x4c, 0x84, 0x5b, 0xd0, 0x1a,
+	0xf7, 0x6f, 0xd4, 0x68, 0x2b, 0xf4, 0xae, 0x02, 0x5b, 0xb5, 0x39, 0x7c, 0x19, 0x21, 0xc2, 0xc1,
+	0x60, 0x49, 0x73, 0x02, 0x84, 0x71, 0x42, 0x84, 0xb8, 0xd4, 0x9d, 0xd6, 0xba, 0x1b, 0x37, 0xea,
+	0xee, 0xf8, 0xad, 0xc6, 0xd6, 0xb6, 0x21, 0x65, 0xe5, 0x17, 0x35, 0x34, 0x9b, 0x20, 0xda, 0x77,
+	0x82, 0x24, 0x09, 0x06, 0x34, 0xa2, 0x52, 0xb8, 0x33, 0xd7, 0xfb, 0xf6, 0x91, 0x24, 0x7b, 0x0a,
+	0x91, 0xfa, 0x4e, 0xd2, 0x80, 0x70, 0xde, 0x82, 0xa5, 0x98, 0x30, 0x4c, 0x59, 0x18, 0x24, 0x24,
+	0xa4, 0x42, 0x9a, 0xeb, 0x11, 0x6e, 0xf1, 0x7a, 0xff, 0x1d, 0x03, 0xf6, 0x33, 0x58, 0xab, 0x5a,
+	0x8e, 0xff, 0x4e, 0x09, 0xe7, 0x10, 0x54, 0xd0, 0x50, 0xf2, 0x00, 0x53, 0x81, 0xba, 0x03, 0x82,
+	0x83, 0xec, 0x3d, 0xfd, 0xa7, 0x6b, 0x3c, 0x98, 0xac, 0xb1, 0x3d, 0x94, 0xbc, 0x6d, 0xf1, 0x93,
+	0x77, 0xb6, 0x8c, 0xae, 0x4b, 0x0a, 0xe7, 0x00, 0x2c, 0x0e, 0x59, 0x42, 0x0e, 0x87, 0x0c, 0x13,
+	0x1c, 0x60, 0x12, 0x73, 0xa1, 0x4e, 0x64, 0xf6, 0x5f, 0x5d, 0x38, 0x7f, 0x54, 0xda, 0x56, 0x64,
+	0xed, 0x7b, 0x01, 0x14, 0xcd, 0xb3, 0x73, 0xee, 0x81, 0x39, 0xc2, 0x54, 0xed, 0x40, 0x6b, 0xe9,
+	0x47, 0x3a, 0xeb, 0x97, 0x4c, 0x6c, 0x47, 0x85, 0x9c, 0x27, 0xe0, 0x56, 0x0a, 0x19, 0x45, 0xc1,
+	0x11, 0xe7, 0x7d, 0x77, 0x4a, 0xa1, 0x9a, 0x0b, 0xe3, 0xb3, 0xda, 0xfc, 0x8e, 0x41, 0xbe, 0x79,
+	0xf9, 0x82, 0xf3, 0xbe, 0x3f, 0x6f, 0x89, 0xa3, 0x48, 0x2d, 0x9d, 0x3d, 0xb0, 0x66, 0xa9, 0x31,
+	0x49, 0x22, 0x2a, 0x04, 0xe5, 0x6c, 0xa0, 0x1e, 0x4f, 0xf6, 0x6a, 0xdc, 0x82, 0xae, 0xb9, 0x6a,
+	0x90, 0x9d, 0x2b, 0xc0, 0xac, 0x21, 0xe7, 0x03, 0x28, 0x67, 0x79, 0xe9, 0xa1, 0xd8, 0x17, 0x58,
+	0xf1, 0x4c, 0xeb, 0x7a, 0xaa, 0x75, 0x3d, 0xdb, 0xba, 0x5e, 0x8b, 0x53, 0xd6, 0xdc, 0x52, 0x27,
+	0xf1, 0xf5, 0xbc, 0xb6, 0x1e, 0x52, 0x79, 0x34, 0xec, 0x7a, 0x3d, 0x1e, 0x41, 0xdb, 0xe7, 0xe6,
+	0x6f, 0x53, 0xe0, 0x3e, 0x94, 0xef, 0x63, 0x22, 0x34, 0x41, 0xf8, 0x8b, 0xd9, 0x42, 0xf6, 0xdc,
+	0x9c, 0x10, 0xdc, 0xbd, 0x52, 0xbf, 0x77, 0x84, 0x06, 0x03, 0xc2, 0x42, 0xed, 0x8e, 0x72, 0xec,
+	0xce, 0xe8, 0x0e, 0xaf, 0x78, 0x66, 0x46, 0x78, 0xe9, 0x8c, 0xf0, 0xda, 0x76, 0x46, 0x34, 0x67,
+	0xd5, 0x46, 0x3e, 0x9f, 0xd7, 0xf2, 0xfe, 0xed, 0xac, 0x52, 0x2b, 0x15, 0xea, 0x68, 0x9d, 0xa7,
+	0x95, 0x8f, 0xbf, 0xbe, 0x6d, 0x94, 0xcd, 0x38, 0x3b, 0xb6, 0x03, 0xcd, 0x8e, 0x89, 0xdd, 0x93,
+	0x71, 0x35, 0x7f, 0x3a, 0xae, 0xe6, 0x7f, 0x8e, 0xab, 0xf9, 0x4f, 0x17, 0xd5, 0xdc, 0xe9, 0x45,
+	0x35, 0xf7, 0xe3, 0xa2, 0x9a, 0x3b, 0x80, 0x19, 0x73, 0x2d, 0x45, 0xdd, 0xdc, 0x27, 0xf2, 0x1d,
+	0x4f, 0xfa, 0x66, 0x05, 0x47, 0x8f, 0x2f, 0xb5, 0xb4, 0xd3, 0x6e, 0x51, 0xef, 0xef, 0xe1, 0xef,
+	0x01, 0x00, 0x05, 0xf3, 0xdf, 0xd6, 0x66, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +305,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnrefundedDeposits) > 0 {
+		for iNdEx := len(m.UnrefundedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnrefundedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AutoDisabledTokenPairs) > 0 {
+		for iNdEx := len(m.AutoDisabledTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoDisabledTokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingRegistrations) > 0 {
+		for iNdEx := len(m.PendingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Erc20AddressIndexes) > 0 {
 		for iNdEx := len(m.Erc20AddressIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -288,6 +436,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RegistrationChallengePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationChallengePeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnablePermissionlessRegistration {
+		i--
+		if m.EnablePermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -348,6 +528,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRegistrations) > 0 {
+		for _, e := range m.PendingRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoDisabledTokenPairs) > 0 {
+		for _, e := range m.AutoDisabledTokenPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnrefundedDeposits) > 0 {
+		for _, e := range m.UnrefundedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -363,6 +567,17 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if m.EnablePermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationChallengePeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -536,6 +751,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRegistrations = append(m.PendingRegistrations, PendingRegistration{})
+			if err := m.PendingRegistrations[len(m.PendingRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDisabledTokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoDisabledTokenPairs = append(m.AutoDisabledTokenPairs, AutoDisabledTokenPair{})
+			if err := m.AutoDisabledTokenPairs[len(m.AutoDisabledTokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrefundedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrefundedDeposits = append(m.UnrefundedDeposits, PendingRegistration{})
+			if err := m.UnrefundedDeposits[len(m.UnrefundedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -626,6 +977,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePermissionlessRegistration = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationChallengePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RegistrationChallengePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
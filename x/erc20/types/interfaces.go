@@ -14,6 +14,8 @@ import (
 
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -36,6 +38,7 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	HasSupply(ctx context.Context, denom string) bool
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetParams(ctx context.Context) banktypes.Params
 	SetParams(ctx context.Context, params banktypes.Params) error
@@ -53,6 +56,11 @@ type EVMKeeper interface {
 	EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
+// EpochsKeeper defines the expected epochs keeper interface used on erc20
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
+
 type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
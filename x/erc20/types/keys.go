@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -15,6 +18,10 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// MaxEVMQueryPageLimit is the maximum page size of the queries that read
+	// every token pair of the page through an EVM call
+	MaxEVMQueryPageLimit = 100
 )
 
 // ModuleAddress is the native module address for EVM
@@ -29,11 +36,27 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20Address
 	prefixTokenPairByDenom
+	prefixRateLimit
+	prefixPendingRegistration
+	prefixAutoDisabledTokenPair
+	prefixPendingRegistrationByTime
+	prefixUnrefundedDeposit
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair               = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20Address = []byte{prefixTokenPairByERC20Address}
-	KeyPrefixTokenPairByDenom        = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPair                 = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20Address   = []byte{prefixTokenPairByERC20Address}
+	KeyPrefixTokenPairByDenom          = []byte{prefixTokenPairByDenom}
+	KeyPrefixRateLimit                 = []byte{prefixRateLimit}
+	KeyPrefixPendingRegistration       = []byte{prefixPendingRegistration}
+	KeyPrefixAutoDisabledTokenPair     = []byte{prefixAutoDisabledTokenPair}
+	KeyPrefixPendingRegistrationByTime = []byte{prefixPendingRegistrationByTime}
+	KeyPrefixUnrefundedDeposit         = []byte{prefixUnrefundedDeposit}
 )
+
+// PendingRegistrationByTimeKey returns the key of a pending registration in
+// the index ordered by activation time, without the index prefix
+func PendingRegistrationByTimeKey(activationTime time.Time, contract common.Address) []byte {
+	return append(sdk.FormatTimeBytes(activationTime), contract.Bytes()...)
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	erc20v1 "github.com/TucanaProtocol/Tucana/v8/api/canto/erc20/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	ethermint "github.com/evmos/ethermint/types"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -16,6 +18,8 @@ import (
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgConvertCoins{}
+	_ sdk.Msg = &MsgConvertERC20S{}
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -43,22 +47,68 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr.Bytes()}
 }
 
+// GetSignersFromMsgConvertERC20V2 resolves the signer of a MsgConvertERC20
+// from its hex sender address. It is registered as a custom GetSigners
+// function so the message can be signed with EIP-712 and executed by authz.
 func GetSignersFromMsgConvertERC20V2(msg protov2.Message) ([][]byte, error) {
 	msgv2, ok := msg.(*erc20v1.MsgConvertERC20)
 	if !ok {
 		return nil, nil
 	}
 
-	msgv1 := MsgConvertERC20{
-		Sender: msgv2.Sender,
+	return getSignersFromHexSender(msgv2.Sender)
+}
+
+// NewMsgConvertCoins creates a new instance of MsgConvertCoins
+func NewMsgConvertCoins(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoins { // nolint: interfacer
+	return &MsgConvertCoins{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// NewMsgConvertERC20S creates a new instance of MsgConvertERC20S
+func NewMsgConvertERC20S(tokens []ERC20Amount, receiver sdk.AccAddress, sender common.Address) *MsgConvertERC20S { // nolint: interfacer
+	return &MsgConvertERC20S{
+		Tokens:   tokens,
+		Receiver: receiver.String(),
+		Sender:   sender.Hex(),
+	}
+}
+
+// NewERC20Amount creates a new instance of ERC20Amount
+func NewERC20Amount(contract common.Address, amount sdkmath.Int) ERC20Amount {
+	return ERC20Amount{
+		ContractAddress: contract.String(),
+		Amount:          amount,
 	}
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20S) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// GetSignersFromMsgConvertERC20sV2 resolves the signer of a MsgConvertERC20s
+// from its hex sender address
+func GetSignersFromMsgConvertERC20sV2(msg protov2.Message) ([][]byte, error) {
+	msgv2, ok := msg.(*erc20v1.MsgConvertERC20S)
+	if !ok {
+		return nil, nil
+	}
+
+	return getSignersFromHexSender(msgv2.Sender)
+}
 
-	signers := [][]byte{}
-	for _, signer := range msgv1.GetSigners() {
-		signers = append(signers, signer.Bytes())
+// getSignersFromHexSender returns the account bytes of a hex sender address
+func getSignersFromHexSender(sender string) ([][]byte, error) {
+	if !common.IsHexAddress(sender) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", sender)
 	}
 
-	return signers, nil
+	return [][]byte{common.HexToAddress(sender).Bytes()}, nil
 }
 
 // ValidateErc20Denom checks if a denom is a valid erc20/
@@ -2,14 +2,33 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
-	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyEnableErc20                      = []byte("EnableErc20")
+	ParamStoreKeyEnableEVMHook                    = []byte("EnableEVMHook")
+	ParamStoreKeyEnablePermissionlessRegistration = []byte("EnablePermissionlessRegistration")
+	ParamStoreKeyRegistrationDeposit              = []byte("RegistrationDeposit")
+	ParamStoreKeyRegistrationChallengePeriod      = []byte("RegistrationChallengePeriod")
+)
+
+var (
+	// DefaultRegistrationDepositDenom is the default denom of the deposit
+	// escrowed to register an ERC20 token without a governance proposal
+	DefaultRegistrationDepositDenom = "atuc"
+	// DefaultRegistrationDepositAmount is the default amount of the deposit
+	// escrowed to register an ERC20 token without a governance proposal
+	// (1000 tokens of 18 decimals)
+	DefaultRegistrationDepositAmount = sdkmath.NewIntWithDecimal(1000, 18)
+	// DefaultRegistrationChallengePeriod is the default period during which
+	// governance can disable a permissionless registration
+	DefaultRegistrationChallengePeriod = 7 * 24 * time.Hour
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -23,17 +42,32 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	enablePermissionlessRegistration bool,
+	registrationDeposit sdk.Coins,
+	registrationChallengePeriod time.Duration,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:                      enableErc20,
+		EnableEVMHook:                    enableEVMHook,
+		EnablePermissionlessRegistration: enablePermissionlessRegistration,
+		RegistrationDeposit:              registrationDeposit,
+		RegistrationChallengePeriod:      registrationChallengePeriod,
 	}
 }
 
+// DefaultRegistrationDeposit returns the default registration deposit in the
+// given denom
+func DefaultRegistrationDeposit(denom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denom, DefaultRegistrationDepositAmount))
+}
+
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:                      true,
+		EnableEVMHook:                    true,
+		EnablePermissionlessRegistration: false,
+		RegistrationDeposit:              DefaultRegistrationDeposit(DefaultRegistrationDepositDenom),
+		RegistrationChallengePeriod:      DefaultRegistrationChallengePeriod,
 	}
 }
 
@@ -46,12 +80,43 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateRegistrationDeposit(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateRegistrationChallengePeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if period < 0 {
+		return fmt.Errorf("registration challenge period cannot be negative: %s", period)
+	}
+
+	return nil
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEVMHook, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnablePermissionlessRegistration, &p.EnablePermissionlessRegistration, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateRegistrationDeposit),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationChallengePeriod, &p.RegistrationChallengePeriod, validateRegistrationChallengePeriod),
 	}
 }
 
-func (p Params) Validate() error { return nil }
+func (p Params) Validate() error {
+	if err := validateRegistrationDeposit(p.RegistrationDeposit); err != nil {
+		return err
+	}
+
+	return validateRegistrationChallengePeriod(p.RegistrationChallengePeriod)
+}
@@ -2,7 +2,10 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -28,7 +31,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, true),
+			NewParams(true, true, true, DefaultRegistrationDeposit(sdk.DefaultBondDenom), time.Hour),
 			false,
 		},
 		{
@@ -36,6 +39,16 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			Params{},
 			false,
 		},
+		{
+			"invalid registration deposit",
+			Params{RegistrationDeposit: sdk.Coins{{Denom: "atuc", Amount: sdkmath.NewInt(-1)}}},
+			true,
+		},
+		{
+			"negative registration challenge period",
+			Params{RegistrationChallengePeriod: -time.Hour},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
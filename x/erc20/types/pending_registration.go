@@ -0,0 +1,37 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewPendingRegistration returns an instance of PendingRegistration
+func NewPendingRegistration(erc20Address common.Address, depositor sdk.AccAddress, deposit sdk.Coins, activationTime time.Time) PendingRegistration {
+	return PendingRegistration{
+		Erc20Address:   erc20Address.String(),
+		Depositor:      depositor.String(),
+		Deposit:        deposit,
+		ActivationTime: activationTime,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (pr PendingRegistration) GetERC20Contract() common.Address {
+	return common.HexToAddress(pr.Erc20Address)
+}
+
+// Validate performs a stateless validation of a PendingRegistration
+func (pr PendingRegistration) Validate() error {
+	if err := ethermint.ValidateAddress(pr.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(pr.Depositor); err != nil {
+		return err
+	}
+
+	return pr.Deposit.Validate()
+}
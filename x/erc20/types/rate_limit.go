@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+)
+
+// NewRateLimit returns an instance of RateLimit with zero converted amounts. A
+// nil maximum leaves the conversion direction unlimited, a zero maximum blocks
+// it.
+func NewRateLimit(denom, epochIdentifier string, maxERC20ToCoin, maxCoinToERC20 *sdkmath.Int) RateLimit {
+	return RateLimit{
+		Denom:                denom,
+		EpochIdentifier:      epochIdentifier,
+		MaxErc20ToCoin:       maxERC20ToCoin,
+		MaxCoinToErc20:       maxCoinToERC20,
+		Erc20ToCoinConverted: sdkmath.ZeroInt(),
+		CoinToErc20Converted: sdkmath.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(rl.EpochIdentifier); err != nil {
+		return err
+	}
+
+	for _, max := range []*sdkmath.Int{rl.MaxErc20ToCoin, rl.MaxCoinToErc20} {
+		if max != nil && (max.IsNil() || max.IsNegative()) {
+			return fmt.Errorf("rate limit maximum cannot be negative: %s", max)
+		}
+	}
+
+	for _, amt := range []sdkmath.Int{rl.Erc20ToCoinConverted, rl.CoinToErc20Converted} {
+		if amt.IsNil() || amt.IsNegative() {
+			return fmt.Errorf("rate limit amounts cannot be nil or negative: %s", amt)
+		}
+	}
+
+	return nil
+}
+
+// RemainingERC20ToCoin returns the amount of ERC20 tokens that can still be
+// converted to Cosmos coins in the current epoch, or nil if the direction is
+// unlimited
+func (rl RateLimit) RemainingERC20ToCoin() *sdkmath.Int {
+	return remaining(rl.MaxErc20ToCoin, rl.Erc20ToCoinConverted)
+}
+
+// RemainingCoinToERC20 returns the amount of Cosmos coins that can still be
+// converted to ERC20 tokens in the current epoch, or nil if the direction is
+// unlimited
+func (rl RateLimit) RemainingCoinToERC20() *sdkmath.Int {
+	return remaining(rl.MaxCoinToErc20, rl.CoinToErc20Converted)
+}
+
+// AddERC20ToCoin records an ERC20 -> Cosmos coin conversion. It returns an
+// error if the conversion exceeds the limit of the current epoch.
+func (rl *RateLimit) AddERC20ToCoin(amount sdkmath.Int) error {
+	converted := rl.Erc20ToCoinConverted.Add(amount)
+	if rl.MaxErc20ToCoin != nil && converted.GT(*rl.MaxErc20ToCoin) {
+		return errorsmod.Wrapf(
+			ErrRateLimitExceeded,
+			"ERC20 -> coin conversion of %s%s exceeds the remaining quota %s",
+			amount, rl.Denom, *rl.RemainingERC20ToCoin(),
+		)
+	}
+
+	rl.Erc20ToCoinConverted = converted
+	return nil
+}
+
+// AddCoinToERC20 records a Cosmos coin -> ERC20 conversion. It returns an
+// error if the conversion exceeds the limit of the current epoch.
+func (rl *RateLimit) AddCoinToERC20(amount sdkmath.Int) error {
+	converted := rl.CoinToErc20Converted.Add(amount)
+	if rl.MaxCoinToErc20 != nil && converted.GT(*rl.MaxCoinToErc20) {
+		return errorsmod.Wrapf(
+			ErrRateLimitExceeded,
+			"coin -> ERC20 conversion of %s%s exceeds the remaining quota %s",
+			amount, rl.Denom, *rl.RemainingCoinToERC20(),
+		)
+	}
+
+	rl.CoinToErc20Converted = converted
+	return nil
+}
+
+// ResetConverted sets the converted amounts of the current epoch to zero
+func (rl *RateLimit) ResetConverted() {
+	rl.Erc20ToCoinConverted = sdkmath.ZeroInt()
+	rl.CoinToErc20Converted = sdkmath.ZeroInt()
+}
+
+// remaining returns the remaining quota for a maximum, floored at zero. A nil
+// maximum is unlimited and therefore has no remaining quota to report.
+func remaining(max *sdkmath.Int, converted sdkmath.Int) *sdkmath.Int {
+	if max == nil {
+		return nil
+	}
+
+	quota := sdkmath.ZeroInt()
+	if converted.LT(*max) {
+		quota = max.Sub(converted)
+	}
+	return &quota
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// newMax returns a pointer to a rate limit maximum
+func newMax(amount int64) *sdkmath.Int {
+	max := sdkmath.NewInt(amount)
+	return &max
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitValidate() {
+	testCases := []struct {
+		msg        string
+		rateLimit  RateLimit
+		expectPass bool
+	}{
+		{"pass", NewRateLimit("test", "day", newMax(100), nil), true},
+		{"pass - blocked direction", NewRateLimit("test", "day", newMax(0), nil), true},
+		{"invalid denom", NewRateLimit("1test", "day", newMax(100), nil), false},
+		{"empty epoch identifier", NewRateLimit("test", "", newMax(100), nil), false},
+		{"negative maximum", NewRateLimit("test", "day", newMax(-1), nil), false},
+		{"nil converted amount", RateLimit{Denom: "test", EpochIdentifier: "day", MaxErc20ToCoin: newMax(1), MaxCoinToErc20: newMax(1)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rateLimit.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitQuota() {
+	rl := NewRateLimit("test", "day", newMax(100), nil)
+
+	suite.Require().NoError(rl.AddERC20ToCoin(sdkmath.NewInt(60)))
+	suite.Require().Equal(sdkmath.NewInt(40), *rl.RemainingERC20ToCoin())
+
+	suite.Require().ErrorIs(rl.AddERC20ToCoin(sdkmath.NewInt(41)), ErrRateLimitExceeded)
+	suite.Require().Equal(sdkmath.NewInt(60), rl.Erc20ToCoinConverted)
+
+	suite.Require().NoError(rl.AddERC20ToCoin(sdkmath.NewInt(40)))
+	suite.Require().True(rl.RemainingERC20ToCoin().IsZero())
+
+	// an unset maximum leaves the direction unlimited
+	suite.Require().NoError(rl.AddCoinToERC20(sdkmath.NewInt(1_000_000)))
+	suite.Require().Nil(rl.RemainingCoinToERC20())
+
+	rl.ResetConverted()
+	suite.Require().True(rl.Erc20ToCoinConverted.IsZero())
+	suite.Require().True(rl.CoinToErc20Converted.IsZero())
+	suite.Require().Equal(sdkmath.NewInt(100), *rl.RemainingERC20ToCoin())
+
+	// a zero maximum blocks the direction
+	blocked := NewRateLimit("test", "day", newMax(0), nil)
+	suite.Require().ErrorIs(blocked.AddERC20ToCoin(sdkmath.OneInt()), ErrRateLimitExceeded)
+	suite.Require().True(blocked.RemainingERC20ToCoin().IsZero())
+}
@@ -2,7 +2,6 @@ package cli
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -23,7 +22,9 @@ import (
 var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
-	FlagAuthority = "authority"
+	FlagAuthority      = "authority"
+	FlagExecutionMode  = "execution-mode"
+	FlagConvertToErc20 = "convert-to-erc20"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/govshuttle transaction commands.
@@ -125,9 +126,13 @@ func NewTreasuryProposalCmd(ac addresscodec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit a proposal to the Canto Treasury",
 		Long: `Submit a proposal for the Canto Treasury along with an initial deposit.
-Upon passing, the
+Upon passing, the proposal is either stored in the Port contract (execution mode
+"port", default) or spent from the community pool to the recipient (execution mode
+"community-pool"). The Port contract only supports the "canto" and "note" denoms. In
+community-pool mode the denom must be registered in the bank metadata and may be the
+base denom or one of its denom units, in which the amount is interpreted.
 The proposal details must be supplied via a JSON file.`,
-		Example: fmt.Sprintf(`$ %s tx gov submit-proposal treasury-proposal <path/to/metadata.json> --from=<key_or_address> --title=<title> --description=<description>
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal treasury-proposal <path/to/metadata.json> --from=<key_or_address> --title=<title> --description=<description> --execution-mode=community-pool --convert-to-erc20
 
 Where metadata.json contains (example):
 
@@ -135,7 +140,7 @@ Where metadata.json contains (example):
 	"recipient": "0xfffffff...",
 	"PropID":  1,
 	"amount": 1,
-	"denom": "canto/note"
+	"denom": "canto"
 }`, version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -154,12 +159,26 @@ Where metadata.json contains (example):
 				return errorsmod.Wrap(err, "Failure to parse JSON object")
 			}
 
+			modeStr, _ := cmd.Flags().GetString(FlagExecutionMode)
+			executionMode, err := ParseTreasuryExecutionMode(modeStr)
+			if err != nil {
+				return err
+			}
+
 			// validate basic logic
-			s := strings.ToLower(propMetaData.GetDenom())
-			if s != "canto" && s != "note" {
+			if executionMode == types.TREASURY_EXECUTION_MODE_PORT {
+				if err := types.ValidatePortTreasuryDenom(propMetaData.GetDenom()); err != nil {
+					return err
+				}
+			} else if err := sdk.ValidateDenom(propMetaData.GetDenom()); err != nil {
 				return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "%s is not a valid denom string", propMetaData.GetDenom())
 			}
 
+			convertToErc20, _ := cmd.Flags().GetBool(FlagConvertToErc20)
+			if err := types.ValidateTreasuryExecution(executionMode, convertToErc20, propMetaData.GetRecipient()); err != nil {
+				return err
+			}
+
 			authority, _ := cmd.Flags().GetString(FlagAuthority)
 			if authority != "" {
 				if _, err = ac.StringToBytes(authority); err != nil {
@@ -171,10 +190,12 @@ Where metadata.json contains (example):
 
 			if err := proposal.SetMsgs([]sdk.Msg{
 				&types.MsgTreasuryProposal{
-					Authority:   authority,
-					Title:       proposal.Title,
-					Description: proposal.Summary,
-					Metadata:    &propMetaData,
+					Authority:      authority,
+					Title:          proposal.Title,
+					Description:    proposal.Summary,
+					Metadata:       &propMetaData,
+					ExecutionMode:  executionMode,
+					ConvertToErc20: convertToErc20,
 				},
 			}); err != nil {
 				return fmt.Errorf("failed to create submit treasury proposal message: %w", err)
@@ -183,6 +204,8 @@ Where metadata.json contains (example):
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
 		},
 	}
+	cmd.Flags().String(FlagExecutionMode, "port", "how the proposal is executed once passed (port|community-pool)")
+	cmd.Flags().Bool(FlagConvertToErc20, false, "convert the spent coins to ERC20 tokens, requires a hex recipient and community-pool execution mode")
 	flags.AddTxFlagsToCmd(cmd)
 	AddGovPropFlagsToCmd(cmd)
 
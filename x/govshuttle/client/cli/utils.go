@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -37,6 +38,22 @@ func ParseLendingMarketMetadata(cdc codec.JSONCodec, metadataFile string) (types
 	return propMetaData, nil
 }
 
+// ParseTreasuryExecutionMode parses the execution mode of a treasury proposal
+// given either as "port"/"community-pool" or as the enum name.
+func ParseTreasuryExecutionMode(mode string) (types.TreasuryExecutionMode, error) {
+	switch strings.ToLower(mode) {
+	case "", "port":
+		return types.TREASURY_EXECUTION_MODE_PORT, nil
+	case "community-pool", "community_pool":
+		return types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, nil
+	}
+
+	if value, ok := types.TreasuryExecutionMode_value[strings.ToUpper(mode)]; ok {
+		return types.TreasuryExecutionMode(value), nil
+	}
+	return types.TREASURY_EXECUTION_MODE_PORT, fmt.Errorf("invalid treasury execution mode %s", mode)
+}
+
 func ParseTreasuryMetadata(cdc codec.JSONCodec, metadataFile string) (types.TreasuryProposalMetadata, error) {
 	propMetaData := types.TreasuryProposalMetadata{}
 
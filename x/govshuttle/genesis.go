@@ -20,6 +20,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper authkeeper.Acco
 		k.SetPort(ctx, portAddr)
 	}
 
+	for _, propID := range genState.ProposalIds {
+		k.SetProposalID(ctx, propID)
+	}
+
 	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
 		panic("the govshuttle module account has not been set")
 	}
@@ -34,6 +38,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.PortContractAddr = portAddr.String()
 	}
 
+	genesis.ProposalIds = k.GetProposalIDs(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+)
+
+// Port returns the address of the ProposalStore (Port) contract
+func (k Keeper) Port(c context.Context, req *types.QueryPortRequest) (*types.QueryPortResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portAddr, found := k.GetPort(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "port contract has not been deployed")
+	}
+
+	return &types.QueryPortResponse{PortAddress: portAddr.Hex()}, nil
+}
+
+// Proposal returns a proposal stored in the Port contract
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portAddr, found := k.GetPort(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "port contract has not been deployed")
+	}
+
+	proposal, found, err := k.QueryPortProposal(ctx, portAddr, req.PropId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d not found in port contract", req.PropId)
+	}
+
+	return &types.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+// Proposals returns the proposals stored in the Port contract
+func (k Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// every proposal is read through an EVM call, so bound the page size
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxProposalsPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination limit %d exceeds the maximum of %d", req.Pagination.Limit, types.MaxProposalsPageLimit)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portAddr, found := k.GetPort(ctx)
+	if !found {
+		return &types.QueryProposalsResponse{}, nil
+	}
+
+	var proposals []types.PortProposal
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ProposalKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		proposal, found, err := k.QueryPortProposal(ctx, portAddr, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		if found {
+			proposals = append(proposals, proposal)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
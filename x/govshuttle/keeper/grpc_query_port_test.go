@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/keeper"
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+)
+
+func (suite *KeeperTestSuite) appendLendingMarketProposal(propID uint64, title string) *types.MsgLendingMarketProposal {
+	msg := &types.MsgLendingMarketProposal{
+		Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Title:       title,
+		Description: title + " description",
+		Metadata: &types.LendingMarketMetadata{
+			Account:    []string{"0x20F72265e2225837fd77C692e0781f720B93eF89", "0xf6Db2570A2417188a5788D6d5Fd9faAa5B1fE555"},
+			PropId:     propID,
+			Values:     []uint64{1234, 5678},
+			Calldatas:  []string{hex.EncodeToString([]byte("calldata1")), hex.EncodeToString([]byte("calldata2"))},
+			Signatures: []string{"sig1", "sig2"},
+		},
+	}
+
+	_, err := keeper.NewMsgServerImpl(suite.app.GovshuttleKeeper).LendingMarketProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	return msg
+}
+
+func (suite *KeeperTestSuite) expectedPortProposal(msg *types.MsgLendingMarketProposal) types.PortProposal {
+	accounts := []string{}
+	for _, account := range msg.Metadata.Account {
+		accounts = append(accounts, common.HexToAddress(account).Hex())
+	}
+
+	values := []sdkmath.Int{}
+	for _, value := range msg.Metadata.Values {
+		values = append(values, sdkmath.NewIntFromUint64(value))
+	}
+
+	return types.PortProposal{
+		PropId:      msg.Metadata.PropId,
+		Title:       msg.Title,
+		Description: msg.Description,
+		Accounts:    accounts,
+		Values:      values,
+		Calldatas:   msg.Metadata.Calldatas,
+		Signatures:  msg.Metadata.Signatures,
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPortAndProposals() {
+	suite.SetupTest()
+	k := suite.app.GovshuttleKeeper
+
+	// nothing stored before the port is deployed
+	_, err := k.Port(suite.ctx, &types.QueryPortRequest{})
+	suite.Require().Error(err)
+	_, err = k.Proposal(suite.ctx, &types.QueryProposalRequest{PropId: 1})
+	suite.Require().Error(err)
+	proposalsRes, err := k.Proposals(suite.ctx, &types.QueryProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(proposalsRes.Proposals)
+
+	msg1 := suite.appendLendingMarketProposal(1, "first proposal")
+	msg2 := suite.appendLendingMarketProposal(2, "second proposal")
+
+	portAddr, found := k.GetPort(suite.ctx)
+	suite.Require().True(found)
+
+	portRes, err := k.Port(suite.ctx, &types.QueryPortRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(portAddr.Hex(), portRes.PortAddress)
+
+	proposalRes, err := k.Proposal(suite.ctx, &types.QueryProposalRequest{PropId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.expectedPortProposal(msg2), proposalRes.Proposal)
+
+	_, err = k.Proposal(suite.ctx, &types.QueryProposalRequest{PropId: 3})
+	suite.Require().Error(err)
+
+	proposalsRes, err = k.Proposals(suite.ctx, &types.QueryProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PortProposal{
+		suite.expectedPortProposal(msg1),
+		suite.expectedPortProposal(msg2),
+	}, proposalsRes.Proposals)
+
+	proposalsRes, err = k.Proposals(suite.ctx, &types.QueryProposalsRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PortProposal{suite.expectedPortProposal(msg2)}, proposalsRes.Proposals)
+	suite.Require().NotNil(proposalsRes.Pagination.NextKey)
+
+	_, err = k.Proposals(suite.ctx, &types.QueryProposalsRequest{
+		Pagination: &query.PageRequest{Limit: types.MaxProposalsPageLimit + 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestIndexPortProposals() {
+	suite.SetupTest()
+	k := suite.app.GovshuttleKeeper
+
+	// no port contract to index
+	suite.Require().NoError(k.IndexPortProposals(suite.ctx))
+	suite.Require().Empty(k.GetProposalIDs(suite.ctx))
+
+	suite.appendLendingMarketProposal(1, "first proposal")
+	suite.appendLendingMarketProposal(3, "third proposal")
+	suite.Require().Equal([]uint64{1, 3}, k.GetProposalIDs(suite.ctx))
+
+	// drop the index as stored before it was introduced
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.GetProposalKey(1))
+	store.Delete(types.GetProposalKey(3))
+	suite.Require().Empty(k.GetProposalIDs(suite.ctx))
+
+	err := suite.app.GovKeeper.ProposalID.Set(suite.ctx, 5)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate2to3(suite.ctx))
+	suite.Require().Equal([]uint64{1, 3}, k.GetProposalIDs(suite.ctx))
+}
@@ -24,6 +24,8 @@ type (
 
 		accKeeper   types.AccountKeeper
 		erc20Keeper types.ERC20Keeper
+		bankKeeper  types.BankKeeper
+		distrKeeper types.DistributionKeeper
 		govKeeper   *govkeeper.Keeper
 		authority   string
 	}
@@ -35,6 +37,8 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	ek types.ERC20Keeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	gk *govkeeper.Keeper,
 	authority string,
 
@@ -50,6 +54,8 @@ func NewKeeper(
 		paramstore:   ps,
 		accKeeper:    ak,
 		erc20Keeper:  ek,
+		bankKeeper:   bk,
+		distrKeeper:  dk,
 		govKeeper:    gk,
 		authority:    authority,
 	}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.MigrationHandler = Migrator{}.Migrate2to3
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate2to3 indexes the ids of the proposals already stored in the Port
+// contract
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.IndexPortProposals(ctx)
+}
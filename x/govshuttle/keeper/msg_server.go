@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,15 +50,28 @@ func (k Keeper) TreasuryProposal(ctx context.Context, req *types.MsgTreasuryProp
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
-	// validate basic logic
-	s := strings.ToLower(req.Metadata.GetDenom())
-	if s != "canto" && s != "note" {
-		return nil, errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "%s is not a valid denom string", req.Metadata.GetDenom())
+	if err := types.ValidateTreasuryExecution(req.ExecutionMode, req.ConvertToErc20, req.Metadata.GetRecipient()); err != nil {
+		return nil, err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	_, err := k.AppendLendingMarketProposal(sdkCtx, req.FromTreasuryToLendingMarket())
-	if err != nil {
+	if req.ExecutionMode == types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL {
+		coin, err := k.ResolveTreasuryCoin(sdkCtx, req.Metadata.GetDenom(), req.Metadata.GetAmount())
+		if err != nil {
+			return nil, err
+		}
+		if err := k.SpendTreasuryFromCommunityPool(sdkCtx, req.Metadata.GetRecipient(), coin, req.ConvertToErc20); err != nil {
+			return nil, err
+		}
+		return &types.MsgTreasuryProposalResponse{}, nil
+	}
+
+	// the Port contract only supports its own set of denoms
+	if err := types.ValidatePortTreasuryDenom(req.Metadata.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.AppendLendingMarketProposal(sdkCtx, req.FromTreasuryToLendingMarket()); err != nil {
 		return nil, err
 	}
 
@@ -230,6 +230,44 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 			false,
 			false,
 		},
+		{
+			"ok - MsgTreasuryProposal - note",
+			&govshuttletypes.MsgTreasuryProposal{
+				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Title:       "treasury proposal test",
+				Description: "treasury proposal test description",
+				Metadata: &govshuttletypes.TreasuryProposalMetadata{
+					PropID:    0,
+					Recipient: "0x20F72265e2225837fd77C692e0781f720B93eF89",
+					Amount:    1234,
+					Denom:     "note",
+				},
+			},
+			func(propId uint64, msg sdk.Msg) {
+				proposalMsg, ok := msg.(*govshuttletypes.MsgTreasuryProposal)
+				suite.Require().True(ok)
+
+				targets := []common.Address{common.HexToAddress(proposalMsg.Metadata.Recipient)}
+				values := []*big.Int{big.NewInt(int64(proposalMsg.Metadata.Amount))}
+				signatures := []string{proposalMsg.Metadata.Denom}
+				calldatas := [][]byte{}
+
+				suite.checkQueryPropResult(
+					propId,
+					ProposalResult{
+						Id:         big.NewInt(int64(propId)),
+						Title:      proposalMsg.Title,
+						Desc:       proposalMsg.Description,
+						Targets:    targets,
+						Values:     values,
+						Signatures: signatures,
+						Calldatas:  calldatas,
+					},
+				)
+			},
+			false,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+)
+
+// storedProposal mirrors the Proposal struct of the ProposalStore contract
+type storedProposal struct {
+	Id         *big.Int
+	Title      string
+	Desc       string
+	Targets    []common.Address
+	Values     []*big.Int
+	Signatures []string
+	Calldatas  [][]byte
+}
+
+// SetProposalID records the id of a proposal stored in the Port contract
+func (k Keeper) SetProposalID(ctx sdk.Context, propID uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetProposalKey(propID), []byte{0x01})
+}
+
+// HasProposalID returns true if a proposal with the given id was stored in the
+// Port contract
+func (k Keeper) HasProposalID(ctx sdk.Context, propID uint64) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.GetProposalKey(propID))
+}
+
+// GetProposalIDs returns the ids of the proposals stored in the Port contract
+func (k Keeper) GetProposalIDs(ctx sdk.Context) []uint64 {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ProposalKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	propIDs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		propIDs = append(propIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return propIDs
+}
+
+// QueryPortProposal reads a proposal from the Port contract with a static EVM
+// call. It returns false if the contract doesn't store the proposal.
+func (k Keeper) QueryPortProposal(ctx sdk.Context, portAddr common.Address, propID uint64) (types.PortProposal, bool, error) {
+	abi := contracts.ProposalStoreContract.ABI
+
+	res, err := k.erc20Keeper.CallEVM(ctx, abi, types.ModuleAddress, portAddr, false,
+		"QueryProp", sdkmath.NewIntFromUint64(propID).BigInt())
+	if err != nil {
+		return types.PortProposal{}, false, err
+	}
+
+	var unpacked struct {
+		Proposal storedProposal
+	}
+	if err := abi.UnpackIntoInterface(&unpacked, "QueryProp", res.Ret); err != nil {
+		return types.PortProposal{}, false, errorsmod.Wrapf(
+			erc20types.ErrABIUnpack, "failed to unpack proposal: %s", err.Error(),
+		)
+	}
+
+	prop := unpacked.Proposal
+	// the contract returns an empty proposal for unknown ids
+	if propID == 0 || prop.Id == nil || !prop.Id.IsUint64() || prop.Id.Uint64() != propID {
+		return types.PortProposal{}, false, nil
+	}
+
+	return toPortProposal(prop), true, nil
+}
+
+// toPortProposal converts a proposal of the ProposalStore contract into its
+// proto representation
+func toPortProposal(prop storedProposal) types.PortProposal {
+	accounts := make([]string, len(prop.Targets))
+	for i, target := range prop.Targets {
+		accounts[i] = target.Hex()
+	}
+
+	values := make([]sdkmath.Int, len(prop.Values))
+	for i, value := range prop.Values {
+		values[i] = sdkmath.NewIntFromBigInt(value)
+	}
+
+	calldatas := make([]string, len(prop.Calldatas))
+	for i, calldata := range prop.Calldatas {
+		calldatas[i] = hex.EncodeToString(calldata)
+	}
+
+	return types.PortProposal{
+		PropId:      prop.Id.Uint64(),
+		Title:       prop.Title,
+		Description: prop.Desc,
+		Accounts:    accounts,
+		Values:      values,
+		Calldatas:   calldatas,
+		Signatures:  prop.Signatures,
+	}
+}
+
+// IndexPortProposals records the ids of the proposals stored in the Port
+// contract before the ids were indexed. The contract can't enumerate its
+// proposals, so the ids are looked up up to the next gov proposal id, which
+// bounds the ids assigned to the proposals by default.
+func (k Keeper) IndexPortProposals(ctx sdk.Context) error {
+	portAddr, found := k.GetPort(ctx)
+	if !found {
+		return nil
+	}
+
+	nextPropID, err := k.govKeeper.ProposalID.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	for propID := uint64(1); propID <= nextPropID; propID++ {
+		_, found, err := k.QueryPortProposal(ctx, portAddr, propID)
+		if err != nil {
+			return err
+		}
+		if found {
+			k.SetProposalID(ctx, propID)
+		}
+	}
+
+	return nil
+}
@@ -47,6 +47,9 @@ func (k *Keeper) AppendLendingMarketProposal(ctx sdk.Context, lm *types.MsgLendi
 		return nil, errorsmod.Wrap(err, "Error in EVM Call")
 	}
 
+	// index the proposal id, the Port contract can't enumerate its proposals
+	k.SetProposalID(ctx, m.GetPropId())
+
 	return lm, nil
 }
 
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+)
+
+// ResolveTreasuryCoin resolves the denom of a community pool treasury proposal
+// against the denom metadata registered in x/bank. The denom is looked up as a
+// base denom first, otherwise it must exactly match a single denom unit or
+// alias, in which case the amount is interpreted in that unit. The returned
+// coin is expressed in the base denom.
+func (k Keeper) ResolveTreasuryCoin(ctx sdk.Context, denom string, amount uint64) (sdk.Coin, error) {
+	base, exponent := denom, uint32(0)
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		var matches []string
+
+		k.bankKeeper.IterateAllDenomMetaData(ctx, func(metadata banktypes.Metadata) bool {
+			if exp, ok := matchDenomUnit(metadata, denom); ok {
+				base, exponent = metadata.Base, exp
+				matches = append(matches, metadata.Base)
+			}
+			return false
+		})
+
+		if len(matches) == 0 {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidTreasuryDenom, "no bank metadata registered for %s", denom)
+		}
+		if len(matches) > 1 {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidTreasuryDenom, "%s is ambiguous, it is a unit of %s", denom, strings.Join(matches, ", "))
+		}
+	}
+
+	baseAmount := sdkmath.NewIntFromUint64(amount).Mul(sdkmath.NewIntWithDecimal(1, int(exponent)))
+	return sdk.NewCoin(base, baseAmount), nil
+}
+
+// matchDenomUnit returns the exponent of the unit of the metadata exactly
+// matching the given denom.
+func matchDenomUnit(metadata banktypes.Metadata, denom string) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// SpendTreasuryFromCommunityPool sends the coin from the distribution community
+// pool to the recipient, optionally converting it to its ERC20 representation
+// when the recipient is a hex address.
+func (k Keeper) SpendTreasuryFromCommunityPool(ctx sdk.Context, recipient string, coin sdk.Coin, convertToErc20 bool) error {
+	if !coin.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidTreasuryDenom, "cannot spend a non-positive amount %s", coin)
+	}
+
+	if err := types.ValidateTreasuryExecution(types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, convertToErc20, recipient); err != nil {
+		return err
+	}
+
+	// error checked during validation
+	recipientAddr, _ := types.ParseTreasuryRecipient(recipient)
+
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(coin), recipientAddr); err != nil {
+		return err
+	}
+
+	if convertToErc20 {
+		if _, err := k.erc20Keeper.ConvertCoin(ctx, &erc20types.MsgConvertCoin{
+			Coin:     coin,
+			Receiver: common.HexToAddress(recipient).Hex(),
+			Sender:   recipientAddr.String(),
+		}); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert %s to erc20", coin)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTreasurySpend,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyConvertToErc20, strconv.FormatBool(convertToErc20)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	"github.com/TucanaProtocol/Tucana/v8/testutil"
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+	inflationtypes "github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+const treasuryTokenBase = "acoin"
+
+func (suite *KeeperTestSuite) setupTreasuryMetadata() banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: "description of the token",
+		Base:        treasuryTokenBase,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    treasuryTokenBase,
+				Exponent: 0,
+			},
+			{
+				Denom:    "coin",
+				Exponent: 18,
+				Aliases:  []string{"wholecoin"},
+			},
+		},
+		Name:    treasuryTokenBase,
+		Symbol:  "COIN",
+		Display: "coin",
+	}
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+	return metadata
+}
+
+func (suite *KeeperTestSuite) fundCommunityPool(coins sdk.Coins) {
+	depositor := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, depositor, coins))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, coins, depositor))
+}
+
+func (suite *KeeperTestSuite) TestResolveTreasuryCoin() {
+	testCases := []struct {
+		name     string
+		denom    string
+		amount   uint64
+		expCoin  sdk.Coin
+		expError bool
+	}{
+		{"ok - base denom", treasuryTokenBase, 100, sdk.NewCoin(treasuryTokenBase, sdkmath.NewInt(100)), false},
+		{"ok - display denom", "coin", 2, sdk.NewCoin(treasuryTokenBase, sdkmath.NewIntWithDecimal(2, 18)), false},
+		{"fail - different case", "COIN", 2, sdk.Coin{}, true},
+		{"ok - alias", "wholecoin", 3, sdk.NewCoin(treasuryTokenBase, sdkmath.NewIntWithDecimal(3, 18)), false},
+		{"fail - unregistered denom", "canto2", 1, sdk.Coin{}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupTreasuryMetadata()
+
+			coin, err := suite.app.GovshuttleKeeper.ResolveTreasuryCoin(suite.ctx, tc.denom, tc.amount)
+			if tc.expError {
+				suite.Require().ErrorIs(err, types.ErrInvalidTreasuryDenom)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expCoin, coin)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResolveTreasuryCoinAmbiguous() {
+	suite.SetupTest()
+	suite.setupTreasuryMetadata()
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base: "ucoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ucoin", Exponent: 0},
+			{Denom: "coin", Exponent: 6},
+		},
+		Display: "coin",
+	})
+
+	_, err := suite.app.GovshuttleKeeper.ResolveTreasuryCoin(suite.ctx, "coin", 1)
+	suite.Require().ErrorIs(err, types.ErrInvalidTreasuryDenom)
+
+	// base denoms are still resolved exactly
+	coin, err := suite.app.GovshuttleKeeper.ResolveTreasuryCoin(suite.ctx, "ucoin", 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("ucoin", sdkmath.NewInt(1)), coin)
+}
+
+func (suite *KeeperTestSuite) TestTreasuryProposalCommunityPool() {
+	hexRecipient := "0x20F72265e2225837fd77C692e0781f720B93eF89"
+	recipient := sdk.AccAddress(common.HexToAddress(hexRecipient).Bytes())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	poolCoins := sdk.NewCoins(sdk.NewCoin(treasuryTokenBase, sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		name       string
+		recipient  string
+		denom      string
+		amount     uint64
+		mode       types.TreasuryExecutionMode
+		convert    bool
+		expBalance sdkmath.Int
+		expError   bool
+	}{
+		{"ok - hex recipient", hexRecipient, treasuryTokenBase, 400, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.NewInt(400), false},
+		{"ok - bech32 recipient", recipient.String(), treasuryTokenBase, 1000, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.NewInt(1000), false},
+		{"fail - insufficient community pool", hexRecipient, treasuryTokenBase, 1001, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.ZeroInt(), true},
+		{"fail - zero amount", hexRecipient, treasuryTokenBase, 0, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.ZeroInt(), true},
+		{"fail - unregistered denom", hexRecipient, "canto2", 1, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.ZeroInt(), true},
+		{"fail - invalid recipient", "recipient", treasuryTokenBase, 1, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, sdkmath.ZeroInt(), true},
+		{"fail - erc20 conversion with bech32 recipient", recipient.String(), treasuryTokenBase, 1, types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, true, sdkmath.ZeroInt(), true},
+		{"fail - erc20 conversion in port mode", hexRecipient, "canto", 1, types.TREASURY_EXECUTION_MODE_PORT, true, sdkmath.ZeroInt(), true},
+		{"fail - port mode with a denom unsupported by the port", hexRecipient, treasuryTokenBase, 1, types.TREASURY_EXECUTION_MODE_PORT, false, sdkmath.ZeroInt(), true},
+		{"fail - unknown execution mode", hexRecipient, treasuryTokenBase, 1, types.TreasuryExecutionMode(2), false, sdkmath.ZeroInt(), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupTreasuryMetadata()
+			suite.fundCommunityPool(poolCoins)
+
+			msg := &types.MsgTreasuryProposal{
+				Authority:   authority,
+				Title:       "treasury proposal test",
+				Description: "treasury proposal test description",
+				Metadata: &types.TreasuryProposalMetadata{
+					Recipient: tc.recipient,
+					Amount:    tc.amount,
+					Denom:     tc.denom,
+				},
+				ExecutionMode:  tc.mode,
+				ConvertToErc20: tc.convert,
+			}
+
+			_, err := suite.app.GovshuttleKeeper.TreasuryProposal(suite.ctx, msg)
+			if tc.expError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient, treasuryTokenBase)
+			suite.Require().Equal(tc.expBalance, balance.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTreasuryProposalCommunityPoolConvertErc20() {
+	suite.SetupTest()
+	metadata := suite.setupTreasuryMetadata()
+	suite.fundCommunityPool(sdk.NewCoins(sdk.NewCoin(treasuryTokenBase, sdkmath.NewInt(1000))))
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(metadata.Base, 1)))
+	suite.Require().NoError(err)
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+	suite.Require().NoError(err)
+
+	hexRecipient := common.HexToAddress("0x20F72265e2225837fd77C692e0781f720B93eF89")
+	_, err = suite.app.GovshuttleKeeper.TreasuryProposal(suite.ctx, &types.MsgTreasuryProposal{
+		Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Title:       "treasury proposal test",
+		Description: "treasury proposal test description",
+		Metadata: &types.TreasuryProposalMetadata{
+			Recipient: hexRecipient.Hex(),
+			Amount:    400,
+			Denom:     treasuryTokenBase,
+		},
+		ExecutionMode:  types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL,
+		ConvertToErc20: true,
+	})
+	suite.Require().NoError(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(hexRecipient.Bytes()), treasuryTokenBase)
+	suite.Require().True(balance.IsZero())
+
+	erc20Balance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), hexRecipient)
+	suite.Require().Equal(big.NewInt(400), erc20Balance)
+
+	pool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDec(600), pool.CommunityPool.AmountOf(treasuryTokenBase))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -22,6 +23,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			paB = common.BytesToAddress(kvB.Value)
 			return fmt.Sprintf("%v\n%v", paA, paB)
 
+		case bytes.Equal(kvA.Key[:4], types.ProposalKey):
+			propIDA := sdk.BigEndianToUint64(kvA.Key[4:])
+			propIDB := sdk.BigEndianToUint64(kvB.Key[4:])
+			return fmt.Sprintf("%d\n%d", propIDA, propIDB)
+
 		default:
 			panic(fmt.Sprintf("invalid govshuttle key prefix %X", kvA.Key[:1]))
 		}
@@ -24,6 +24,7 @@ func TestGovShuttleStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.PortKey, Value: portAddress.Bytes()},
+			{Key: types.GetProposalKey(7), Value: []byte{0x01}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -33,6 +34,7 @@ func TestGovShuttleStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"PortAddress", fmt.Sprintf("%v\n%v", portAddress, portAddress)},
+		{"ProposalID", "7\n7"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
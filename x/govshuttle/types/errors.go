@@ -8,5 +8,8 @@ import (
 
 // x/govshuttle module sentinel errors
 var (
-	Errgovshuttle = errorsmod.Register(ModuleName, 1100, "govshuttle error")
+	Errgovshuttle              = errorsmod.Register(ModuleName, 1100, "govshuttle error")
+	ErrInvalidTreasuryDenom    = errorsmod.Register(ModuleName, 1101, "invalid treasury denom")
+	ErrInvalidExecutionMode    = errorsmod.Register(ModuleName, 1102, "invalid treasury execution mode")
+	ErrInvalidTreasuryReceiver = errorsmod.Register(ModuleName, 1103, "invalid treasury recipient")
 )
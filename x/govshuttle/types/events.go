@@ -0,0 +1,10 @@
+package types
+
+// govshuttle events
+const (
+	EventTypeTreasurySpend = "treasury_spend"
+
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyConvertToErc20 = "convert_to_erc20"
+)
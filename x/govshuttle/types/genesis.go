@@ -1,7 +1,8 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -19,6 +20,19 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	propIDs := make(map[uint64]bool)
+	for _, propID := range gs.ProposalIds {
+		if propID == 0 {
+			return fmt.Errorf("proposal id cannot be 0")
+		}
+		if propIDs[propID] {
+			return fmt.Errorf("duplicated proposal id %d", propID)
+		}
+		if gs.PortContractAddr == "" {
+			return fmt.Errorf("proposal id %d without a port contract", propID)
+		}
+		propIDs[propID] = true
+	}
 
 	return gs.Params.Validate()
 }
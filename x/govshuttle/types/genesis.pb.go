@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params           Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PortContractAddr string `protobuf:"bytes,2,opt,name=port_contract_addr,json=portContractAddr,proto3" json:"port_contract_addr,omitempty"`
+	// proposal_ids are the ids of the proposals stored in the Port contract
+	ProposalIds []uint64 `protobuf:"varint,3,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,13 @@ func (m *GenesisState) GetPortContractAddr() string {
 	return ""
 }
 
+func (m *GenesisState) GetProposalIds() []uint64 {
+	if m != nil {
+		return m.ProposalIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "canto.govshuttle.v1.GenesisState")
 }
@@ -83,23 +92,25 @@ func init() {
 func init() { proto.RegisterFile("canto/govshuttle/v1/genesis.proto", fileDescriptor_356493b1fc5972f0) }
 
 var fileDescriptor_356493b1fc5972f0 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
+	// 274 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4e, 0xcc, 0x2b,
 	0xc9, 0xd7, 0x4f, 0xcf, 0x2f, 0x2b, 0xce, 0x28, 0x2d, 0x29, 0xc9, 0x49, 0xd5, 0x2f, 0x33, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
 	0x2b, 0xd1, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x2a, 0x58, 0x4d, 0x43, 0x68, 0x04, 0xab, 0x52, 0x2a, 0xe7, 0xe2,
-	0x71, 0x87, 0xd8, 0x10, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc9, 0xc5, 0x56, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xad, 0x87, 0xc5, 0x46, 0xbd, 0x00,
-	0xb0, 0x12, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x1a, 0x84, 0x74, 0xb8, 0x84, 0x0a,
-	0xf2, 0x8b, 0x4a, 0xe2, 0x93, 0xf3, 0xf3, 0x4a, 0x8a, 0x12, 0x93, 0x4b, 0xe2, 0x13, 0x53, 0x52,
-	0x8a, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0x04, 0x40, 0x32, 0xce, 0x50, 0x09, 0xc7, 0x94,
-	0x94, 0x22, 0x27, 0xbf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x49,
-	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0x06, 0x59, 0xae, 0xeb, 0x97,
-	0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d, 0xe1, 0xe9, 0x97, 0x59, 0xe8, 0x57, 0x20, 0x7b, 0xab, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x1f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdd,
-	0x28, 0x9e, 0xc4, 0x45, 0x01, 0x00, 0x00,
+	0x83, 0x58, 0x10, 0xa5, 0x52, 0x2a, 0x58, 0x4d, 0x43, 0x68, 0x04, 0xab, 0x52, 0x9a, 0xc5, 0xc8,
+	0xc5, 0xe3, 0x0e, 0xb1, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x92, 0x8b, 0xad, 0x20, 0xb1,
+	0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5a, 0x0f, 0x8b, 0x95, 0x7a,
+	0x01, 0x60, 0x25, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x35, 0x08, 0xe9, 0x70, 0x09,
+	0x15, 0xe4, 0x17, 0x95, 0xc4, 0x27, 0xe7, 0xe7, 0x95, 0x14, 0x25, 0x26, 0x97, 0xc4, 0x27, 0xa6,
+	0xa4, 0x14, 0x49, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x09, 0x80, 0x64, 0x9c, 0xa1, 0x12, 0x8e,
+	0x29, 0x29, 0x45, 0x42, 0x8a, 0x5c, 0x3c, 0x05, 0x45, 0xf9, 0x05, 0xf9, 0xc5, 0x89, 0x39, 0xf1,
+	0x99, 0x29, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0x2c, 0x41, 0xdc, 0x30, 0x31, 0xcf, 0x94, 0x62,
+	0x27, 0xbf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
+	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x49, 0xcf, 0x2c,
+	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0x06, 0xb9, 0x4f, 0xd7, 0x2f, 0xb5, 0xa4,
+	0x3c, 0xbf, 0x28, 0x1b, 0xc2, 0xd3, 0x2f, 0xb3, 0xd0, 0xaf, 0x40, 0xf6, 0x7a, 0x49, 0x65, 0x41,
+	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xcf, 0xc6, 0x80, 0x01, 0x00, 0xcf, 0xb8, 0xa8, 0x3e, 0x69, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +133,24 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalIds) > 0 {
+		dAtA2 := make([]byte, len(m.ProposalIds)*10)
+		var j1 int
+		for _, num := range m.ProposalIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PortContractAddr) > 0 {
 		i -= len(m.PortContractAddr)
 		copy(dAtA[i:], m.PortContractAddr)
@@ -165,6 +194,13 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ProposalIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -268,6 +304,82 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.PortContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposalIds = append(m.ProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposalIds) == 0 {
+					m.ProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposalIds = append(m.ProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,6 +26,38 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid genesis state with proposal ids",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				PortContractAddr: "0x20F72265e2225837fd77C692e0781f720B93eF89",
+				ProposalIds:      []uint64{1, 2},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state - zero proposal id",
+			genState: &types.GenesisState{
+				PortContractAddr: "0x20F72265e2225837fd77C692e0781f720B93eF89",
+				ProposalIds:      []uint64{0},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state - duplicated proposal id",
+			genState: &types.GenesisState{
+				PortContractAddr: "0x20F72265e2225837fd77C692e0781f720B93eF89",
+				ProposalIds:      []uint64{1, 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state - proposal id without port",
+			genState: &types.GenesisState{
+				ProposalIds: []uint64{1},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
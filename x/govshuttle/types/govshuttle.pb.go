@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -24,6 +25,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TreasuryExecutionMode defines how a passed treasury proposal is executed
+type TreasuryExecutionMode int32
+
+const (
+	// TREASURY_EXECUTION_MODE_PORT converts the proposal into a lending market
+	// proposal and stores it in the Port contract
+	TREASURY_EXECUTION_MODE_PORT TreasuryExecutionMode = 0
+	// TREASURY_EXECUTION_MODE_COMMUNITY_POOL spends the amount from the
+	// distribution community pool to the recipient
+	TREASURY_EXECUTION_MODE_COMMUNITY_POOL TreasuryExecutionMode = 1
+)
+
+var TreasuryExecutionMode_name = map[int32]string{
+	0: "TREASURY_EXECUTION_MODE_PORT",
+	1: "TREASURY_EXECUTION_MODE_COMMUNITY_POOL",
+}
+
+var TreasuryExecutionMode_value = map[string]int32{
+	"TREASURY_EXECUTION_MODE_PORT":           0,
+	"TREASURY_EXECUTION_MODE_COMMUNITY_POOL": 1,
+}
+
+func (x TreasuryExecutionMode) String() string {
+	return proto.EnumName(TreasuryExecutionMode_name, int32(x))
+}
+
+func (TreasuryExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_39f3a63fcc428040, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 }
@@ -332,12 +363,106 @@ func (m *LendingMarketMetadata) GetSignatures() []string {
 	return nil
 }
 
+// PortProposal defines a lending market or treasury proposal stored in the
+// ProposalStore (Port) contract
+type PortProposal struct {
+	// prop_id is the id of the proposal in the Port contract
+	PropId      uint64 `protobuf:"varint,1,opt,name=prop_id,json=propId,proto3" json:"prop_id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// accounts are the hex addresses of the call targets
+	Accounts []string `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// values are the values sent with each call
+	Values []cosmossdk_io_math.Int `protobuf:"bytes,5,rep,name=values,proto3,customtype=cosmossdk.io/math.Int" json:"values"`
+	// calldatas are the hex encoded calldatas of each call
+	Calldatas []string `protobuf:"bytes,6,rep,name=calldatas,proto3" json:"calldatas,omitempty"`
+	// signatures are the function signatures of each call
+	Signatures []string `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *PortProposal) Reset()         { *m = PortProposal{} }
+func (m *PortProposal) String() string { return proto.CompactTextString(m) }
+func (*PortProposal) ProtoMessage()    {}
+func (*PortProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f3a63fcc428040, []int{5}
+}
+func (m *PortProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortProposal.Merge(m, src)
+}
+func (m *PortProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortProposal proto.InternalMessageInfo
+
+func (m *PortProposal) GetPropId() uint64 {
+	if m != nil {
+		return m.PropId
+	}
+	return 0
+}
+
+func (m *PortProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PortProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PortProposal) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *PortProposal) GetCalldatas() []string {
+	if m != nil {
+		return m.Calldatas
+	}
+	return nil
+}
+
+func (m *PortProposal) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("canto.govshuttle.v1.TreasuryExecutionMode", TreasuryExecutionMode_name, TreasuryExecutionMode_value)
 	proto.RegisterType((*Params)(nil), "canto.govshuttle.v1.Params")
 	proto.RegisterType((*LendingMarketProposal)(nil), "canto.govshuttle.v1.LendingMarketProposal")
 	proto.RegisterType((*TreasuryProposal)(nil), "canto.govshuttle.v1.TreasuryProposal")
 	proto.RegisterType((*TreasuryProposalMetadata)(nil), "canto.govshuttle.v1.TreasuryProposalMetadata")
 	proto.RegisterType((*LendingMarketMetadata)(nil), "canto.govshuttle.v1.LendingMarketMetadata")
+	proto.RegisterType((*PortProposal)(nil), "canto.govshuttle.v1.PortProposal")
 }
 
 func init() {
@@ -345,37 +470,48 @@ func init() {
 }
 
 var fileDescriptor_39f3a63fcc428040 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0xb8, 0xe9, 0xea, 0x4e, 0x41, 0x24, 0x56, 0x19, 0x8b, 0xa4, 0x61, 0xf1, 0xb0, 0x08,
-	0x9b, 0xb0, 0xea, 0xa1, 0xec, 0x49, 0xad, 0x08, 0x05, 0x5b, 0x4a, 0xf0, 0xe4, 0x45, 0x66, 0x93,
-	0x21, 0x0d, 0x9b, 0xcc, 0x84, 0x99, 0x97, 0x68, 0xef, 0xfe, 0x00, 0x8f, 0xde, 0xec, 0x8f, 0xf0,
-	0x47, 0x88, 0xa7, 0xde, 0xd4, 0x9b, 0xec, 0x5e, 0xfc, 0x19, 0x32, 0x33, 0xd9, 0x6d, 0xac, 0xf5,
-	0x24, 0x9e, 0x92, 0xef, 0x7b, 0xf3, 0xde, 0xfb, 0xbe, 0xf7, 0x66, 0xf0, 0xbd, 0x84, 0x72, 0x10,
-	0x51, 0x26, 0x1a, 0x75, 0x5c, 0x03, 0x14, 0x2c, 0x6a, 0x26, 0x1d, 0x14, 0x56, 0x52, 0x80, 0xf0,
-	0x6e, 0x9a, 0x53, 0x61, 0x87, 0x6f, 0x26, 0xdb, 0x5b, 0x99, 0xc8, 0x84, 0x89, 0x47, 0xfa, 0xcf,
-	0x1e, 0xdd, 0xbe, 0x93, 0x08, 0x55, 0x0a, 0xf5, 0xda, 0x06, 0x2c, 0xb0, 0xa1, 0xe1, 0x75, 0xdc,
-	0x3f, 0xa2, 0x92, 0x96, 0x6a, 0xea, 0x7e, 0x38, 0xdd, 0x71, 0x86, 0xdf, 0x11, 0xbe, 0xf5, 0x82,
-	0xf1, 0x34, 0xe7, 0xd9, 0x01, 0x95, 0x73, 0x06, 0x47, 0x52, 0x54, 0x42, 0xd1, 0xc2, 0xdb, 0xc2,
-	0x1b, 0x90, 0x43, 0xc1, 0x08, 0x0a, 0xd0, 0x68, 0x10, 0x5b, 0xe0, 0x05, 0x78, 0x33, 0x65, 0x2a,
-	0x91, 0x79, 0x05, 0xb9, 0xe0, 0xe4, 0x8a, 0x89, 0x75, 0x29, 0xef, 0x39, 0xbe, 0x56, 0x32, 0xa0,
-	0x29, 0x05, 0x4a, 0x7a, 0x01, 0x1a, 0x6d, 0x3e, 0xb8, 0x1f, 0x5e, 0x22, 0x3d, 0xfc, 0xad, 0xeb,
-	0x41, 0x9b, 0x11, 0xaf, 0x73, 0xa7, 0x8f, 0x7f, 0x9e, 0xee, 0x38, 0x5f, 0x3e, 0x8d, 0x77, 0xb3,
-	0x1c, 0x8e, 0xeb, 0x59, 0x98, 0x88, 0xb2, 0xb5, 0xd2, 0x7e, 0xc6, 0x2a, 0x9d, 0x47, 0x6f, 0xf5,
-	0xa0, 0x22, 0x38, 0xa9, 0x98, 0x8a, 0x9a, 0xc9, 0x8c, 0x01, 0x9d, 0x84, 0x7b, 0x82, 0x03, 0xe3,
-	0x30, 0xfc, 0x8a, 0xf0, 0x8d, 0x97, 0x92, 0x51, 0x55, 0xcb, 0x93, 0x7f, 0xb6, 0xb5, 0xff, 0x87,
-	0xad, 0xf1, 0xa5, 0xb6, 0x2e, 0x36, 0xfc, 0x2f, 0xce, 0xde, 0x21, 0x4c, 0xfe, 0xd6, 0xc8, 0xbb,
-	0x8d, 0xfb, 0x9a, 0xdb, 0x7f, 0x66, 0x2c, 0xba, 0x71, 0x8b, 0xbc, 0xbb, 0x78, 0x20, 0x59, 0x92,
-	0x57, 0x39, 0xe3, 0xd0, 0x3a, 0x3c, 0x27, 0x74, 0x16, 0x2d, 0x45, 0xcd, 0xc1, 0xb8, 0x73, 0xe3,
-	0x16, 0xe9, 0x79, 0xa5, 0x8c, 0x8b, 0x92, 0xb8, 0x76, 0x5e, 0x06, 0x4c, 0x5d, 0x6d, 0x61, 0xf8,
-	0xf1, 0xe2, 0xe5, 0x59, 0x6b, 0x20, 0xf8, 0xea, 0x93, 0x24, 0x31, 0xe5, 0x50, 0xd0, 0x1b, 0x0d,
-	0xe2, 0x15, 0x5c, 0xab, 0x4b, 0x8d, 0x84, 0x95, 0xba, 0x54, 0xf3, 0x0d, 0x2d, 0x6a, 0xa6, 0x48,
-	0x2f, 0xe8, 0x69, 0xde, 0x22, 0xad, 0x3a, 0xa1, 0x45, 0xa1, 0xab, 0x2a, 0xe2, 0x9a, 0x5a, 0xe7,
-	0x84, 0xe7, 0x63, 0xac, 0xf2, 0x8c, 0x53, 0xa8, 0x25, 0x53, 0x64, 0xc3, 0x84, 0x3b, 0xcc, 0xd3,
-	0xc3, 0xcf, 0x0b, 0x1f, 0x9d, 0x2d, 0x7c, 0xf4, 0x63, 0xe1, 0xa3, 0xf7, 0x4b, 0xdf, 0x39, 0x5b,
-	0xfa, 0xce, 0xb7, 0xa5, 0xef, 0xbc, 0x7a, 0xd4, 0x19, 0xfe, 0x9e, 0xde, 0xe3, 0xf8, 0x90, 0xc1,
-	0x1b, 0x21, 0xe7, 0x16, 0x45, 0xcd, 0xae, 0xdd, 0xc0, 0xea, 0x49, 0x9a, 0x45, 0xcc, 0xfa, 0xe6,
-	0x15, 0x3d, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x80, 0xb2, 0xdd, 0xca, 0xb3, 0x03, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4b, 0x1b, 0x4f,
+	0x18, 0xde, 0x35, 0x6b, 0xd4, 0xf1, 0xc7, 0x0f, 0xd9, 0x6a, 0xbb, 0x0d, 0xb2, 0x09, 0xa1, 0x14,
+	0xb1, 0x64, 0x97, 0xb4, 0x3d, 0x88, 0xa7, 0x6a, 0x4c, 0x21, 0x60, 0xfe, 0xb0, 0x8d, 0x50, 0x7b,
+	0x09, 0xe3, 0xee, 0x10, 0x97, 0x64, 0x67, 0x96, 0x99, 0xd9, 0x54, 0xef, 0x3d, 0xf4, 0xd8, 0x63,
+	0x6f, 0x15, 0xfa, 0x15, 0xfc, 0x10, 0xd2, 0x93, 0xf4, 0xd2, 0x3f, 0x07, 0x29, 0x7a, 0xe9, 0xa7,
+	0x28, 0x65, 0x66, 0x36, 0x31, 0xd5, 0xe8, 0xa5, 0xf4, 0x94, 0x3c, 0xcf, 0x3b, 0x33, 0xef, 0xf3,
+	0xbc, 0xfb, 0xcc, 0x80, 0x07, 0x3e, 0xc4, 0x9c, 0xb8, 0x5d, 0x32, 0x60, 0xfb, 0x09, 0xe7, 0x7d,
+	0xe4, 0x0e, 0xca, 0x63, 0xc8, 0x89, 0x29, 0xe1, 0xc4, 0xbc, 0x23, 0x57, 0x39, 0x63, 0xfc, 0xa0,
+	0x9c, 0x5b, 0xec, 0x92, 0x2e, 0x91, 0x75, 0x57, 0xfc, 0x53, 0x4b, 0x73, 0xf7, 0x7d, 0xc2, 0x22,
+	0xc2, 0x3a, 0xaa, 0xa0, 0x80, 0x2a, 0x15, 0xff, 0x07, 0xd9, 0x16, 0xa4, 0x30, 0x62, 0xeb, 0xc6,
+	0xfb, 0xa3, 0xbc, 0x56, 0xfc, 0xa6, 0x83, 0xa5, 0x6d, 0x84, 0x83, 0x10, 0x77, 0xeb, 0x90, 0xf6,
+	0x10, 0x6f, 0x51, 0x12, 0x13, 0x06, 0xfb, 0xe6, 0x22, 0x98, 0xe6, 0x21, 0xef, 0x23, 0x4b, 0x2f,
+	0xe8, 0x2b, 0x73, 0x9e, 0x02, 0x66, 0x01, 0xcc, 0x07, 0x88, 0xf9, 0x34, 0x8c, 0x79, 0x48, 0xb0,
+	0x35, 0x25, 0x6b, 0xe3, 0x94, 0xf9, 0x1c, 0xcc, 0x46, 0x88, 0xc3, 0x00, 0x72, 0x68, 0x65, 0x0a,
+	0xfa, 0xca, 0xfc, 0xe3, 0x55, 0x67, 0x82, 0x74, 0xe7, 0x8f, 0xae, 0xf5, 0x74, 0x87, 0x37, 0xda,
+	0xbb, 0xfe, 0xec, 0xe7, 0x51, 0x5e, 0xfb, 0x74, 0x5c, 0x5a, 0xeb, 0x86, 0x7c, 0x3f, 0xd9, 0x73,
+	0x7c, 0x12, 0xa5, 0x56, 0xd2, 0x9f, 0x12, 0x0b, 0x7a, 0xee, 0x81, 0x18, 0x94, 0xcb, 0x0f, 0x63,
+	0xc4, 0xdc, 0x41, 0x79, 0x0f, 0x71, 0x58, 0x76, 0x2a, 0x04, 0x73, 0x84, 0x79, 0xf1, 0x8b, 0x0e,
+	0x16, 0xda, 0x14, 0x41, 0x96, 0xd0, 0xc3, 0xbf, 0xb6, 0x55, 0xbb, 0x66, 0xab, 0x34, 0xd1, 0xd6,
+	0xd5, 0x86, 0xff, 0xc4, 0xd9, 0x1b, 0x1d, 0x58, 0x37, 0x35, 0x32, 0xef, 0x82, 0xac, 0xe0, 0x6a,
+	0x5b, 0xd2, 0xa2, 0xe1, 0xa5, 0xc8, 0x5c, 0x06, 0x73, 0x14, 0xf9, 0x61, 0x1c, 0x22, 0xcc, 0x53,
+	0x87, 0x97, 0x84, 0xd8, 0x05, 0x23, 0x92, 0x60, 0x2e, 0xdd, 0x19, 0x5e, 0x8a, 0xc4, 0xbc, 0x02,
+	0x84, 0x49, 0x64, 0x19, 0x6a, 0x5e, 0x12, 0xac, 0x1b, 0xc2, 0x42, 0xf1, 0xc3, 0xd5, 0xf0, 0x8c,
+	0x34, 0x58, 0x60, 0x66, 0xc3, 0xf7, 0xe5, 0x71, 0x7a, 0x21, 0xb3, 0x32, 0xe7, 0x0d, 0xe1, 0x48,
+	0x5d, 0x60, 0x4d, 0x8d, 0xa9, 0x0b, 0x04, 0x3f, 0x80, 0xfd, 0x04, 0x31, 0x2b, 0x53, 0xc8, 0x08,
+	0x5e, 0x21, 0xa1, 0xda, 0x87, 0xfd, 0xbe, 0x38, 0x95, 0x59, 0x86, 0x3c, 0xeb, 0x92, 0x30, 0x6d,
+	0x00, 0x58, 0xd8, 0xc5, 0x90, 0x27, 0x14, 0x31, 0x6b, 0x5a, 0x96, 0xc7, 0x98, 0xe2, 0x2f, 0x1d,
+	0xfc, 0xd7, 0x22, 0xf4, 0x32, 0xd5, 0xf7, 0xc0, 0x4c, 0x4c, 0x49, 0xdc, 0x09, 0x83, 0xe1, 0x74,
+	0x62, 0xd5, 0x7f, 0x94, 0x8b, 0xa9, 0x5b, 0x72, 0x91, 0xb9, 0x9e, 0x8b, 0x1c, 0x98, 0x85, 0xca,
+	0xda, 0x50, 0xde, 0x08, 0x9b, 0x95, 0x91, 0x27, 0xa9, 0x6c, 0xf3, 0xd1, 0xc9, 0x59, 0x5e, 0xfb,
+	0x7e, 0x96, 0x5f, 0x52, 0x9f, 0x99, 0x05, 0x3d, 0x27, 0x24, 0x6e, 0x04, 0xf9, 0xbe, 0x53, 0xc3,
+	0xfc, 0xf3, 0x71, 0x09, 0xa4, 0x77, 0xb5, 0x86, 0xf9, 0xe4, 0x01, 0x64, 0x6f, 0x1f, 0xc0, 0xcc,
+	0xd5, 0x01, 0xac, 0xf6, 0xc0, 0xd2, 0x30, 0x28, 0xd5, 0x03, 0xe4, 0x27, 0x42, 0x73, 0x9d, 0x04,
+	0xc2, 0xd9, 0x72, 0xdb, 0xab, 0x6e, 0xbc, 0xd8, 0xf1, 0x76, 0x3b, 0xd5, 0x97, 0xd5, 0xca, 0x4e,
+	0xbb, 0xd6, 0x6c, 0x74, 0xea, 0xcd, 0xad, 0x6a, 0xa7, 0xd5, 0xf4, 0xda, 0x0b, 0x9a, 0xb9, 0x0a,
+	0x1e, 0xde, 0xb4, 0xa2, 0xd2, 0xac, 0xd7, 0x77, 0x1a, 0xb5, 0xf6, 0x6e, 0xa7, 0xd5, 0x6c, 0x6e,
+	0x2f, 0xe8, 0x39, 0xe3, 0xed, 0x47, 0x5b, 0xdb, 0x6c, 0x9c, 0x9c, 0xdb, 0xfa, 0xe9, 0xb9, 0xad,
+	0xff, 0x38, 0xb7, 0xf5, 0x77, 0x17, 0xb6, 0x76, 0x7a, 0x61, 0x6b, 0x5f, 0x2f, 0x6c, 0xed, 0xd5,
+	0xd3, 0xb1, 0xa8, 0x57, 0xc4, 0xad, 0x29, 0x35, 0x10, 0x7f, 0x4d, 0x68, 0x4f, 0x21, 0x77, 0xb0,
+	0xa6, 0xf2, 0x3e, 0x7c, 0x00, 0x65, 0xec, 0xf7, 0xb2, 0xf2, 0xcd, 0x7a, 0xf2, 0x7b, 0x00, 0x0d,
+	0x82, 0xba, 0xc5, 0x21, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -619,6 +755,89 @@ func (m *LendingMarketMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PortProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintGovshuttle(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Calldatas) > 0 {
+		for iNdEx := len(m.Calldatas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Calldatas[iNdEx])
+			copy(dAtA[i:], m.Calldatas[iNdEx])
+			i = encodeVarintGovshuttle(dAtA, i, uint64(len(m.Calldatas[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGovshuttle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGovshuttle(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGovshuttle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovshuttle(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PropId != 0 {
+		i = encodeVarintGovshuttle(dAtA, i, uint64(m.PropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovshuttle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovshuttle(v)
 	base := offset
@@ -741,6 +960,50 @@ func (m *LendingMarketMetadata) Size() (n int) {
 	return n
 }
 
+func (m *PortProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropId != 0 {
+		n += 1 + sovGovshuttle(uint64(m.PropId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovshuttle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGovshuttle(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGovshuttle(uint64(l))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovGovshuttle(uint64(l))
+		}
+	}
+	if len(m.Calldatas) > 0 {
+		for _, s := range m.Calldatas {
+			l = len(s)
+			n += 1 + l + sovGovshuttle(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovGovshuttle(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGovshuttle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1490,6 +1753,271 @@ func (m *LendingMarketMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PortProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovshuttle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropId", wireType)
+			}
+			m.PropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldatas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldatas = append(m.Calldatas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovshuttle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovshuttle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovshuttle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovshuttle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
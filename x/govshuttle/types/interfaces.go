@@ -4,9 +4,12 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 )
 
 // Required for deploying Map-Contract/Caling setter methods of Map-Contract
@@ -20,6 +23,8 @@ type ERC20Keeper interface {
 		data []byte,
 		commit bool,
 	) (*evmtypes.MsgEthereumTxResponse, error)
+
+	ConvertCoin(goCtx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -33,8 +38,14 @@ type GovKeeper interface {
 	GetProposalID(ctx sdk.Context) (uint64, error)
 }
 
-// BankKeeper defines the expected interface needed to retrieve account balances.
-// type BankKeeper interface {
-// 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
-// 	// Methods imported from bank should be defined here
-// }
+// BankKeeper defines the expected interface needed to resolve denom metadata.
+type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	IterateAllDenomMetaData(ctx context.Context, cb func(banktypes.Metadata) bool)
+}
+
+// DistributionKeeper defines the expected interface needed to spend from the
+// community pool.
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
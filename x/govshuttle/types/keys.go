@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -20,11 +21,18 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_govshuttle"
+
+	// MaxProposalsPageLimit is the maximum page size of the Proposals query,
+	// as each proposal is read from the Port contract through an EVM call
+	MaxProposalsPageLimit = 100
 )
 
 var (
 	ModuleAddress common.Address
 	PortKey       = []byte("Port")
+	// ProposalKey is the prefix of the ids of the proposals stored in the Port
+	// contract
+	ProposalKey = []byte("Prop")
 )
 
 func init() {
@@ -34,3 +42,8 @@ func init() {
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// GetProposalKey returns the key of a proposal id stored in the Port contract
+func GetProposalKey(propID uint64) []byte {
+	return append(KeyPrefix(string(ProposalKey)), sdk.Uint64ToBigEndian(propID)...)
+}
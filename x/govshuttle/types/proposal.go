@@ -1,8 +1,6 @@
 package types
 
 import (
-	"strings"
-
 	errorsmod "cosmossdk.io/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -78,14 +76,7 @@ func (tp *TreasuryProposal) ValidateBasic() error {
 		return err
 	}
 
-	tm := tp.GetMetadata()
-	s := strings.ToLower(tm.GetDenom())
-
-	if s != "canto" && s != "note" {
-		return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "%s is not a valid denom string", tm.GetDenom())
-	}
-
-	return nil
+	return ValidatePortTreasuryDenom(tp.GetMetadata().GetDenom())
 }
 
 func (tp *TreasuryProposal) FromTreasuryToLendingMarket() *LendingMarketProposal {
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidateTreasuryExecution checks that the execution mode and ERC20
+// conversion flag of a treasury proposal are consistent with its recipient.
+func ValidateTreasuryExecution(mode TreasuryExecutionMode, convertToErc20 bool, recipient string) error {
+	switch mode {
+	case TREASURY_EXECUTION_MODE_PORT:
+		if convertToErc20 {
+			return errorsmod.Wrap(ErrInvalidExecutionMode, "erc20 conversion is only supported in community pool execution mode")
+		}
+		return nil
+	case TREASURY_EXECUTION_MODE_COMMUNITY_POOL:
+		if _, err := ParseTreasuryRecipient(recipient); err != nil {
+			return err
+		}
+		if convertToErc20 && !common.IsHexAddress(recipient) {
+			return errorsmod.Wrapf(ErrInvalidTreasuryReceiver, "erc20 conversion requires a hex recipient address, got %s", recipient)
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidExecutionMode, "%s", mode)
+	}
+}
+
+// Denoms of the treasury proposals supported by the Port contract, which
+// stores the denom as is and resolves it itself
+const (
+	PortDenomCanto = "canto"
+	PortDenomNote  = "note"
+)
+
+// PortTreasuryDenoms are the denoms the Port contract supports, matched case
+// insensitively
+var PortTreasuryDenoms = []string{PortDenomCanto, PortDenomNote}
+
+// ValidatePortTreasuryDenom checks that the denom of a treasury proposal
+// executed through the Port contract is one the contract supports.
+func ValidatePortTreasuryDenom(denom string) error {
+	if !slices.Contains(PortTreasuryDenoms, strings.ToLower(denom)) {
+		return errorsmod.Wrapf(
+			govtypes.ErrInvalidProposalContent,
+			"%s is not a valid denom string, the Port contract supports %s", denom, strings.Join(PortTreasuryDenoms, ", "),
+		)
+	}
+	return nil
+}
+
+// ParseTreasuryRecipient returns the account address of a treasury proposal
+// recipient given either as a hex or as a bech32 address.
+func ParseTreasuryRecipient(recipient string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(recipient) {
+		return sdk.AccAddress(common.HexToAddress(recipient).Bytes()), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidTreasuryReceiver, "%s: %s", recipient, err)
+	}
+	return addr, nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/govshuttle/types"
+)
+
+func TestValidateTreasuryExecution(t *testing.T) {
+	hexRecipient := "0x20F72265e2225837fd77C692e0781f720B93eF89"
+	bech32Recipient := sdk.AccAddress(common.HexToAddress(hexRecipient).Bytes()).String()
+
+	testCases := []struct {
+		name      string
+		mode      types.TreasuryExecutionMode
+		convert   bool
+		recipient string
+		expPass   bool
+	}{
+		{"port mode", types.TREASURY_EXECUTION_MODE_PORT, false, hexRecipient, true},
+		{"port mode with erc20 conversion", types.TREASURY_EXECUTION_MODE_PORT, true, hexRecipient, false},
+		{"community pool with hex recipient", types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, hexRecipient, true},
+		{"community pool with bech32 recipient", types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, bech32Recipient, true},
+		{"community pool with invalid recipient", types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, false, "recipient", false},
+		{"erc20 conversion with hex recipient", types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, true, hexRecipient, true},
+		{"erc20 conversion with bech32 recipient", types.TREASURY_EXECUTION_MODE_COMMUNITY_POOL, true, bech32Recipient, false},
+		{"unknown execution mode", types.TreasuryExecutionMode(2), false, hexRecipient, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateTreasuryExecution(tc.mode, tc.convert, tc.recipient)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidatePortTreasuryDenom(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denom   string
+		expPass bool
+	}{
+		{"canto", types.PortDenomCanto, true},
+		{"note", types.PortDenomNote, true},
+		{"upper case", "CANTO", true},
+		{"base denom", "acanto", false},
+		{"unsupported denom", "osmo", false},
+		{"empty denom", "", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidatePortTreasuryDenom(tc.denom)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
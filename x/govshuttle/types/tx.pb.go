@@ -144,6 +144,12 @@ type MsgTreasuryProposal struct {
 	Title       string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Metadata    *TreasuryProposalMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// execution_mode defines how the proposal is executed once passed
+	ExecutionMode TreasuryExecutionMode `protobuf:"varint,5,opt,name=execution_mode,json=executionMode,proto3,enum=canto.govshuttle.v1.TreasuryExecutionMode" json:"execution_mode,omitempty"`
+	// convert_to_erc20 converts the spent coins to their ERC20 token
+	// representation via x/erc20. Only valid in community pool execution mode
+	// with a hex recipient address.
+	ConvertToErc20 bool `protobuf:"varint,6,opt,name=convert_to_erc20,json=convertToErc20,proto3" json:"convert_to_erc20,omitempty"`
 }
 
 func (m *MsgTreasuryProposal) Reset()         { *m = MsgTreasuryProposal{} }
@@ -207,6 +213,20 @@ func (m *MsgTreasuryProposal) GetMetadata() *TreasuryProposalMetadata {
 	return nil
 }
 
+func (m *MsgTreasuryProposal) GetExecutionMode() TreasuryExecutionMode {
+	if m != nil {
+		return m.ExecutionMode
+	}
+	return TREASURY_EXECUTION_MODE_PORT
+}
+
+func (m *MsgTreasuryProposal) GetConvertToErc20() bool {
+	if m != nil {
+		return m.ConvertToErc20
+	}
+	return false
+}
+
 type MsgTreasuryProposalResponse struct {
 }
 
@@ -253,37 +273,41 @@ func init() {
 func init() { proto.RegisterFile("canto/govshuttle/v1/tx.proto", fileDescriptor_d69de145343701ce) }
 
 var fileDescriptor_d69de145343701ce = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x94, 0x22, 0x7a, 0x5d, 0xc0, 0x0d, 0xc2, 0x35, 0x60, 0x22, 0x8b, 0x21, 0x8a,
-	0x14, 0x5f, 0x5b, 0x28, 0x42, 0x6c, 0x14, 0x09, 0x09, 0x09, 0x57, 0x28, 0x30, 0xb1, 0xa0, 0x6b,
-	0x7c, 0xba, 0x58, 0x8d, 0xfd, 0xac, 0x7b, 0x2f, 0xa1, 0x19, 0x90, 0x50, 0x37, 0x98, 0xf8, 0x08,
-	0x7c, 0x84, 0x0c, 0x7c, 0x08, 0xc6, 0x8a, 0x89, 0x11, 0x25, 0x43, 0xf8, 0x0e, 0x2c, 0x28, 0xb6,
-	0xd3, 0x84, 0xd4, 0x96, 0x60, 0x62, 0x89, 0x72, 0xef, 0xff, 0x7f, 0xef, 0xfd, 0xfd, 0xb3, 0x8f,
-	0xdd, 0xea, 0x88, 0x98, 0x80, 0x2b, 0x18, 0x60, 0xb7, 0x4f, 0xd4, 0x93, 0x7c, 0xb0, 0xcb, 0xe9,
-	0xc4, 0x4b, 0x34, 0x10, 0x98, 0x5b, 0xa9, 0xea, 0x2d, 0x54, 0x6f, 0xb0, 0x6b, 0xd7, 0x14, 0x28,
-	0x48, 0x75, 0x3e, 0xfb, 0x97, 0x59, 0xed, 0x1b, 0x1d, 0xc0, 0x08, 0x90, 0x47, 0xa8, 0x66, 0x23,
-	0x22, 0x54, 0xb9, 0xb0, 0x9d, 0x09, 0x6f, 0xb2, 0x8e, 0xec, 0x90, 0x4b, 0xd7, 0x44, 0x14, 0xc6,
-	0xc0, 0xd3, 0xdf, 0xbc, 0x74, 0xb7, 0x28, 0xcf, 0xd2, 0xfe, 0xd4, 0xe5, 0x7e, 0xa8, 0x32, 0xcb,
-	0x47, 0xf5, 0x5c, 0xc6, 0x41, 0x18, 0x2b, 0x5f, 0xe8, 0x63, 0x49, 0x2f, 0x34, 0x24, 0x80, 0xa2,
-	0x67, 0x3e, 0x60, 0x1b, 0xa2, 0x4f, 0x5d, 0xd0, 0x21, 0x0d, 0x2d, 0xa3, 0x6e, 0x34, 0x36, 0x0e,
-	0xac, 0x6f, 0x5f, 0x5a, 0xb5, 0x7c, 0xf5, 0xe3, 0x20, 0xd0, 0x12, 0xf1, 0x25, 0xe9, 0x30, 0x56,
-	0xed, 0x85, 0xd5, 0xac, 0xb1, 0x75, 0x0a, 0xa9, 0x27, 0xad, 0xea, 0xac, 0xa7, 0x9d, 0x1d, 0xcc,
-	0x3a, 0xdb, 0x0c, 0x24, 0x76, 0x74, 0x98, 0x50, 0x08, 0xb1, 0xb5, 0x96, 0x6a, 0xcb, 0x25, 0xf3,
-	0x29, 0xbb, 0x12, 0x49, 0x12, 0x81, 0x20, 0x61, 0x5d, 0xaa, 0x1b, 0x8d, 0xcd, 0xbd, 0xa6, 0x57,
-	0xc0, 0xcd, 0xfb, 0x23, 0xad, 0x9f, 0x77, 0xb4, 0xcf, 0x7b, 0x1f, 0xed, 0xff, 0xfc, 0x7c, 0xa7,
-	0x72, 0x3a, 0x1d, 0x35, 0x17, 0x99, 0x3e, 0x4e, 0x47, 0x4d, 0x27, 0x23, 0x52, 0xf6, 0xb8, 0xae,
-	0xcb, 0xea, 0x65, 0x5a, 0x5b, 0x62, 0x02, 0x31, 0x4a, 0xf7, 0xb4, 0xca, 0xb6, 0x7c, 0x54, 0xaf,
-	0xb4, 0x14, 0xd8, 0xd7, 0xc3, 0xff, 0x86, 0xea, 0xd9, 0x05, 0x54, 0xad, 0x42, 0x54, 0xab, 0x41,
-	0x0b, 0x68, 0xed, 0x14, 0xd3, 0xda, 0x3e, 0xa7, 0xb5, 0x3a, 0xc3, 0xbd, 0xcd, 0x6e, 0x16, 0x94,
-	0xe7, 0x8c, 0xf6, 0x7e, 0x19, 0x6c, 0xcd, 0x47, 0x65, 0xbe, 0x63, 0xd7, 0x8b, 0xbf, 0xab, 0xe2,
-	0xa8, 0x65, 0xec, 0xed, 0xfd, 0x7f, 0xb2, 0xcf, 0x63, 0x98, 0x31, 0xbb, 0x7a, 0xe1, 0x35, 0x35,
-	0xca, 0x46, 0xad, 0x3a, 0xed, 0x9d, 0xbf, 0x75, 0xce, 0xf7, 0xd9, 0xeb, 0xef, 0xa7, 0xa3, 0xa6,
-	0x71, 0x70, 0xf8, 0x75, 0xec, 0x18, 0x67, 0x63, 0xc7, 0xf8, 0x31, 0x76, 0x8c, 0x4f, 0x13, 0xa7,
-	0x72, 0x36, 0x71, 0x2a, 0xdf, 0x27, 0x4e, 0xe5, 0xf5, 0x7d, 0x15, 0x52, 0xb7, 0x7f, 0xe4, 0x75,
-	0x20, 0xe2, 0x4f, 0x66, 0xc3, 0x5b, 0x87, 0x92, 0xde, 0x82, 0x3e, 0xce, 0x4e, 0x7c, 0xf0, 0x90,
-	0x9f, 0x2c, 0xdf, 0x57, 0x1a, 0x26, 0x12, 0x8f, 0x2e, 0xa7, 0x17, 0xf5, 0xde, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa1, 0xa3, 0xe9, 0xa2, 0x60, 0x04, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xa6, 0xa4, 0x6a, 0xb7, 0x22, 0x2a, 0x6e, 0x10, 0x6e, 0x00, 0x63, 0x45, 0x1c, 0xac,
+	0x48, 0xb1, 0xd3, 0x40, 0x11, 0xea, 0x8d, 0xa2, 0x22, 0x21, 0xe1, 0x0a, 0x4c, 0x4f, 0x5c, 0x22,
+	0xd7, 0x5e, 0x6d, 0xac, 0xc6, 0x9e, 0x68, 0x77, 0x6d, 0x92, 0x03, 0x12, 0xe2, 0x06, 0x27, 0x1e,
+	0x81, 0x47, 0xc8, 0x81, 0x87, 0xe0, 0x58, 0x71, 0xe2, 0x88, 0x92, 0x43, 0x78, 0x07, 0x0e, 0x20,
+	0xff, 0xa4, 0x09, 0x89, 0x83, 0xe0, 0xd4, 0x8b, 0xe5, 0x99, 0xef, 0x9b, 0xd9, 0x6f, 0xbf, 0x59,
+	0x0d, 0xbe, 0xe5, 0xd8, 0x81, 0x00, 0x83, 0x42, 0xc4, 0x3b, 0xa1, 0x10, 0x5d, 0x62, 0x44, 0x7b,
+	0x86, 0xe8, 0xeb, 0x3d, 0x06, 0x02, 0xa4, 0x9d, 0x04, 0xd5, 0x67, 0xa8, 0x1e, 0xed, 0x55, 0x2b,
+	0x14, 0x28, 0x24, 0xb8, 0x11, 0xff, 0xa5, 0xd4, 0xea, 0x0d, 0x07, 0xb8, 0x0f, 0xdc, 0xf0, 0x39,
+	0x8d, 0x5b, 0xf8, 0x9c, 0x66, 0xc0, 0x6e, 0x0a, 0xb4, 0xd3, 0x8a, 0x34, 0xc8, 0xa0, 0x6b, 0xb6,
+	0xef, 0x05, 0x60, 0x24, 0xdf, 0x2c, 0x75, 0x37, 0x4f, 0xcf, 0x2c, 0x4a, 0x59, 0xb5, 0xf7, 0x45,
+	0x2c, 0x9b, 0x9c, 0x3e, 0x23, 0x81, 0xeb, 0x05, 0xd4, 0xb4, 0xd9, 0x19, 0x11, 0xcf, 0x19, 0xf4,
+	0x80, 0xdb, 0x5d, 0xe9, 0x01, 0xde, 0xb4, 0x43, 0xd1, 0x01, 0xe6, 0x89, 0x81, 0x8c, 0x54, 0xa4,
+	0x6d, 0x1e, 0xca, 0x5f, 0x3f, 0x37, 0x2a, 0xd9, 0xd1, 0x8f, 0x5c, 0x97, 0x11, 0xce, 0x5f, 0x0a,
+	0xe6, 0x05, 0xd4, 0x9a, 0x51, 0xa5, 0x0a, 0x2e, 0x09, 0x4f, 0x74, 0x89, 0x5c, 0x8c, 0x6b, 0xac,
+	0x34, 0x90, 0x54, 0xbc, 0xe5, 0x12, 0xee, 0x30, 0xaf, 0x27, 0x3c, 0x08, 0xe4, 0xb5, 0x04, 0x9b,
+	0x4f, 0x49, 0x4f, 0xf0, 0x86, 0x4f, 0x84, 0xed, 0xda, 0xc2, 0x96, 0xaf, 0xa8, 0x48, 0xdb, 0x6a,
+	0xd5, 0xf5, 0x1c, 0xdf, 0xf4, 0x3f, 0xd4, 0x9a, 0x59, 0x85, 0x75, 0x51, 0x7b, 0xb0, 0xff, 0xe3,
+	0xd3, 0x9d, 0xc2, 0xbb, 0xc9, 0xb0, 0x3e, 0xd3, 0xf4, 0x61, 0x32, 0xac, 0x2b, 0xa9, 0x23, 0xab,
+	0xae, 0x5b, 0xab, 0x61, 0x75, 0x15, 0x66, 0x11, 0xde, 0x83, 0x80, 0x93, 0xda, 0xaf, 0x22, 0xde,
+	0x31, 0x39, 0x3d, 0x61, 0xc4, 0xe6, 0x21, 0x1b, 0x5c, 0x9a, 0x55, 0x4f, 0x97, 0xac, 0x6a, 0xe4,
+	0x5a, 0xb5, 0x28, 0x74, 0xd9, 0x2d, 0xe9, 0x05, 0x2e, 0x93, 0x3e, 0x71, 0xc2, 0xb8, 0x6f, 0xdb,
+	0x07, 0x97, 0xc8, 0x25, 0x15, 0x69, 0xe5, 0x56, 0xfd, 0xaf, 0x0d, 0x8f, 0xa6, 0x25, 0x26, 0xb8,
+	0xc4, 0xba, 0x4a, 0xe6, 0x43, 0x49, 0xc3, 0xdb, 0x0e, 0x04, 0x11, 0x61, 0xa2, 0x2d, 0xa0, 0x4d,
+	0x98, 0xd3, 0x6a, 0xca, 0xeb, 0x2a, 0xd2, 0x36, 0xac, 0x72, 0x96, 0x3f, 0x81, 0xa3, 0x38, 0x7b,
+	0xd0, 0xcc, 0x1f, 0xd5, 0xee, 0xc5, 0xa8, 0x16, 0x2f, 0x50, 0xbb, 0x8d, 0x6f, 0xe6, 0xa4, 0xa7,
+	0x03, 0x6a, 0xfd, 0x44, 0x78, 0xcd, 0xe4, 0x54, 0x7a, 0x83, 0xaf, 0xe7, 0x3f, 0xea, 0x7c, 0x9f,
+	0x56, 0x0d, 0xbe, 0xba, 0xff, 0x5f, 0xf4, 0xa9, 0x0c, 0x29, 0xc0, 0xdb, 0x4b, 0x6f, 0x44, 0x5b,
+	0xd5, 0x6a, 0x91, 0x59, 0x6d, 0xfe, 0x2b, 0x73, 0x7a, 0x5e, 0xb5, 0xf4, 0x76, 0x32, 0xac, 0xa3,
+	0xc3, 0xe3, 0x2f, 0x23, 0x05, 0x9d, 0x8f, 0x14, 0xf4, 0x7d, 0xa4, 0xa0, 0x8f, 0x63, 0xa5, 0x70,
+	0x3e, 0x56, 0x0a, 0xdf, 0xc6, 0x4a, 0xe1, 0xd5, 0x7d, 0xea, 0x89, 0x4e, 0x78, 0xaa, 0x3b, 0xe0,
+	0x1b, 0x8f, 0xe3, 0xe6, 0x8d, 0x63, 0x22, 0x5e, 0x03, 0x3b, 0x4b, 0x23, 0x23, 0x7a, 0x68, 0xf4,
+	0xe7, 0x97, 0x85, 0x18, 0xf4, 0x08, 0x3f, 0x5d, 0x4f, 0xb6, 0xc4, 0xbd, 0xdf, 0x03, 0x00, 0x89,
+	0x57, 0xf7, 0x75, 0xdd, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -507,6 +531,21 @@ func (m *MsgTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConvertToErc20 {
+		i--
+		if m.ConvertToErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecutionMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionMode))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Metadata != nil {
 		{
 			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
@@ -633,6 +672,12 @@ func (m *MsgTreasuryProposal) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExecutionMode != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionMode))
+	}
+	if m.ConvertToErc20 {
+		n += 2
+	}
 	return n
 }
 
@@ -1044,6 +1089,45 @@ func (m *MsgTreasuryProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+			}
+			m.ExecutionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionMode |= TreasuryExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertToErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvertToErc20 = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
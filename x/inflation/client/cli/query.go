@@ -3,12 +3,22 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var (
+	FlagAssumedBondedRatio = "assumed-bonded-ratio"
 )
 
 // GetQueryCmd returns the cli query commands for the inflation module.
@@ -28,6 +38,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCirculatingSupply(),
 		GetInflationRate(),
 		GetParams(),
+		GetRemainingSupply(),
+		GetProjectedSchedule(),
+		GetBondingFactor(),
+		GetInflationPause(),
 	)
 
 	return cmd
@@ -124,7 +138,7 @@ func GetSkippedEpochs() *cobra.Command {
 func GetCirculatingSupply() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "circulating-supply",
-		Short: "Query the current supply of tokens in circulation",
+		Short: "Query the current total supply and supply of tokens in circulation",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -139,7 +153,7 @@ func GetCirculatingSupply() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.CirculatingSupply))
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -204,3 +218,172 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetRemainingSupply implements a command to return the amount of tokens that
+// can still be minted before the supply reaches the max supply.
+func GetRemainingSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-supply",
+		Short: "Query the amount of tokens that can still be minted before reaching the max supply",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRemainingSupplyRequest{}
+			res, err := queryClient.RemainingSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetBondingFactor implements a command to return the bonding factor applied
+// to the current epoch mint provision and the one of the current bonded ratio.
+func GetBondingFactor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bonding-factor",
+		Short: "Query the bonding factor applied to the current epoch mint provision",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBondingFactorRequest{}
+			res, err := queryClient.BondingFactor(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetInflationPause implements a command to return whether the inflation is
+// paused and the heights of its latest pause and resume.
+func GetInflationPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-pause",
+		Short: "Query whether the inflation is paused and the heights of its latest pause and resume",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryInflationPauseRequest{}
+			res, err := queryClient.InflationPause(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetProjectedSchedule implements a command to return the projected inflation
+// of the next periods as a table.
+func GetProjectedSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-schedule [periods]",
+		Short: "Query the projected provisions, supply and inflation rate of the next periods",
+		Long: fmt.Sprintf(`Query the projected provisions, supply and inflation rate of the next periods, starting at the next epoch of the current period.
+The current bonded ratio is assumed for all periods unless --%s is set. At most %d periods can be projected.`,
+			FlagAssumedBondedRatio, types.MaxProjectedPeriods),
+		Example: fmt.Sprintf("%s query inflation projected-schedule 10 --%s 0.5", version.AppName, FlagAssumedBondedRatio),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			periods, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of periods %s: %w", args[0], err)
+			}
+
+			req := &types.QueryProjectedScheduleRequest{Periods: periods}
+
+			bondedRatio, err := cmd.Flags().GetString(FlagAssumedBondedRatio)
+			if err != nil {
+				return err
+			}
+			if bondedRatio != "" {
+				if _, err := sdkmath.LegacyNewDecFromStr(bondedRatio); err != nil {
+					return fmt.Errorf("invalid assumed bonded ratio %s: %w", bondedRatio, err)
+				}
+				req.AssumedBondedRatio = bondedRatio
+			}
+
+			res, err := queryClient.ProjectedSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == flags.OutputFormatJSON {
+				return clientCtx.PrintProto(res)
+			}
+
+			return clientCtx.PrintString(formatProjectedSchedule(res))
+		},
+	}
+
+	cmd.Flags().String(FlagAssumedBondedRatio, "", "Bonded ratio assumed for all periods (defaults to the current bonded ratio)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// formatProjectedSchedule renders a projected schedule as a table
+func formatProjectedSchedule(res *types.QueryProjectedScheduleResponse) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(w, "PERIOD\tEPOCHS\tEPOCH PROVISION (%[1]s)\tPERIOD PROVISION (%[1]s)\tSUPPLY (%[1]s)\tINFLATION RATE\t\n", res.MintDenom)
+	for _, p := range res.Periods {
+		inflationRate, _ := p.InflationRate.Float64()
+		fmt.Fprintf(
+			w, "%d\t%d\t%s\t%s\t%s\t%.4f%%\t\n",
+			p.Period,
+			p.Epochs,
+			p.EpochMintProvision.TruncateInt(),
+			p.PeriodProvision.TruncateInt(),
+			p.Supply.TruncateInt(),
+			inflationRate,
+		)
+	}
+
+	_ = w.Flush()
+
+	if !res.InflationEnabled {
+		sb.WriteString("inflation is disabled, the projection assumes it is enabled again at the next epoch\n")
+	}
+	return sb.String()
+}
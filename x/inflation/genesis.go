@@ -21,6 +21,9 @@ func InitGenesis(
 
 	// Set genesis state
 	params := data.Params
+	if err := k.ValidateRecipients(ctx, params.InflationDistribution); err != nil {
+		panic(err)
+	}
 	k.SetParams(ctx, params)
 
 	period := data.Period
@@ -35,15 +38,24 @@ func InitGenesis(
 	skippedEpochs := data.SkippedEpochs
 	k.SetSkippedEpochs(ctx, skippedEpochs)
 
+	k.SetMaxSupplyReached(ctx, data.MaxSupplyReached)
+	k.SetInflationPause(ctx, data.InflationPause)
+
+	// Index the genesis vesting accounts for the circulating supply
+	k.IndexVestingAccounts(ctx)
+
 	// Get bondedRatio
 	bondedRatio := k.BondedRatio(ctx)
 
-	// Calculate epoch mint provision
-	epochMintProvision := types.CalculateEpochMintProvision(
+	// Calculate bonding factor and epoch mint provision
+	bondingFactor := params.Schedule.BondingFactor(bondedRatio)
+	k.SetBondingFactor(ctx, bondingFactor)
+
+	epochMintProvision := types.CalculateAdjustedEpochMintProvision(
 		params,
 		period,
 		epochsPerPeriod,
-		bondedRatio,
+		bondingFactor,
 	)
 	k.SetEpochMintProvision(ctx, epochMintProvision)
 }
@@ -51,10 +63,12 @@ func InitGenesis(
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:          k.GetParams(ctx),
-		Period:          k.GetPeriod(ctx),
-		EpochIdentifier: k.GetEpochIdentifier(ctx),
-		EpochsPerPeriod: k.GetEpochsPerPeriod(ctx),
-		SkippedEpochs:   k.GetSkippedEpochs(ctx),
+		Params:           k.GetParams(ctx),
+		Period:           k.GetPeriod(ctx),
+		EpochIdentifier:  k.GetEpochIdentifier(ctx),
+		EpochsPerPeriod:  k.GetEpochsPerPeriod(ctx),
+		SkippedEpochs:    k.GetSkippedEpochs(ctx),
+		MaxSupplyReached: k.GetMaxSupplyReached(ctx),
+		InflationPause:   k.GetInflationPause(ctx),
 	}
 }
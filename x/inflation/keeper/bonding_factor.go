@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// GetBondingFactor gets the bonding factor applied to the current
+// EpochMintProvision. It defaults to one if no factor is stored.
+func (k Keeper) GetBondingFactor(ctx sdk.Context) (sdkmath.LegacyDec, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.KeyPrefixBondingFactor)
+	if len(bz) == 0 {
+		return sdkmath.LegacyOneDec(), false
+	}
+
+	var bondingFactor sdkmath.LegacyDec
+	err := bondingFactor.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal bondingFactor value: %w", err))
+	}
+
+	return bondingFactor, true
+}
+
+// SetBondingFactor sets the bonding factor applied to the current
+// EpochMintProvision
+func (k Keeper) SetBondingFactor(ctx sdk.Context, bondingFactor sdkmath.LegacyDec) {
+	bz, err := bondingFactor.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value: %w", err))
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.KeyPrefixBondingFactor, bz)
+}
+
+// adjustBondingFactor moves the stored bonding factor towards the factor of
+// the current bonded ratio, bounded by the max change per epoch, and stores
+// it. Without a stored factor, the target factor applies directly.
+func (k Keeper) adjustBondingFactor(ctx sdk.Context, params types.Params) sdkmath.LegacyDec {
+	target := params.Schedule.BondingFactor(k.BondedRatio(ctx))
+
+	bondingFactor := target
+	if prev, found := k.GetBondingFactor(ctx); found {
+		bondingFactor = params.EpochBondingAdjustment.SmoothBondingFactor(prev, target)
+	}
+
+	k.SetBondingFactor(ctx, bondingFactor)
+	return bondingFactor
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 )
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
@@ -13,3 +17,18 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	expMintProvision := sdkmath.LegacyMustNewDecFromStr("543478266666666666666667.000000000000000000")
 	suite.Require().Equal(expMintProvision, epochMintProvision)
 }
+
+func (suite *KeeperTestSuite) TestInitGenesisInvalidRecipient() {
+	suite.SetupTest()
+
+	genesis := inflation.ExportGenesis(suite.ctx, suite.app.InflationKeeper)
+	blocked := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	genesis.Params.InflationDistribution = types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_ADDRESS, blocked.String(), sdkmath.LegacyOneDec()),
+	)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.Require().Panics(func() {
+		inflation.InitGenesis(suite.ctx, suite.app.InflationKeeper, suite.app.AccountKeeper, suite.app.StakingKeeper, *genesis)
+	})
+}
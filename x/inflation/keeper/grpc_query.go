@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -59,19 +60,23 @@ func (k Keeper) InflationRate(
 	return &types.QueryInflationRateResponse{InflationRate: inflationRate}, nil
 }
 
-// CirculatingSupply returns the total supply in circulation excluding the team
-// allocation in the first year
+// CirculatingSupply returns the total supply and the supply in circulation,
+// which excludes module accounts, the excluded addresses and locked vesting
+// coins
 func (k Keeper) CirculatingSupply(
 	c context.Context,
 	_ *types.QueryCirculatingSupplyRequest,
 ) (*types.QueryCirculatingSupplyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	circulatingSupply := k.GetCirculatingSupply(ctx)
+	totalSupply := k.GetTotalSupply(ctx)
 
 	mintDenom := k.GetParams(ctx).MintDenom
-	coin := sdk.NewDecCoinFromDec(mintDenom, circulatingSupply)
 
-	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
+	return &types.QueryCirculatingSupplyResponse{
+		CirculatingSupply: sdk.NewDecCoinFromDec(mintDenom, circulatingSupply),
+		TotalSupply:       sdk.NewDecCoinFromDec(mintDenom, totalSupply),
+	}, nil
 }
 
 // Params returns params of the mint module.
@@ -83,3 +88,89 @@ func (k Keeper) Params(
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// RemainingSupply returns the amount of tokens that can still be minted before
+// the supply reaches the max supply.
+func (k Keeper) RemainingSupply(
+	c context.Context,
+	_ *types.QueryRemainingSupplyRequest,
+) (*types.QueryRemainingSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	remaining, capped := k.GetRemainingSupply(ctx, params)
+
+	return &types.QueryRemainingSupplyResponse{
+		RemainingSupply:  sdk.NewCoin(params.MintDenom, remaining),
+		Capped:           capped,
+		MaxSupplyReached: k.GetMaxSupplyReached(ctx),
+	}, nil
+}
+
+// ProjectedSchedule returns the projected inflation of the next periods,
+// starting at the next epoch and assuming a constant bonded ratio.
+func (k Keeper) ProjectedSchedule(
+	c context.Context,
+	req *types.QueryProjectedScheduleRequest,
+) (*types.QueryProjectedScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Periods == 0 || req.Periods > types.MaxProjectedPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must be between 1 and %d", types.MaxProjectedPeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bondedRatio := k.BondedRatio(ctx)
+	if req.AssumedBondedRatio != "" {
+		var err error
+		bondedRatio, err = sdkmath.LegacyNewDecFromStr(req.AssumedBondedRatio)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assumed bonded ratio %s: %s", req.AssumedBondedRatio, err)
+		}
+		if bondedRatio.IsNegative() || bondedRatio.GT(sdkmath.LegacyOneDec()) {
+			return nil, status.Errorf(codes.InvalidArgument, "assumed bonded ratio must be between 0 and 1, got %s", bondedRatio)
+		}
+	}
+
+	if k.GetEpochsPerPeriod(ctx) <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "epochs per period must be positive")
+	}
+
+	params := k.GetParams(ctx)
+	return &types.QueryProjectedScheduleResponse{
+		MintDenom:        params.MintDenom,
+		Periods:          k.ProjectSchedule(ctx, req.Periods, bondedRatio),
+		InflationEnabled: params.EnableInflation,
+	}, nil
+}
+
+// BondingFactor returns the bonding factor applied to the current epoch mint
+// provision and the one of the current bonded ratio.
+func (k Keeper) BondingFactor(
+	c context.Context,
+	_ *types.QueryBondingFactorRequest,
+) (*types.QueryBondingFactorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	bondingFactor, _ := k.GetBondingFactor(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+
+	return &types.QueryBondingFactorResponse{
+		BondingFactor:       bondingFactor,
+		TargetBondingFactor: params.Schedule.BondingFactor(bondedRatio),
+		BondedRatio:         bondedRatio,
+	}, nil
+}
+
+// InflationPause returns whether the inflation is paused and the heights of
+// its latest pause and resume.
+func (k Keeper) InflationPause(
+	c context.Context,
+	_ *types.QueryInflationPauseRequest,
+) (*types.QueryInflationPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryInflationPauseResponse{InflationPause: k.GetInflationPause(ctx)}, nil
+}
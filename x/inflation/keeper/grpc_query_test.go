@@ -5,7 +5,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/TucanaProtocol/Tucana/v8/testutil"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -172,7 +174,7 @@ func (suite *KeeperTestSuite) TestQueryCirculatingSupply() {
 	// Mint coins to increase supply
 	mintDenom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
 	mintCoin := sdk.NewCoin(mintDenom, sdk.TokensFromConsensusPower(int64(400_000_000), ethermint.PowerReduction))
-	err := suite.app.InflationKeeper.MintCoins(suite.ctx, mintCoin)
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(mintCoin))
 	suite.Require().NoError(err)
 
 	// team allocation is zero if not on mainnet
@@ -188,7 +190,7 @@ func (suite *KeeperTestSuite) TestQueryInflationRate() {
 	// Mint coins to increase supply
 	mintDenom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
 	mintCoin := sdk.NewCoin(mintDenom, sdk.TokensFromConsensusPower(int64(400_000_000), ethermint.PowerReduction))
-	err := suite.app.InflationKeeper.MintCoins(suite.ctx, mintCoin)
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(mintCoin))
 	suite.Require().NoError(err)
 
 	expInflationRate := sdkmath.LegacyMustNewDecFromStr("4.076087000000000000")
@@ -204,3 +206,308 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryProjectedSchedule() {
+	var (
+		req    *types.QueryProjectedScheduleRequest
+		expRes *types.QueryProjectedScheduleResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - zero periods",
+			func() {
+				req = &types.QueryProjectedScheduleRequest{}
+			},
+			false,
+		},
+		{
+			"fail - too many periods",
+			func() {
+				req = &types.QueryProjectedScheduleRequest{Periods: types.MaxProjectedPeriods + 1}
+			},
+			false,
+		},
+		{
+			"fail - invalid bonded ratio",
+			func() {
+				req = &types.QueryProjectedScheduleRequest{Periods: 1, AssumedBondedRatio: "2"}
+			},
+			false,
+		},
+		{
+			"fail - malformed bonded ratio",
+			func() {
+				req = &types.QueryProjectedScheduleRequest{Periods: 1, AssumedBondedRatio: "half"}
+			},
+			false,
+		},
+		{
+			"pass - linear schedule",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.Schedule = types.NewLinearSchedule(
+					sdkmath.LegacyNewDec(3_000_000),
+					sdkmath.LegacyNewDec(1_500_000),
+					sdkmath.LegacyNewDec(750_000),
+				)
+				params.EnableInflation = true
+				suite.app.InflationKeeper.SetParams(suite.ctx, params)
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 30)
+				suite.app.InflationKeeper.SetPeriod(suite.ctx, 1)
+				// period 1 started at the end of epoch 31
+				suite.setCurrentEpoch(31)
+
+				mintCoin := sdk.NewCoin(denomMint, sdk.TokensFromConsensusPower(400_000_000, ethermint.PowerReduction))
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(mintCoin)))
+				supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount.ToLegacyDec()
+				epochMintProvision := sdkmath.LegacyNewDec(50_000).MulInt(ethermint.PowerReduction)
+				periodProvision := epochMintProvision.MulInt64(30)
+				suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, epochMintProvision)
+
+				req = &types.QueryProjectedScheduleRequest{Periods: 2, AssumedBondedRatio: "0"}
+				expRes = &types.QueryProjectedScheduleResponse{
+					MintDenom: denomMint,
+					Periods: []types.ProjectedPeriod{
+						{
+							Period:             1,
+							EpochMintProvision: epochMintProvision,
+							PeriodProvision:    periodProvision,
+							Supply:             supply.Add(periodProvision),
+							InflationRate:      periodProvision.Quo(supply).MulInt64(100),
+							Epochs:             30,
+						},
+						{
+							Period:             2,
+							EpochMintProvision: epochMintProvision.QuoInt64(2),
+							PeriodProvision:    periodProvision.QuoInt64(2),
+							Supply:             supply.Add(periodProvision).Add(periodProvision.QuoInt64(2)),
+							InflationRate:      periodProvision.QuoInt64(2).Quo(supply.Add(periodProvision)).MulInt64(100),
+							Epochs:             30,
+						},
+					},
+					InflationEnabled: true,
+				}
+			},
+			true,
+		},
+		{
+			"pass - current period partially minted with skipped epochs",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.Schedule = types.NewStepSchedule(
+					types.NewProvisionStep(0, sdkmath.LegacyNewDec(3_000_000)),
+				)
+				suite.app.InflationKeeper.SetParams(suite.ctx, params)
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 30)
+				// epochs 2 to 11 ended, one of them was skipped
+				suite.setCurrentEpoch(11)
+				suite.app.InflationKeeper.SetSkippedEpochs(suite.ctx, 1)
+
+				mintCoin := sdk.NewCoin(denomMint, sdk.TokensFromConsensusPower(400_000_000, ethermint.PowerReduction))
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(mintCoin)))
+				supply := suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx)
+				epochMintProvision := sdkmath.LegacyNewDec(100_000).MulInt(ethermint.PowerReduction)
+				suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, epochMintProvision)
+				remainingProvision := epochMintProvision.MulInt64(21)
+				periodProvision := epochMintProvision.MulInt64(30)
+
+				req = &types.QueryProjectedScheduleRequest{Periods: 2}
+				expRes = &types.QueryProjectedScheduleResponse{
+					MintDenom: denomMint,
+					Periods: []types.ProjectedPeriod{
+						{
+							Period:             0,
+							EpochMintProvision: epochMintProvision,
+							PeriodProvision:    remainingProvision,
+							Supply:             supply.Add(remainingProvision),
+							InflationRate:      remainingProvision.Quo(supply).MulInt64(100),
+							Epochs:             21,
+						},
+						{
+							Period:             1,
+							EpochMintProvision: epochMintProvision,
+							PeriodProvision:    periodProvision,
+							Supply:             supply.Add(remainingProvision).Add(periodProvision),
+							InflationRate:      periodProvision.Quo(supply.Add(remainingProvision)).MulInt64(100),
+							Epochs:             30,
+						},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"pass - capped by max supply",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.Schedule = types.NewStepSchedule(
+					types.NewProvisionStep(0, sdkmath.LegacyNewDec(3_000_000)),
+				)
+				mintCoin := sdk.NewCoin(denomMint, sdk.TokensFromConsensusPower(400_000_000, ethermint.PowerReduction))
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(mintCoin)))
+				supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount
+				params.MaxSupply = supply.Add(sdkmath.NewInt(1_000))
+				suite.app.InflationKeeper.SetParams(suite.ctx, params)
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 30)
+
+				epochMintProvision := sdkmath.LegacyNewDec(100_000).MulInt(ethermint.PowerReduction)
+				suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, epochMintProvision)
+				periodProvision := sdkmath.LegacyNewDec(1_000)
+
+				req = &types.QueryProjectedScheduleRequest{Periods: 2}
+				expRes = &types.QueryProjectedScheduleResponse{
+					MintDenom: denomMint,
+					Periods: []types.ProjectedPeriod{
+						{
+							Period:             0,
+							EpochMintProvision: epochMintProvision,
+							PeriodProvision:    periodProvision,
+							Supply:             params.MaxSupply.ToLegacyDec(),
+							InflationRate:      periodProvision.Quo(supply.ToLegacyDec()).MulInt64(100),
+							Epochs:             30,
+						},
+						{
+							Period:             1,
+							EpochMintProvision: epochMintProvision,
+							PeriodProvision:    sdkmath.LegacyZeroDec(),
+							Supply:             params.MaxSupply.ToLegacyDec(),
+							InflationRate:      sdkmath.LegacyZeroDec(),
+							Epochs:             30,
+						},
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			res, err := suite.queryClient.ProjectedSchedule(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryProjectedScheduleEpochBondingAdjustment() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+	params.EpochBondingAdjustment = types.NewEpochBondingAdjustment(true, sdkmath.LegacyNewDecWithPrec(1, 2))
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+	suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 30)
+	suite.app.InflationKeeper.SetBondingFactor(suite.ctx, sdkmath.LegacyOneDec())
+
+	// the factor moves from one towards the target of 1.4 by 0.01 before each
+	// mint, so it reaches the target on the 10th epoch of the second period
+	var expProvisions []sdkmath.LegacyDec
+	for _, period := range []uint64{0, 1} {
+		periodProvision := sdkmath.LegacyZeroDec()
+		for epoch := int64(1); epoch <= 30; epoch++ {
+			bondingFactor := sdkmath.LegacyNewDecWithPrec(100+min(int64(period)*30+epoch, 40), 2)
+			epochMintProvision := types.CalculateAdjustedEpochMintProvision(params, period, 30, bondingFactor)
+			periodProvision = periodProvision.Add(epochMintProvision.TruncateDec())
+		}
+		expProvisions = append(expProvisions, periodProvision)
+	}
+
+	res, err := suite.queryClient.ProjectedSchedule(suite.ctx, &types.QueryProjectedScheduleRequest{Periods: 2, AssumedBondedRatio: "0"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Periods, 2)
+	suite.Require().Equal(
+		types.CalculateAdjustedEpochMintProvision(params, 0, 30, sdkmath.LegacyNewDecWithPrec(101, 2)),
+		res.Periods[0].EpochMintProvision,
+	)
+	suite.Require().Equal(
+		types.CalculateAdjustedEpochMintProvision(params, 1, 30, sdkmath.LegacyNewDecWithPrec(131, 2)),
+		res.Periods[1].EpochMintProvision,
+	)
+	for i, expProvision := range expProvisions {
+		suite.Require().Equal(expProvision, res.Periods[i].PeriodProvision)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryProjectedScheduleCurrentBondedRatio() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	// bond about half of the staking token supply
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom).Amount.AddRaw(1_000_000)
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, supply))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, stakingtypes.BondedPoolName, bonded))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), bonded))
+
+	bondedRatio := suite.app.InflationKeeper.BondedRatio(suite.ctx)
+	suite.Require().True(bondedRatio.IsPositive())
+
+	// the current bonded ratio is used when the assumed one is omitted
+	res, err := suite.queryClient.ProjectedSchedule(suite.ctx, &types.QueryProjectedScheduleRequest{Periods: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.InflationKeeper.ProjectSchedule(suite.ctx, 2, bondedRatio), res.Periods)
+
+	zeroRes, err := suite.queryClient.ProjectedSchedule(suite.ctx, &types.QueryProjectedScheduleRequest{Periods: 2, AssumedBondedRatio: "0"})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(zeroRes.Periods, res.Periods)
+}
+
+func (suite *KeeperTestSuite) TestQueryBondingFactor() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+	suite.app.InflationKeeper.SetBondingFactor(suite.ctx, sdkmath.LegacyNewDecWithPrec(11, 1))
+
+	// bonded ratio is zero in tests, so the target factor is 1 + max variance
+	res, err := suite.queryClient.BondingFactor(suite.ctx, &types.QueryBondingFactorRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryBondingFactorResponse{
+		BondingFactor:       sdkmath.LegacyNewDecWithPrec(11, 1),
+		TargetBondingFactor: sdkmath.LegacyNewDecWithPrec(14, 1),
+		BondedRatio:         sdkmath.LegacyZeroDec(),
+	}, res)
+}
+
+func (suite *KeeperTestSuite) TestQueryInflationPause() {
+	suite.SetupTest()
+
+	pause := types.InflationPause{
+		Paused:               true,
+		PauseHeight:          10,
+		ResumeHeight:         5,
+		SkippedEpochsAtPause: 2,
+	}
+	suite.app.InflationKeeper.SetInflationPause(suite.ctx, pause)
+
+	res, err := suite.queryClient.InflationPause(suite.ctx, &types.QueryInflationPauseRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pause, res.InflationPause)
+}
+
+// setCurrentEpoch sets the number of the current epoch of the inflation epoch
+// identifier
+func (suite *KeeperTestSuite) setCurrentEpoch(epochNumber int64) {
+	epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, suite.app.InflationKeeper.GetEpochIdentifier(suite.ctx))
+	suite.Require().True(found)
+	epochInfo.EpochCountingStarted = true
+	epochInfo.CurrentEpoch = epochNumber
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochInfo)
+}
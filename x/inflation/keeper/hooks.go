@@ -11,20 +11,25 @@ import (
 )
 
 // BeforeEpochStart: noop, We don't need to do anything here
-func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
 }
 
 // AfterEpochEnd mints and allocates coins at the end of each epoch end
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
 
+	expEpochID := k.GetEpochIdentifier(ctx)
+	if epochIdentifier != expEpochID {
+		return nil
+	}
+
+	// Remove the fully vested accounts from the vesting account index
+	k.PruneVestingAccounts(ctx)
+
 	// Skip inflation if it is disabled and increment number of skipped epochs
 	if !params.EnableInflation {
-		// check if the epochIdentifier is "day" before incrementing.
-		if epochIdentifier != epochstypes.DayEpochID {
-			return
-		}
 		skippedEpochs++
 
 		k.SetSkippedEpochs(ctx, skippedEpochs)
@@ -35,28 +40,57 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			"epoch-number", epochNumber,
 			"skipped-epochs", skippedEpochs,
 		)
-		return
+		return nil
 	}
 
-	expEpochID := k.GetEpochIdentifier(ctx)
-	if epochIdentifier != expEpochID {
-		return
+	// Stop minting once the supply reached the max supply. The epochs are
+	// counted as skipped so that raising the max supply later resumes the
+	// schedule where it stopped instead of jumping periods ahead.
+	if k.GetMaxSupplyReached(ctx) {
+		skippedEpochs++
+
+		k.SetSkippedEpochs(ctx, skippedEpochs)
+		k.Logger(ctx).Debug(
+			"max supply reached, skipping inflation mint and allocation",
+			"height", ctx.BlockHeight(),
+			"epoch-id", epochIdentifier,
+			"epoch-number", epochNumber,
+			"skipped-epochs", skippedEpochs,
+		)
+		return nil
 	}
 
 	// mint coins, update supply
 	epochMintProvision, found := k.GetEpochMintProvision(ctx)
 	if !found {
-		panic("the epochMintProvision was not found")
+		return types.ErrEpochMintProvisionNotFound
 	}
 
-	mintedCoin := sdk.NewCoin(params.MintDenom, epochMintProvision.TruncateInt())
-	staking, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin)
+	period := k.GetPeriod(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	bondingFactor, _ := k.GetBondingFactor(ctx)
+
+	// Recalculate the bonding incentive on every epoch, bounded by the max
+	// change per epoch, before minting so that this epoch is minted with the
+	// adjusted factor
+	if params.EpochBondingAdjustment.Enabled {
+		bondingFactor = k.adjustBondingFactor(ctx, params)
+		epochMintProvision = types.CalculateAdjustedEpochMintProvision(
+			params,
+			period,
+			epochsPerPeriod,
+			bondingFactor,
+		)
+		k.SetEpochMintProvision(ctx, epochMintProvision)
+	}
+
+	// Clamp the mint to the supply left before reaching the max supply
+	mintedCoin := k.capMintedCoin(ctx, params, sdk.NewCoin(params.MintDenom, epochMintProvision.TruncateInt()))
+	allocations, err := k.MintAndAllocateInflation(ctx, mintedCoin)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	period := k.GetPeriod(ctx)
-	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
 	newProvision := epochMintProvision
 
 	// If period is passed, update the period and epochMintProvision. A period is
@@ -69,16 +103,24 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	//   => 1 - 365 * 0 - 0 < 365 --- nothing to do here
 	// Given, epochNumber = 741, period = 1, epochPerPeriod = 365, skippedEpochs = 10
 	//   => 741 - 1 * 365 - 10 > 365 --- a period has passed! we change the epochMintProvision and set a new period
-	if epochNumber-epochsPerPeriod*int64(period)-int64(skippedEpochs) > epochsPerPeriod {
+	periodPassed := epochNumber-epochsPerPeriod*int64(period)-int64(skippedEpochs) > epochsPerPeriod
+	if periodPassed {
 		period++
 		k.SetPeriod(ctx, period)
 		period = k.GetPeriod(ctx)
-		bondedRatio := k.BondedRatio(ctx)
-		newProvision = types.CalculateEpochMintProvision(
+
+		// Without the per-epoch adjustment, only recalculate the bonding
+		// incentive for the new period
+		if !params.EpochBondingAdjustment.Enabled {
+			bondingFactor = params.Schedule.BondingFactor(k.BondedRatio(ctx))
+			k.SetBondingFactor(ctx, bondingFactor)
+		}
+
+		newProvision = types.CalculateAdjustedEpochMintProvision(
 			params,
 			period,
 			epochsPerPeriod,
-			bondedRatio,
+			bondingFactor,
 		)
 		k.SetEpochMintProvision(ctx, newProvision)
 	}
@@ -91,30 +133,55 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
 			)
 		}
-		if staking.AmountOf(mintedCoin.Denom).IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "staking", "total"},
-				float32(staking.AmountOf(mintedCoin.Denom).Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if communityPool.AmountOf(mintedCoin.Denom).IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "community_pool", "total"},
-				float32(communityPool.AmountOf(mintedCoin.Denom).Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
+		for _, allocation := range allocations {
+			if allocation.Amount.Amount.IsInt64() {
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "allocate", "recipient", "total"},
+					float32(allocation.Amount.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("denom", mintedCoin.Denom),
+						telemetry.NewLabel("recipient", allocation.Recipient.Label()),
+					},
+				)
+			}
 		}
 	}()
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
-			sdk.NewAttribute(types.AttributeKeyEpochProvisions, newProvision.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
-		),
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+		sdk.NewAttribute(types.AttributeKeyEpochProvisions, newProvision.String()),
+		sdk.NewAttribute(types.AttributeKeyBondingFactor, bondingFactor.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+	}
+	for _, allocation := range allocations {
+		attributes = append(attributes, sdk.NewAttribute(
+			types.AttributeKeyAllocation,
+			fmt.Sprintf("%s:%s", allocation.Recipient.Label(), allocation.Amount),
+		))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMint, attributes...))
+
+	return nil
+}
+
+// AfterEpochsSkipped counts the epochs skipped by the epoch catch-up policy
+// after a chain halt as skipped epochs, as no inflation was minted for them
+func (k Keeper) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) error {
+	if epochIdentifier != k.GetEpochIdentifier(ctx) {
+		return nil
+	}
+
+	totalSkippedEpochs := k.GetSkippedEpochs(ctx) + uint64(skippedEpochs)
+	k.SetSkippedEpochs(ctx, totalSkippedEpochs)
+	k.Logger(ctx).Info(
+		"skipping inflation mint and allocation of missed epochs",
+		"height", ctx.BlockHeight(),
+		"epoch-id", epochIdentifier,
+		"epoch-number", epochNumber,
+		"skipped-epochs", totalSkippedEpochs,
 	)
+	return nil
 }
 
 // ___________________________________________________________________________________________________
@@ -124,7 +191,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks    = Hooks{}
+	_ epochstypes.EpochReferrer = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -132,10 +202,24 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // epochs hooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+func (h Hooks) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) error {
+	return h.k.AfterEpochsSkipped(ctx, epochIdentifier, epochNumber, skippedEpochs)
+}
+
+// GetModuleName returns the inflation module name
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+// IsEpochReferenced returns true if the inflation is minted on the given epoch
+func (h Hooks) IsEpochReferenced(ctx sdk.Context, epochIdentifier string) bool {
+	return h.k.GetEpochIdentifier(ctx) == epochIdentifier
 }
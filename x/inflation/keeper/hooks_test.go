@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 )
@@ -236,3 +239,108 @@ func (suite *KeeperTestSuite) TestPeriodChangesSkippedEpochsAfterEpochEnd() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEpochBondingAdjustmentAfterEpochEnd() {
+	testCases := []struct {
+		name             string
+		enabled          bool
+		expBondingFactor []sdkmath.LegacyDec
+	}{
+		{
+			"disabled - bonding factor stays until the next period",
+			false,
+			[]sdkmath.LegacyDec{sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()},
+		},
+		{
+			"enabled - bonding factor moves towards the target by the max change",
+			true,
+			[]sdkmath.LegacyDec{sdkmath.LegacyNewDecWithPrec(101, 2), sdkmath.LegacyNewDecWithPrec(102, 2)},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			params.EnableInflation = true
+			params.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+			params.EpochBondingAdjustment = types.NewEpochBondingAdjustment(tc.enabled, sdkmath.LegacyNewDecWithPrec(1, 2))
+			suite.app.InflationKeeper.SetParams(suite.ctx, params)
+			suite.app.InflationKeeper.SetBondingFactor(suite.ctx, sdkmath.LegacyOneDec())
+			epochsPerPeriod := suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx)
+			initialProvision := types.CalculateAdjustedEpochMintProvision(params, 0, epochsPerPeriod, sdkmath.LegacyOneDec())
+			suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, initialProvision)
+
+			// bonded ratio is zero in tests, so the target factor is 1 + max variance
+			for i, expBondingFactor := range tc.expBondingFactor {
+				ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+				suite.Require().NoError(suite.app.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, int64(i+1)))
+
+				bondingFactor, found := suite.app.InflationKeeper.GetBondingFactor(ctx)
+				suite.Require().True(found)
+				suite.Require().Equal(expBondingFactor, bondingFactor)
+
+				epochMintProvision, _ := suite.app.InflationKeeper.GetEpochMintProvision(ctx)
+				suite.Require().Equal(
+					types.CalculateAdjustedEpochMintProvision(params, 0, epochsPerPeriod, expBondingFactor),
+					epochMintProvision,
+				)
+
+				// the epoch is minted with the adjusted factor
+				var attribute, minted string
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type != types.EventTypeMint {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						switch attr.Key {
+						case types.AttributeKeyBondingFactor:
+							attribute = attr.Value
+						case sdk.AttributeKeyAmount:
+							minted = attr.Value
+						}
+					}
+				}
+				suite.Require().Equal(expBondingFactor.String(), attribute)
+				suite.Require().Equal(epochMintProvision.TruncateInt().String(), minted)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndMissingEpochMintProvision() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.KeyPrefixEpochMintProvision)
+
+	err := suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().ErrorIs(err, types.ErrEpochMintProvisionNotFound)
+
+	// the epochs module isolates the failure instead of halting the chain
+	supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint)
+	suite.Require().NotPanics(func() {
+		suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	})
+	suite.Require().Equal(supplyBefore, suite.app.BankKeeper.GetSupply(suite.ctx, denomMint))
+	suite.Require().Equal(uint64(1), suite.app.EpochsKeeper.GetHookFailures(suite.ctx, types.ModuleName))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochsSkipped() {
+	suite.SetupTest()
+
+	suite.app.InflationKeeper.SetSkippedEpochs(suite.ctx, 2)
+
+	// epochs skipped on another epoch identifier are ignored
+	suite.Require().NoError(suite.app.InflationKeeper.AfterEpochsSkipped(suite.ctx, epochstypes.WeekEpochID, 5, 3))
+	suite.Require().Equal(uint64(2), suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+
+	// epochs skipped by the catch-up policy are counted as skipped epochs
+	supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint)
+	suite.Require().NoError(suite.app.InflationKeeper.AfterEpochsSkipped(suite.ctx, epochstypes.DayEpochID, 5, 3))
+	suite.Require().Equal(uint64(5), suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+	suite.Require().Equal(supplyBefore, suite.app.BankKeeper.GetSupply(suite.ctx, denomMint))
+}
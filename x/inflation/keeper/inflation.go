@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 )
@@ -12,16 +18,16 @@ func (k Keeper) MintAndAllocateInflation(
 	ctx sdk.Context,
 	coin sdk.Coin,
 ) (
-	staking, communityPool sdk.Coins,
+	allocations []types.Allocation,
 	err error,
 ) {
 	// Mint coins for distribution
 	if err := k.MintCoins(ctx, coin); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Allocate minted coins according to allocation proportions (staking, usage
-	// incentives, community pool)
+	// Allocate minted coins according to the weights of the inflation
+	// recipients
 	return k.AllocateExponentialInflation(ctx, coin)
 }
 
@@ -38,47 +44,92 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
 
-// AllocateExponentialInflation allocates coins from the inflation to external
-// modules according to allocation proportions:
-//   - staking rewards -> sdk `auth` module fee collector
-//   - community pool -> `sdk `distr` module community pool
+// AllocateExponentialInflation allocates coins from the inflation to the
+// recipients of the inflation distribution according to their weights:
+//   - community pool -> sdk `distr` module community pool
+//   - module -> module account
+//   - address and contract -> account address
+//
+// The last recipient receives the amount left after truncating the other
+// allocations, so that no minted coins remain on the module account.
 func (k Keeper) AllocateExponentialInflation(
 	ctx sdk.Context,
 	mintedCoin sdk.Coin,
 ) (
-	staking, communityPool sdk.Coins,
+	allocations []types.Allocation,
 	err error,
 ) {
-	params := k.GetParams(ctx)
-	proportions := params.InflationDistribution
-	// Allocate staking rewards into fee collector account
-	staking = sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.StakingRewards))
-	err = k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		staking,
-	)
-	if err != nil {
-		return nil, nil, err
+	recipients := k.GetParams(ctx).InflationDistribution.Recipients
+	remaining := mintedCoin
+
+	for i, recipient := range recipients {
+		amount := k.GetProportions(ctx, mintedCoin, recipient.Weight)
+		if i == len(recipients)-1 {
+			amount = remaining
+		}
+		remaining = remaining.Sub(amount)
+
+		if err := k.allocate(ctx, recipient, amount); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to allocate inflation to %s", recipient.Label())
+		}
+
+		allocations = append(allocations, types.Allocation{Recipient: recipient, Amount: amount})
 	}
 
-	// Allocate community pool amount (remaining module balance) to community
-	// pool address
-	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	communityPool = k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	return allocations, nil
+}
 
-	err = k.distrKeeper.FundCommunityPool(
-		ctx,
-		communityPool,
-		moduleAddr,
-	)
+// allocate sends minted coins from the module account to an inflation recipient
+func (k Keeper) allocate(ctx sdk.Context, recipient types.InflationRecipient, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return nil
+	}
 
-	if err != nil {
-		return nil, nil, err
+	coins := sdk.NewCoins(amount)
+
+	switch recipient.RecipientType {
+	case types.RECIPIENT_TYPE_COMMUNITY_POOL:
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		return k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr)
+	case types.RECIPIENT_TYPE_MODULE:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Address, coins)
+	case types.RECIPIENT_TYPE_ADDRESS, types.RECIPIENT_TYPE_CONTRACT:
+		addr, err := recipient.AccAddress()
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+	default:
+		return fmt.Errorf("invalid recipient type %s", recipient.RecipientType)
+	}
+}
+
+// ValidateRecipients checks that the module recipients of an inflation
+// distribution are allowed and have a module account and that the address and
+// contract recipients are allowed to receive funds.
+func (k Keeper) ValidateRecipients(ctx sdk.Context, distribution types.InflationDistribution) error {
+	for _, recipient := range distribution.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid inflation recipient: %s", err)
+		}
+
+		switch recipient.RecipientType {
+		case types.RECIPIENT_TYPE_MODULE:
+			if k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.Address)
+			}
+		case types.RECIPIENT_TYPE_ADDRESS, types.RECIPIENT_TYPE_CONTRACT:
+			addr, err := recipient.AccAddress()
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(addr) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient.Address)
+			}
+		}
 	}
 
-	return staking, communityPool, nil
+	return nil
 }
 
 // GetAllocationProportion calculates the proportion of coins that is to be
@@ -111,13 +162,87 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdkmath.LegacyDec {
 	return totalBonded.ToLegacyDec().QuoInt(stakeSupply)
 }
 
-// GetCirculatingSupply returns the bank supply of the mintDenom
-func (k Keeper) GetCirculatingSupply(ctx sdk.Context) sdkmath.LegacyDec {
+// GetTotalSupply returns the bank supply of the mintDenom
+func (k Keeper) GetTotalSupply(ctx sdk.Context) sdkmath.LegacyDec {
 	mintDenom := k.GetParams(ctx).MintDenom
 
-	circulatingSupply := k.bankKeeper.GetSupply(ctx, mintDenom).Amount.ToLegacyDec()
+	return k.bankKeeper.GetSupply(ctx, mintDenom).Amount.ToLegacyDec()
+}
+
+// GetCirculatingSupply returns the bank supply of the mintDenom that is in
+// circulation, i.e. the total supply minus the non-circulating supply.
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context) sdkmath.LegacyDec {
+	params := k.GetParams(ctx)
+
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	nonCirculatingSupply := k.GetNonCirculatingSupply(ctx, params)
+
+	circulatingSupply := totalSupply.Sub(nonCirculatingSupply)
+	if circulatingSupply.IsNegative() {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	return circulatingSupply.ToLegacyDec()
+}
+
+// GetNonCirculatingSupply returns the amount of the mintDenom that is held by
+// the excluded addresses and by the module accounts of the app, which escrow
+// the community pool, staked and pooled tokens, plus the coins that are still
+// locked in the indexed vesting accounts. Only these balances are read, so the
+// cost doesn't grow with the number of accounts.
+func (k Keeper) GetNonCirculatingSupply(ctx sdk.Context, params types.Params) sdkmath.Int {
+	nonCirculatingSupply := sdkmath.ZeroInt()
+
+	excluded := make(map[string]bool, len(params.ExcludedAddresses))
+	for _, address := range params.ExcludedAddresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil || excluded[addr.String()] {
+			continue
+		}
 
-	return circulatingSupply
+		excluded[addr.String()] = true
+		balance := k.bankKeeper.GetBalance(ctx, addr, params.MintDenom)
+		nonCirculatingSupply = nonCirculatingSupply.Add(balance.Amount)
+	}
+
+	moduleAccounts := k.accountKeeper.GetModulePermissions()
+	moduleNames := make([]string, 0, len(moduleAccounts))
+	for name := range moduleAccounts {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	for _, name := range moduleNames {
+		addr := moduleAccounts[name].GetAddress()
+		if excluded[addr.String()] {
+			continue
+		}
+
+		excluded[addr.String()] = true
+		balance := k.bankKeeper.GetBalance(ctx, addr, params.MintDenom)
+		nonCirculatingSupply = nonCirculatingSupply.Add(balance.Amount)
+	}
+
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		if excluded[addr.String()] {
+			return false
+		}
+
+		acc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount)
+		if !ok {
+			return false
+		}
+
+		// delegated vesting coins are escrowed by the staking module
+		// accounts and the locked coins cannot exceed the balance
+		locked := acc.LockedCoins(ctx.BlockTime()).AmountOf(params.MintDenom)
+		balance := k.bankKeeper.GetBalance(ctx, addr, params.MintDenom)
+		nonCirculatingSupply = nonCirculatingSupply.Add(sdkmath.MinInt(locked, balance.Amount))
+
+		return false
+	})
+
+	return nonCirculatingSupply
 }
 
 // GetInflationRate returns the inflation rate for the current period.
@@ -142,3 +267,108 @@ func (k Keeper) GetInflationRate(ctx sdk.Context) sdkmath.LegacyDec {
 	// EpochMintProvision * 365 / circulatingSupply * 100
 	return epochMintProvision.Mul(epochsPerPeriod).Quo(circulatingSupply).Mul(sdkmath.LegacyNewDec(100))
 }
+
+// ProjectSchedule simulates the epoch mints of the given number of periods for
+// a constant bonded ratio, starting at the next epoch of the current period.
+// It follows AfterEpochEnd: the epochs already minted or skipped shorten the
+// current period, the bonding factor is adjusted before every mint if the epoch
+// bonding adjustment is enabled or recalculated for every new period otherwise,
+// and the max supply caps the mints. A disabled or paused inflation is
+// projected as if it was enabled again at the next epoch.
+func (k Keeper) ProjectSchedule(ctx sdk.Context, periods uint64, bondedRatio sdkmath.LegacyDec) []types.ProjectedPeriod {
+	params := k.GetParams(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochsPerPeriod <= 0 {
+		return []types.ProjectedPeriod{}
+	}
+
+	remaining, capped := k.GetRemainingSupply(ctx, params)
+	supply := k.GetCirculatingSupply(ctx)
+	period := k.GetPeriod(ctx)
+	skippedEpochs := int64(k.GetSkippedEpochs(ctx))
+	epochNumber := k.nextEpochNumber(ctx)
+
+	targetFactor := params.Schedule.BondingFactor(bondedRatio)
+	bondingFactor, found := k.GetBondingFactor(ctx)
+	epochMintProvision, ok := k.GetEpochMintProvision(ctx)
+	if !ok {
+		epochMintProvision = types.CalculateAdjustedEpochMintProvision(params, period, epochsPerPeriod, bondingFactor)
+	}
+
+	projection := make([]types.ProjectedPeriod, 0, periods)
+	for i := uint64(0); i < periods; i++ {
+		// the period passes once the epoch number, without the skipped epochs,
+		// surpasses the epochs of the previous periods by epochsPerPeriod
+		lastEpoch := epochsPerPeriod*int64(period+1) + skippedEpochs + 1
+		epochs := max(lastEpoch-epochNumber+1, 1)
+
+		firstProvision := epochMintProvision
+		periodProvision := sdkmath.LegacyZeroDec()
+		for minted := int64(0); minted < epochs; minted++ {
+			if params.EpochBondingAdjustment.Enabled {
+				adjusted := targetFactor
+				if found {
+					adjusted = params.EpochBondingAdjustment.SmoothBondingFactor(bondingFactor, targetFactor)
+				}
+				if !found || minted == 0 || !adjusted.Equal(bondingFactor) {
+					bondingFactor, found = adjusted, true
+					epochMintProvision = types.CalculateAdjustedEpochMintProvision(params, period, epochsPerPeriod, bondingFactor)
+				}
+			}
+			if minted == 0 {
+				firstProvision = epochMintProvision
+			}
+
+			// each epoch mints the truncated provision, which stays the same
+			// for the rest of the period once the bonding factor is stable
+			if !params.EpochBondingAdjustment.Enabled || bondingFactor.Equal(targetFactor) {
+				periodProvision = periodProvision.Add(epochMintProvision.TruncateDec().MulInt64(epochs - minted))
+				break
+			}
+			periodProvision = periodProvision.Add(epochMintProvision.TruncateDec())
+		}
+
+		if capped {
+			periodProvision = sdkmath.LegacyMinDec(periodProvision, remaining.ToLegacyDec())
+			remaining = remaining.Sub(periodProvision.TruncateInt())
+		}
+
+		inflationRate := sdkmath.LegacyZeroDec()
+		if supply.IsPositive() {
+			inflationRate = periodProvision.Quo(supply).Mul(sdkmath.LegacyNewDec(100))
+		}
+		supply = supply.Add(periodProvision)
+
+		projection = append(projection, types.ProjectedPeriod{
+			Period:             period,
+			EpochMintProvision: firstProvision,
+			PeriodProvision:    periodProvision,
+			Supply:             supply,
+			InflationRate:      inflationRate,
+			Epochs:             uint64(epochs),
+		})
+
+		// the provision of the new period is recalculated with the factor of
+		// the bonded ratio, unless the factor is adjusted on every epoch
+		period++
+		epochNumber += epochs
+		if !params.EpochBondingAdjustment.Enabled {
+			bondingFactor = targetFactor
+		}
+		epochMintProvision = types.CalculateAdjustedEpochMintProvision(params, period, epochsPerPeriod, bondingFactor)
+	}
+
+	return projection
+}
+
+// nextEpochNumber returns the epoch number AfterEpochEnd is called with at the
+// end of the current epoch, as the epoch number is incremented before the
+// hook is called
+func (k Keeper) nextEpochNumber(ctx sdk.Context) int64 {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx))
+	if !found || !epochInfo.EpochCountingStarted {
+		// the initial epoch is number one
+		return 2
+	}
+	return epochInfo.CurrentEpoch + 1
+}
@@ -2,12 +2,20 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
 	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/testutil"
+	csrtypes "github.com/TucanaProtocol/Tucana/v8/x/csr/types"
 )
 
 func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
@@ -42,7 +50,7 @@ func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
 
 			tc.malleate()
 
-			_, _, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, tc.mintCoin)
+			_, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, tc.mintCoin)
 
 			// Get balances
 			balanceModule := suite.app.BankKeeper.GetBalance(
@@ -73,6 +81,65 @@ func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllocateInflationRecipients() {
+	suite.SetupTest()
+
+	recipient := sdk.AccAddress([]byte("inflation_recipient_"))
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.InflationDistribution = types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, sdkmath.LegacyNewDecWithPrec(5, 1)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, csrtypes.ModuleName, sdkmath.LegacyNewDecWithPrec(2, 1)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_ADDRESS, recipient.String(), sdkmath.LegacyNewDecWithPrec(1, 1)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_CONTRACT, contract.Hex(), sdkmath.LegacyNewDecWithPrec(1, 1)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	)
+	suite.Require().NoError(suite.app.InflationKeeper.ValidateRecipients(suite.ctx, params.InflationDistribution))
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	allocations, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, sdk.NewCoin(denomMint, sdkmath.NewInt(1_000_009)))
+	suite.Require().NoError(err)
+	suite.Require().Len(allocations, 5)
+
+	getBalance := func(addr sdk.AccAddress) sdkmath.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, addr, denomMint).Amount
+	}
+
+	suite.Require().Equal(sdkmath.NewInt(500_004), getBalance(suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)))
+	suite.Require().Equal(sdkmath.NewInt(200_001), getBalance(suite.app.AccountKeeper.GetModuleAddress(csrtypes.ModuleName)))
+	suite.Require().Equal(sdkmath.NewInt(100_000), getBalance(recipient))
+	suite.Require().Equal(sdkmath.NewInt(100_000), getBalance(contract.Bytes()))
+
+	// the last recipient receives the truncated remainder
+	feePool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDec(100_004), feePool.CommunityPool.AmountOf(denomMint))
+	suite.Require().Equal(sdk.NewCoin(denomMint, sdkmath.NewInt(100_004)), allocations[4].Amount)
+	suite.Require().True(getBalance(suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestValidateRecipients() {
+	suite.SetupTest()
+
+	err := suite.app.InflationKeeper.ValidateRecipients(suite.ctx, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, "unknown", sdkmath.LegacyOneDec()),
+	))
+	suite.Require().Error(err)
+
+	// the distribution module account exists but is not an allowed recipient
+	err = suite.app.InflationKeeper.ValidateRecipients(suite.ctx, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, distrtypes.ModuleName, sdkmath.LegacyOneDec()),
+	))
+	suite.Require().Error(err)
+
+	blocked := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	err = suite.app.InflationKeeper.ValidateRecipients(suite.ctx, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_ADDRESS, blocked.String(), sdkmath.LegacyOneDec()),
+	))
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestGetCirculatingSupplyAndInflationRate() {
 	testCases := []struct {
 		name             string
@@ -118,7 +185,7 @@ func (suite *KeeperTestSuite) TestGetCirculatingSupplyAndInflationRate() {
 			// Mint coins to increase supply
 			coin := sdk.NewCoin(types.DefaultInflationDenom, sdk.TokensFromConsensusPower(tc.bankSupply, ethermint.PowerReduction))
 			decCoin := sdk.NewDecCoinFromCoin(coin)
-			err := suite.app.InflationKeeper.MintCoins(suite.ctx, coin)
+			err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(coin))
 			suite.Require().NoError(err)
 
 			circulatingSupply := s.app.InflationKeeper.GetCirculatingSupply(suite.ctx)
@@ -129,3 +196,68 @@ func (suite *KeeperTestSuite) TestGetCirculatingSupplyAndInflationRate() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGetCirculatingSupplyExclusions() {
+	suite.SetupTest()
+
+	amount := func(tokens int64) sdkmath.Int {
+		return sdk.TokensFromConsensusPower(tokens, ethermint.PowerReduction)
+	}
+	coins := func(tokens int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(denomMint, amount(tokens)))
+	}
+
+	// circulating holder
+	holder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, holder, coins(1_000)))
+
+	// module account, e.g. the community pool
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, distrtypes.ModuleName, coins(200)))
+
+	// excluded address
+	excluded := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, excluded, coins(300)))
+
+	// vesting account with half of its coins vested. The app doesn't include
+	// the vesting module, so its account types are registered here.
+	vestingtypes.RegisterInterfaces(suite.app.InterfaceRegistry())
+	vesting := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	baseAccount := authtypes.NewBaseAccountWithAddress(vesting)
+	baseAccount.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+	start := suite.ctx.BlockTime().Add(-time.Hour).Unix()
+	end := suite.ctx.BlockTime().Add(time.Hour).Unix()
+	vestingAccount, err := vestingtypes.NewContinuousVestingAccount(baseAccount, coins(400), start, end)
+	suite.Require().NoError(err)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAccount)
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, vesting, coins(400)))
+	suite.app.InflationKeeper.IndexVestingAccounts(suite.ctx)
+	suite.Require().True(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, vesting))
+
+	totalSupply := amount(1_000 + 200 + 300 + 400).ToLegacyDec()
+	suite.Require().Equal(totalSupply, suite.app.InflationKeeper.GetTotalSupply(suite.ctx))
+
+	// the module account and the locked half of the vesting coins are not
+	// circulating
+	circulatingSupply := suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx)
+	suite.Require().Equal(amount(1_000+300+200).ToLegacyDec(), circulatingSupply)
+
+	// excluding the module account twice doesn't count its balance twice
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.ExcludedAddresses = []string{
+		excluded.String(),
+		suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(),
+	}
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	res, err := suite.queryClient.CirculatingSupply(suite.ctx, &types.QueryCirculatingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(denomMint, totalSupply), res.TotalSupply)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(denomMint, amount(1_000+200).ToLegacyDec()), res.CirculatingSupply)
+
+	// once fully vested, the account is circulating and leaves the index
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.app.InflationKeeper.PruneVestingAccounts(suite.ctx)
+	suite.Require().False(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, vesting))
+	circulatingSupply = suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx)
+	suite.Require().Equal(amount(1_000+400).ToLegacyDec(), circulatingSupply)
+}
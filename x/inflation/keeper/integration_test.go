@@ -60,7 +60,7 @@ var _ = Describe("Inflation", Ordered, func() {
 					provision, _ := s.app.InflationKeeper.GetEpochMintProvision(s.ctx)
 					params := s.app.InflationKeeper.GetParams(s.ctx)
 
-					distributionStaking := params.InflationDistribution.StakingRewards
+					distributionStaking := params.InflationDistribution.Recipients[0].Weight
 					expectedStaking := provision.Mul(distributionStaking)
 
 					staking := s.app.AccountKeeper.GetModuleAddress("fee_collector")
@@ -21,6 +21,7 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	distrKeeper      distrkeeper.Keeper
 	stakingKeeper    types.StakingKeeper
+	epochsKeeper     types.EpochsKeeper
 	feeCollectorName string
 
 	authority string
@@ -35,6 +36,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	dk distrkeeper.Keeper,
 	sk types.StakingKeeper,
+	ek types.EpochsKeeper,
 	feeCollectorName string,
 	authority string,
 ) Keeper {
@@ -56,6 +58,7 @@ func NewKeeper(
 		bankKeeper:       bk,
 		distrKeeper:      dk,
 		stakingKeeper:    sk,
+		epochsKeeper:     ek,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
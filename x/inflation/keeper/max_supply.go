@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// GetMaxSupplyReached returns true once minting stopped because the supply
+// reached the max supply
+func (k Keeper) GetMaxSupplyReached(ctx sdk.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, _ := store.Has(types.KeyPrefixMaxSupplyReached)
+	return has
+}
+
+// SetMaxSupplyReached stores whether minting stopped because the supply
+// reached the max supply
+func (k Keeper) SetMaxSupplyReached(ctx sdk.Context, reached bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	if !reached {
+		store.Delete(types.KeyPrefixMaxSupplyReached)
+		return
+	}
+	store.Set(types.KeyPrefixMaxSupplyReached, []byte{1})
+}
+
+// GetRemainingSupply returns the amount of the mint denom that can still be
+// minted before the bank supply reaches the max supply. The returned boolean
+// is false if the supply is not capped.
+func (k Keeper) GetRemainingSupply(ctx sdk.Context, params types.Params) (sdkmath.Int, bool) {
+	if params.MaxSupply.IsZero() {
+		return sdkmath.ZeroInt(), false
+	}
+
+	if k.GetMaxSupplyReached(ctx) {
+		return sdkmath.ZeroInt(), true
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	if supply.GTE(params.MaxSupply) {
+		return sdkmath.ZeroInt(), true
+	}
+
+	return params.MaxSupply.Sub(supply), true
+}
+
+// capMintedCoin clamps the coin minted on an epoch to the remaining supply.
+// The mint that reaches the max supply stops minting permanently.
+func (k Keeper) capMintedCoin(ctx sdk.Context, params types.Params, mintedCoin sdk.Coin) sdk.Coin {
+	remaining, capped := k.GetRemainingSupply(ctx, params)
+	if !capped || mintedCoin.Amount.LT(remaining) {
+		return mintedCoin
+	}
+
+	mintedCoin.Amount = remaining
+	k.SetMaxSupplyReached(ctx, true)
+
+	k.Logger(ctx).Info(
+		"max supply reached, stopping inflation",
+		"height", ctx.BlockHeight(),
+		"max-supply", params.MaxSupply.String(),
+		"final-mint", mintedCoin.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMaxSupplyReached,
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, params.MaxSupply.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		),
+	)
+
+	return mintedCoin
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/keeper"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	suite.SetupTest()
+
+	epochMintProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+	mintAmount := epochMintProvision.TruncateInt()
+	suite.Require().True(mintAmount.IsPositive())
+
+	// cap the supply below two full epoch mints
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount
+	remaining := mintAmount.QuoRaw(2)
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	params.MaxSupply = supply.Add(mintAmount).Add(remaining)
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	// full mint below the cap
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Equal(supply.Add(mintAmount), suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount)
+	suite.Require().False(suite.app.InflationKeeper.GetMaxSupplyReached(suite.ctx))
+
+	res, err := suite.queryClient.RemainingSupply(suite.ctx, &types.QueryRemainingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denomMint, remaining), res.RemainingSupply)
+	suite.Require().True(res.Capped)
+	suite.Require().False(res.MaxSupplyReached)
+
+	// partial mint up to the cap
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 2)
+	suite.Require().Equal(params.MaxSupply, suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount)
+	suite.Require().True(suite.app.InflationKeeper.GetMaxSupplyReached(suite.ctx))
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMaxSupplyReached {
+			continue
+		}
+		emitted = true
+		amount, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		suite.Require().True(ok)
+		suite.Require().Equal(remaining.String(), amount.Value)
+	}
+	suite.Require().True(emitted)
+
+	// no mint once the cap is reached, the epoch is counted as skipped
+	skippedEpochs := suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx)
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 3)
+	suite.Require().Equal(params.MaxSupply, suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount)
+	suite.Require().Equal(skippedEpochs+1, suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+
+	res, err = suite.queryClient.RemainingSupply(suite.ctx, &types.QueryRemainingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.RemainingSupply.IsZero())
+	suite.Require().True(res.Capped)
+	suite.Require().True(res.MaxSupplyReached)
+
+	// raising the cap resumes minting
+	msgServer := keeper.NewMsgServerImpl(suite.app.InflationKeeper)
+	params.MaxSupply = params.MaxSupply.Add(mintAmount)
+	_, err = msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.InflationKeeper.GetMaxSupplyReached(suite.ctx))
+
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 4)
+	suite.Require().Equal(params.MaxSupply, suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount)
+	suite.Require().True(suite.app.InflationKeeper.GetMaxSupplyReached(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMaxSupplyKeepsPeriod() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	period := suite.app.InflationKeeper.GetPeriod(suite.ctx)
+	epochMintProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+
+	// a whole period goes by while the max supply is reached
+	suite.app.InflationKeeper.SetMaxSupplyReached(suite.ctx, true)
+	epochsPerPeriod := suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx)
+	for epoch := int64(1); epoch <= epochsPerPeriod+1; epoch++ {
+		suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, epoch)
+	}
+	suite.Require().Equal(uint64(epochsPerPeriod+1), suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+
+	// minting resumes within the same period at the same provision
+	suite.app.InflationKeeper.SetMaxSupplyReached(suite.ctx, false)
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, epochsPerPeriod+2)
+	suite.Require().Equal(period, suite.app.InflationKeeper.GetPeriod(suite.ctx))
+
+	newProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(epochMintProvision, newProvision)
+}
+
+func (suite *KeeperTestSuite) TestQueryRemainingSupplyUncapped() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.RemainingSupply(suite.ctx, &types.QueryRemainingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denomMint, sdkmath.ZeroInt()), res.RemainingSupply)
+	suite.Require().False(res.Capped)
+	suite.Require().False(res.MaxSupplyReached)
+}
@@ -2,11 +2,24 @@ package keeper
 
 import (
 	v2 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v2"
+	v3 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v3"
+	v4 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v4"
+	v5 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v5"
+	v6 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v6"
+	v7 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v7"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-var _ module.MigrationHandler = Migrator{}.Migrate1to2
+var (
+	_ module.MigrationHandler = Migrator{}.Migrate1to2
+	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
+	_ module.MigrationHandler = Migrator{}.Migrate4to5
+	_ module.MigrationHandler = Migrator{}.Migrate5to6
+	_ module.MigrationHandler = Migrator{}.Migrate6to7
+	_ module.MigrationHandler = Migrator{}.Migrate7to8
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -23,3 +36,30 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.UpdateParams(ctx, m.keeper)
 }
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateInflationDistribution(ctx, &m.keeper.paramstore)
+}
+
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.UpdateParams(ctx, &m.keeper.paramstore)
+}
+
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateInflationSchedule(ctx, &m.keeper.paramstore)
+}
+
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.UpdateParams(ctx, &m.keeper.paramstore)
+}
+
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateEpochBondingAdjustment(ctx, &m.keeper.paramstore, m.keeper)
+}
+
+// Migrate7to8 builds the index of vesting accounts used by the circulating
+// supply
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.IndexVestingAccounts(ctx)
+	return nil
+}
@@ -32,7 +32,58 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateRecipients(ctx, req.Params.InflationDistribution); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+
+	// Raising or removing the max supply resumes a minting stopped by the cap
+	if req.Params.MaxSupply.IsZero() || req.Params.MaxSupply.GT(params.MaxSupply) {
+		k.SetMaxSupplyReached(ctx, false)
+	}
+
+	// Enabling a paused inflation through the params resumes it without
+	// catching up on the skipped epochs
+	if req.Params.EnableInflation && !params.EnableInflation {
+		if pause := k.GetInflationPause(ctx); pause.Paused {
+			k.setInflationResumed(ctx, pause)
+		}
+	}
+
 	k.SetParams(ctx, req.Params)
 
+	// Switching to another schedule type applies it from the current period on
+	if req.Params.Schedule.Name() != params.Schedule.Name() {
+		k.migrateInflationSchedule(ctx, params.Schedule, req.Params)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) PauseInflation(goCtx context.Context, req *types.MsgPauseInflation) (*types.MsgPauseInflationResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.PauseInflation(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseInflationResponse{}, nil
+}
+
+func (k msgServer) ResumeInflation(goCtx context.Context, req *types.MsgResumeInflation) (*types.MsgResumeInflationResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	catchUpAmount, err := k.Keeper.ResumeInflation(ctx, req.CatchUp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeInflationResponse{CatchUpAmount: catchUpAmount}, nil
+}
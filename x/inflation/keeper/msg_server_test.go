@@ -12,6 +12,8 @@ import (
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 
 	"github.com/TucanaProtocol/Tucana/v8/testutil"
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/keeper"
 	inflationtypes "github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 )
 
@@ -54,35 +56,33 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Params: inflationtypes.Params{
 					MintDenom: "btc",
-					ExponentialCalculation: inflationtypes.ExponentialCalculation{
+					Schedule: inflationtypes.NewExponentialSchedule(inflationtypes.ExponentialCalculation{
 						A:             sdkmath.LegacyNewDec(int64(16_304_348)),
 						R:             sdkmath.LegacyNewDecWithPrec(35, 2),
 						C:             sdkmath.LegacyZeroDec(),
 						BondingTarget: sdkmath.LegacyNewDecWithPrec(66, 2),
 						MaxVariance:   sdkmath.LegacyZeroDec(),
-					},
-					InflationDistribution: inflationtypes.InflationDistribution{
-						StakingRewards: sdkmath.LegacyNewDecWithPrec(1000000, 6),
-						CommunityPool:  sdkmath.LegacyZeroDec(),
-					},
-					EnableInflation: false,
+					}),
+					InflationDistribution:  inflationtypes.DefaultInflationDistribution(),
+					EnableInflation:        false,
+					MaxSupply:              sdkmath.ZeroInt(),
+					EpochBondingAdjustment: inflationtypes.DefaultEpochBondingAdjustment(),
 				},
 			},
 			func(proposalId uint64) {
 				changeParams := inflationtypes.Params{
 					MintDenom: "btc",
-					ExponentialCalculation: inflationtypes.ExponentialCalculation{
+					Schedule: inflationtypes.NewExponentialSchedule(inflationtypes.ExponentialCalculation{
 						A:             sdkmath.LegacyNewDec(int64(16_304_348)),
 						R:             sdkmath.LegacyNewDecWithPrec(35, 2),
 						C:             sdkmath.LegacyZeroDec(),
 						BondingTarget: sdkmath.LegacyNewDecWithPrec(66, 2),
 						MaxVariance:   sdkmath.LegacyZeroDec(),
-					},
-					InflationDistribution: inflationtypes.InflationDistribution{
-						StakingRewards: sdkmath.LegacyNewDecWithPrec(1000000, 6),
-						CommunityPool:  sdkmath.LegacyZeroDec(),
-					},
-					EnableInflation: false,
+					}),
+					InflationDistribution:  inflationtypes.DefaultInflationDistribution(),
+					EnableInflation:        false,
+					MaxSupply:              sdkmath.ZeroInt(),
+					EpochBondingAdjustment: inflationtypes.DefaultEpochBondingAdjustment(),
 				}
 
 				proposal, err := suite.app.GovKeeper.Proposals.Get(suite.ctx, proposalId)
@@ -114,3 +114,206 @@ func (suite *KeeperTestSuite) TestMsgExecutionByProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParamsSwitchSchedule() {
+	suite.SetupTest()
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.InflationKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	epochsPerPeriod := suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx)
+	suite.app.InflationKeeper.SetPeriod(suite.ctx, 3)
+
+	testCases := []struct {
+		name         string
+		schedule     inflationtypes.InflationSchedule
+		expProvision sdkmath.LegacyDec
+		expSwitch    bool
+	}{
+		{
+			"switch to step schedule",
+			inflationtypes.NewStepSchedule(
+				inflationtypes.NewProvisionStep(0, sdkmath.LegacyNewDec(3_000_000)),
+				inflationtypes.NewProvisionStep(2, sdkmath.LegacyNewDec(1_500_000)),
+			),
+			sdkmath.LegacyNewDec(1_500_000),
+			true,
+		},
+		{
+			"same schedule type applies on the next period",
+			inflationtypes.NewStepSchedule(
+				inflationtypes.NewProvisionStep(0, sdkmath.LegacyNewDec(6_000_000)),
+			),
+			sdkmath.LegacyNewDec(1_500_000),
+			false,
+		},
+		{
+			"switch to linear schedule",
+			inflationtypes.NewLinearSchedule(
+				sdkmath.LegacyNewDec(3_000_000),
+				sdkmath.LegacyNewDec(600_000),
+				sdkmath.LegacyZeroDec(),
+			),
+			sdkmath.LegacyNewDec(1_200_000),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+			params := suite.app.InflationKeeper.GetParams(ctx)
+			params.Schedule = tc.schedule
+			_, err := msgServer.UpdateParams(ctx, &inflationtypes.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			})
+			suite.Require().NoError(err)
+
+			expEpochMintProvision := tc.expProvision.
+				Quo(sdkmath.LegacyNewDec(epochsPerPeriod)).
+				Mul(sdkmath.LegacyNewDecFromBigInt(sdk.DefaultPowerReduction.BigInt()))
+			epochMintProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(expEpochMintProvision, epochMintProvision)
+
+			var switched bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == inflationtypes.EventTypeSwitchSchedule {
+					switched = true
+				}
+			}
+			suite.Require().Equal(tc.expSwitch, switched)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPauseResumeInflation() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name             string
+		epochIdentifier  string
+		catchUp          bool
+		malleate         func()
+		expSkippedEpochs uint64
+		expCatchUpEpochs int64
+	}{
+		{
+			"resume without catch-up",
+			epochstypes.DayEpochID,
+			false,
+			func() {},
+			3,
+			0,
+		},
+		{
+			"resume with catch-up",
+			epochstypes.DayEpochID,
+			true,
+			func() {},
+			0,
+			3,
+		},
+		{
+			"skipped epochs of another identifier are not counted",
+			epochstypes.WeekEpochID,
+			true,
+			func() {},
+			0,
+			0,
+		},
+		{
+			"skipped epochs of the configured identifier are counted",
+			epochstypes.WeekEpochID,
+			true,
+			func() {
+				suite.app.InflationKeeper.SetEpochIdentifier(suite.ctx, epochstypes.WeekEpochID)
+			},
+			0,
+			3,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			msgServer := keeper.NewMsgServerImpl(suite.app.InflationKeeper)
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			params.EnableInflation = true
+			suite.app.InflationKeeper.SetParams(suite.ctx, params)
+			tc.malleate()
+
+			pauseCtx := suite.ctx.WithBlockHeight(10)
+			_, err := msgServer.PauseInflation(pauseCtx, &inflationtypes.MsgPauseInflation{Authority: authority})
+			suite.Require().NoError(err)
+			suite.Require().False(suite.app.InflationKeeper.GetParams(suite.ctx).EnableInflation)
+
+			// pausing twice fails
+			_, err = msgServer.PauseInflation(pauseCtx, &inflationtypes.MsgPauseInflation{Authority: authority})
+			suite.Require().ErrorIs(err, inflationtypes.ErrInflationDisabled)
+
+			for i := int64(1); i <= 3; i++ {
+				suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, tc.epochIdentifier, i)
+			}
+
+			epochMintProvision, _ := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount
+
+			resumeCtx := suite.ctx.WithBlockHeight(20)
+			res, err := msgServer.ResumeInflation(resumeCtx, &inflationtypes.MsgResumeInflation{
+				Authority: authority,
+				CatchUp:   tc.catchUp,
+			})
+			suite.Require().NoError(err)
+			suite.Require().True(suite.app.InflationKeeper.GetParams(suite.ctx).EnableInflation)
+
+			expCatchUp := epochMintProvision.TruncateInt().MulRaw(tc.expCatchUpEpochs)
+			suite.Require().Equal(sdk.NewCoin(denomMint, expCatchUp), res.CatchUpAmount)
+			suite.Require().Equal(supply.Add(expCatchUp), suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount)
+			suite.Require().Equal(tc.expSkippedEpochs, suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+			suite.Require().Equal(inflationtypes.InflationPause{
+				Paused:       false,
+				PauseHeight:  10,
+				ResumeHeight: 20,
+			}, suite.app.InflationKeeper.GetInflationPause(suite.ctx))
+
+			// resuming twice fails
+			_, err = msgServer.ResumeInflation(resumeCtx, &inflationtypes.MsgResumeInflation{Authority: authority})
+			suite.Require().ErrorIs(err, inflationtypes.ErrInflationNotPaused)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResumeInflationCatchUpCapped() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.InflationKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	params.MaxSupply = suite.app.BankKeeper.GetSupply(suite.ctx, denomMint).Amount.AddRaw(1_000)
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	_, err := msgServer.PauseInflation(suite.ctx, &inflationtypes.MsgPauseInflation{Authority: authority})
+	suite.Require().NoError(err)
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+
+	res, err := msgServer.ResumeInflation(suite.ctx, &inflationtypes.MsgResumeInflation{
+		Authority: authority,
+		CatchUp:   true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denomMint, sdkmath.NewInt(1_000)), res.CatchUpAmount)
+	suite.Require().True(suite.app.InflationKeeper.GetMaxSupplyReached(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestPauseInflationInvalidAuthority() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.InflationKeeper)
+
+	_, err := msgServer.PauseInflation(suite.ctx, &inflationtypes.MsgPauseInflation{Authority: "invalid"})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.ResumeInflation(suite.ctx, &inflationtypes.MsgResumeInflation{Authority: "invalid"})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
@@ -16,3 +16,34 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// migrateInflationSchedule recalculates the epoch mint provision of the
+// current period after governance switched to another schedule type, so that
+// the new schedule applies without waiting for the next period.
+func (k Keeper) migrateInflationSchedule(ctx sdk.Context, prevSchedule types.InflationSchedule, params types.Params) {
+	bondingFactor := params.Schedule.BondingFactor(k.BondedRatio(ctx))
+	epochMintProvision := types.CalculateAdjustedEpochMintProvision(
+		params,
+		k.GetPeriod(ctx),
+		k.GetEpochsPerPeriod(ctx),
+		bondingFactor,
+	)
+	k.SetBondingFactor(ctx, bondingFactor)
+	k.SetEpochMintProvision(ctx, epochMintProvision)
+
+	k.Logger(ctx).Info(
+		"switched inflation schedule",
+		"previous-schedule", prevSchedule.Name(),
+		"schedule", params.Schedule.Name(),
+		"epoch-mint-provision", epochMintProvision.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwitchSchedule,
+			sdk.NewAttribute(types.AttributeKeyPrevSchedule, prevSchedule.Name()),
+			sdk.NewAttribute(types.AttributeKeySchedule, params.Schedule.Name()),
+			sdk.NewAttribute(types.AttributeKeyEpochProvisions, epochMintProvision.String()),
+		),
+	)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// GetInflationPause returns the latest pause and resume of the inflation
+func (k Keeper) GetInflationPause(ctx sdk.Context) types.InflationPause {
+	var pause types.InflationPause
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.KeyPrefixInflationPause)
+	if len(bz) == 0 {
+		return pause
+	}
+
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause
+}
+
+// SetInflationPause stores the latest pause and resume of the inflation
+func (k Keeper) SetInflationPause(ctx sdk.Context, pause types.InflationPause) {
+	store := k.storeService.OpenKVStore(ctx)
+	store.Set(types.KeyPrefixInflationPause, k.cdc.MustMarshal(&pause))
+}
+
+// PauseInflation disables the inflation and records the height and the number
+// of skipped epochs at which it was paused
+func (k Keeper) PauseInflation(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.EnableInflation {
+		return types.ErrInflationDisabled
+	}
+
+	params.EnableInflation = false
+	k.SetParams(ctx, params)
+
+	pause := k.GetInflationPause(ctx)
+	pause.Paused = true
+	pause.PauseHeight = ctx.BlockHeight()
+	pause.SkippedEpochsAtPause = k.GetSkippedEpochs(ctx)
+	k.SetInflationPause(ctx, pause)
+
+	k.Logger(ctx).Info(
+		"paused inflation",
+		"height", ctx.BlockHeight(),
+		"skipped-epochs", pause.SkippedEpochsAtPause,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseInflation,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return nil
+}
+
+// ResumeInflation enables a paused inflation. If catchUp is set, the current
+// epoch mint provision is minted for each epoch skipped while paused, capped
+// by the max supply, and these epochs no longer count as skipped.
+func (k Keeper) ResumeInflation(ctx sdk.Context, catchUp bool) (sdk.Coin, error) {
+	pause := k.GetInflationPause(ctx)
+	if !pause.Paused {
+		return sdk.Coin{}, types.ErrInflationNotPaused
+	}
+
+	params := k.GetParams(ctx)
+	skippedEpochs := k.GetSkippedEpochs(ctx)
+
+	var missedEpochs uint64
+	if skippedEpochs > pause.SkippedEpochsAtPause {
+		missedEpochs = skippedEpochs - pause.SkippedEpochsAtPause
+	}
+
+	catchUpCoin := sdk.NewCoin(params.MintDenom, sdkmath.ZeroInt())
+	if catchUp && missedEpochs > 0 && !k.GetMaxSupplyReached(ctx) {
+		epochMintProvision, _ := k.GetEpochMintProvision(ctx)
+		amount := epochMintProvision.TruncateInt().Mul(sdkmath.NewIntFromUint64(missedEpochs))
+
+		catchUpCoin = k.capMintedCoin(ctx, params, sdk.NewCoin(params.MintDenom, amount))
+		if catchUpCoin.IsPositive() {
+			if _, err := k.MintAndAllocateInflation(ctx, catchUpCoin); err != nil {
+				return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint the catch-up provision")
+			}
+		}
+
+		// the caught up epochs count towards the current period again
+		k.SetSkippedEpochs(ctx, pause.SkippedEpochsAtPause)
+	}
+
+	params.EnableInflation = true
+	k.SetParams(ctx, params)
+	k.setInflationResumed(ctx, pause)
+
+	k.Logger(ctx).Info(
+		"resumed inflation",
+		"height", ctx.BlockHeight(),
+		"skipped-epochs", missedEpochs,
+		"catch-up", catchUpCoin.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeInflation,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeySkippedEpochs, fmt.Sprintf("%d", missedEpochs)),
+			sdk.NewAttribute(types.AttributeKeyCatchUpAmount, catchUpCoin.String()),
+		),
+	)
+
+	return catchUpCoin, nil
+}
+
+// setInflationResumed records the resume of a paused inflation at the current
+// height
+func (k Keeper) setInflationResumed(ctx sdk.Context, pause types.InflationPause) {
+	pause.Paused = false
+	pause.ResumeHeight = ctx.BlockHeight()
+	k.SetInflationPause(ctx, pause)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// SetVestingAccount adds an account to the index of vesting accounts whose
+// locked coins are excluded from the circulating supply
+func (k Keeper) SetVestingAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixVestingAccount)
+	store.Set(addr.Bytes(), []byte{1})
+}
+
+// DeleteVestingAccount removes an account from the index of vesting accounts
+func (k Keeper) DeleteVestingAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixVestingAccount)
+	store.Delete(addr.Bytes())
+}
+
+// IsVestingAccountIndexed returns true if the account is in the index of
+// vesting accounts
+func (k Keeper) IsVestingAccountIndexed(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixVestingAccount)
+	return store.Has(addr.Bytes())
+}
+
+// IterateVestingAccounts iterates over the indexed vesting accounts and
+// performs a callback function
+func (k Keeper) IterateVestingAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixVestingAccount)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// IndexVestingAccounts adds every vesting account that has not fully vested
+// yet to the index. It iterates over all accounts and is only meant to be run
+// at genesis and on store migrations.
+func (k Keeper) IndexVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		acc, ok := account.(vestingexported.VestingAccount)
+		if ok && acc.GetEndTime() > ctx.BlockTime().Unix() {
+			k.SetVestingAccount(ctx, acc.GetAddress())
+		}
+		return false
+	})
+}
+
+// PruneVestingAccounts removes the accounts that fully vested, or that are no
+// longer vesting accounts, from the index
+func (k Keeper) PruneVestingAccounts(ctx sdk.Context) {
+	var vested []sdk.AccAddress
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		acc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount)
+		if !ok || acc.GetEndTime() <= ctx.BlockTime().Unix() {
+			vested = append(vested, addr)
+		}
+		return false
+	})
+
+	for _, addr := range vested {
+		k.DeleteVestingAccount(ctx, addr)
+	}
+}
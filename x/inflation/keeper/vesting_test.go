@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/keeper"
+)
+
+func (suite *KeeperTestSuite) TestIndexVestingAccounts() {
+	suite.SetupTest()
+
+	// the app doesn't include the vesting module, so its account types are
+	// registered here
+	vestingtypes.RegisterInterfaces(suite.app.InterfaceRegistry())
+
+	newVestingAccount := func(end time.Time) sdk.AccAddress {
+		addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+		baseAccount := authtypes.NewBaseAccountWithAddress(addr)
+		baseAccount.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 100))
+		start := suite.ctx.BlockTime().Add(-2 * time.Hour).Unix()
+		account, err := vestingtypes.NewContinuousVestingAccount(baseAccount, coins, start, end.Unix())
+		suite.Require().NoError(err)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+		return addr
+	}
+
+	vesting := newVestingAccount(suite.ctx.BlockTime().Add(time.Hour))
+	vested := newVestingAccount(suite.ctx.BlockTime().Add(-time.Hour))
+	plain := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, plain))
+
+	// the store migration indexes the accounts that are still vesting
+	suite.Require().NoError(keeper.NewMigrator(suite.app.InflationKeeper).Migrate7to8(suite.ctx))
+	suite.Require().True(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, vesting))
+	suite.Require().False(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, vested))
+	suite.Require().False(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, plain))
+
+	// pruning removes the accounts that are not vesting accounts anymore
+	suite.app.InflationKeeper.SetVestingAccount(suite.ctx, plain)
+	suite.app.InflationKeeper.PruneVestingAccounts(suite.ctx)
+	suite.Require().True(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, vesting))
+	suite.Require().False(suite.app.InflationKeeper.IsVestingAccountIndexed(suite.ctx, plain))
+}
@@ -25,7 +25,7 @@ func UpdateParams(ctx sdk.Context, ik InflationKeeper) error {
 
 	ctx.Logger().Info("Setting Inflation Parameters")
 
-	params.ExponentialCalculation = newExp
+	params.Schedule = types.NewExponentialSchedule(newExp)
 	ik.SetParams(ctx, params)
 
 	//update EpochsPerPeriod
@@ -0,0 +1,46 @@
+package v3
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// MigrateInflationDistribution replaces the staking rewards and community
+// pool proportions of the inflation distribution with the equivalent weighted
+// recipients.
+//
+//nolint:staticcheck // migrating the deprecated proportions
+func MigrateInflationDistribution(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	var distribution types.InflationDistribution
+	paramstore.Get(ctx, types.ParamStoreKeyInflationDistribution, &distribution)
+
+	if len(distribution.Recipients) == 0 {
+		stakingRewards := distribution.StakingRewards
+		if stakingRewards.IsNil() {
+			stakingRewards = sdkmath.LegacyZeroDec()
+		}
+		communityPool := distribution.CommunityPool
+		if communityPool.IsNil() {
+			communityPool = sdkmath.LegacyZeroDec()
+		}
+
+		distribution = types.NewInflationDistribution(
+			types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, stakingRewards),
+			types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", communityPool),
+		)
+	}
+
+	ctx.Logger().Info("Migrating inflation distribution to weighted recipients")
+
+	paramstore.Set(ctx, types.ParamStoreKeyInflationDistribution, distribution)
+	return nil
+}
@@ -0,0 +1,45 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v3 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v3"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+func TestMigrateInflationDistribution(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tInflationKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(inflationKey, tInflationKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, inflationKey, tInflationKey, "inflation",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// store the distribution with the deprecated proportions
+	paramstore.Set(ctx, types.ParamStoreKeyInflationDistribution, types.InflationDistribution{
+		StakingRewards: sdkmath.LegacyNewDecWithPrec(8, 1),
+		CommunityPool:  sdkmath.LegacyNewDecWithPrec(2, 1),
+	})
+
+	require.NoError(t, v3.MigrateInflationDistribution(ctx, &paramstore))
+
+	var distribution types.InflationDistribution
+	paramstore.Get(ctx, types.ParamStoreKeyInflationDistribution, &distribution)
+	require.Equal(t, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, sdkmath.LegacyNewDecWithPrec(8, 1)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(2, 1)),
+	), distribution)
+}
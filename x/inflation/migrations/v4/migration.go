@@ -0,0 +1,21 @@
+package v4
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// UpdateParams sets the max supply parameter to zero, which keeps the supply
+// uncapped.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyMaxSupply, sdkmath.ZeroInt())
+	return nil
+}
@@ -0,0 +1,42 @@
+package v4_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v4 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v4"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tInflationKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(inflationKey, tInflationKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, inflationKey, tInflationKey, "inflation",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyMaxSupply))
+
+	// Run migrations
+	require.NoError(t, v4.UpdateParams(ctx, &paramstore))
+
+	// check the supply is not capped
+	var maxSupply sdkmath.Int
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, types.ParamStoreKeyMaxSupply, &maxSupply)
+	})
+	require.True(t, maxSupply.IsZero())
+}
@@ -0,0 +1,33 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+// ParamStoreKeyExponentialCalculation is the legacy parameter store key of the
+// exponential calculation, which is no longer registered on the key table.
+var ParamStoreKeyExponentialCalculation = []byte("ParamStoreKeyExponentialCalculation")
+
+// MigrateInflationSchedule moves the stored exponential calculation into an
+// exponential inflation schedule.
+func MigrateInflationSchedule(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	var calculation types.ExponentialCalculation
+	bz := paramstore.GetRaw(ctx, ParamStoreKeyExponentialCalculation)
+	if err := codec.NewLegacyAmino().UnmarshalJSON(bz, &calculation); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Migrating exponential calculation to the inflation schedule")
+
+	paramstore.Set(ctx, types.ParamStoreKeyInflationSchedule, types.NewExponentialSchedule(calculation))
+	return nil
+}
@@ -0,0 +1,55 @@
+package v5_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v5 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v5"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+func TestMigrateInflationSchedule(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	types.RegisterLegacyAminoCodec(encCfg.Amino)
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tInflationKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(inflationKey, tInflationKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, inflationKey, tInflationKey, "inflation",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// store the exponential calculation under its legacy key
+	calculation := types.ExponentialCalculation{
+		A:             sdkmath.LegacyNewDec(int64(16_304_348)),
+		R:             sdkmath.LegacyNewDecWithPrec(35, 2),
+		C:             sdkmath.LegacyZeroDec(),
+		BondingTarget: sdkmath.LegacyNewDecWithPrec(80, 2),
+		MaxVariance:   sdkmath.LegacyZeroDec(),
+	}
+	bz, err := encCfg.Amino.MarshalJSON(calculation)
+	require.NoError(t, err)
+	store := prefix.NewStore(ctx.KVStore(inflationKey), []byte("inflation/"))
+	store.Set(v5.ParamStoreKeyExponentialCalculation, bz)
+
+	// check no schedule
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyInflationSchedule))
+
+	// Run migrations
+	require.NoError(t, v5.MigrateInflationSchedule(ctx, &paramstore))
+
+	var schedule types.InflationSchedule
+	paramstore.Get(ctx, types.ParamStoreKeyInflationSchedule, &schedule)
+	require.Equal(t, types.NewExponentialSchedule(calculation), schedule)
+	require.NoError(t, schedule.Validate())
+}
@@ -0,0 +1,41 @@
+package v6_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v6 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v6"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tInflationKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(inflationKey, tInflationKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, inflationKey, tInflationKey, "inflation",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyExcludedAddresses))
+
+	// Run migrations
+	require.NoError(t, v6.UpdateParams(ctx, &paramstore))
+
+	// check no address is excluded
+	var excludedAddresses []string
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, types.ParamStoreKeyExcludedAddresses, &excludedAddresses)
+	})
+	require.Empty(t, excludedAddresses)
+}
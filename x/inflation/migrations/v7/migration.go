@@ -0,0 +1,32 @@
+package v7
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+type InflationKeeper interface {
+	BondedRatio(ctx sdk.Context) sdkmath.LegacyDec
+	SetBondingFactor(ctx sdk.Context, bondingFactor sdkmath.LegacyDec)
+}
+
+// MigrateEpochBondingAdjustment sets the epoch bonding adjustment parameter to
+// its disabled default and stores the bonding factor of the current bonded
+// ratio, which the first adjusted epoch is smoothed from.
+func MigrateEpochBondingAdjustment(ctx sdk.Context, paramstore *paramtypes.Subspace, ik InflationKeeper) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyEpochBondingAdjustment, types.DefaultEpochBondingAdjustment())
+
+	var schedule types.InflationSchedule
+	paramstore.Get(ctx, types.ParamStoreKeyInflationSchedule, &schedule)
+	ik.SetBondingFactor(ctx, schedule.BondingFactor(ik.BondedRatio(ctx)))
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package v7_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	v7 "github.com/TucanaProtocol/Tucana/v8/x/inflation/migrations/v7"
+	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
+)
+
+type mockInflationKeeper struct {
+	bondedRatio   sdkmath.LegacyDec
+	bondingFactor sdkmath.LegacyDec
+}
+
+func (m *mockInflationKeeper) BondedRatio(_ sdk.Context) sdkmath.LegacyDec {
+	return m.bondedRatio
+}
+
+func (m *mockInflationKeeper) SetBondingFactor(_ sdk.Context, bondingFactor sdkmath.LegacyDec) {
+	m.bondingFactor = bondingFactor
+}
+
+func TestMigrateEpochBondingAdjustment(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	types.RegisterLegacyAminoCodec(encCfg.Amino)
+	inflationKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tInflationKey := storetypes.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(inflationKey, tInflationKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, inflationKey, tInflationKey, "inflation",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	schedule := types.DefaultInflationSchedule()
+	schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+	paramstore.Set(ctx, types.ParamStoreKeyInflationSchedule, schedule)
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyEpochBondingAdjustment))
+
+	// Run migrations
+	ik := &mockInflationKeeper{bondedRatio: sdkmath.LegacyNewDecWithPrec(40, 2)}
+	require.NoError(t, v7.MigrateEpochBondingAdjustment(ctx, &paramstore, ik))
+
+	// check the adjustment is disabled
+	var adjustment types.EpochBondingAdjustment
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, types.ParamStoreKeyEpochBondingAdjustment, &adjustment)
+	})
+	require.Equal(t, types.DefaultEpochBondingAdjustment(), adjustment)
+
+	// 1 + 0.4 - 0.4 * (0.4 / 0.8)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(12, 1), ik.bondingFactor)
+}
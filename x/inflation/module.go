@@ -58,7 +58,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // ConsensusVersion returns the consensus state-breaking version for the module.
 func (AppModuleBasic) ConsensusVersion() uint64 {
-	return 2 // set verison to 2
+	return 8
 }
 
 // RegisterInterfaces registers interfaces and implementations of the incentives
@@ -155,6 +155,30 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Errorf("FAILURE IN MIGRATION from v1 to v2 %s: %w", types.ModuleName, err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v2 to v3 %s: %w", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v3 to v4 %s: %w", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 4, migrator.Migrate4to5); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v4 to v5 %s: %w", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, migrator.Migrate5to6); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v5 to v6 %s: %w", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, migrator.Migrate6to7); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v6 to v7 %s: %w", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 7, migrator.Migrate7to8); err != nil {
+		panic(fmt.Errorf("FAILURE IN MIGRATION from v7 to v8 %s: %w", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the inflation module. It returns
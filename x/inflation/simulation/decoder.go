@@ -47,6 +47,24 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			seB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", seA, seB)
 
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMaxSupplyReached):
+			return fmt.Sprintf("%v\n%v", len(kvA.Value) != 0, len(kvB.Value) != 0)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBondingFactor):
+			var bfA, bfB sdkmath.LegacyDec
+			bfA.Unmarshal(kvA.Value)
+			bfB.Unmarshal(kvB.Value)
+			return fmt.Sprintf("%v\n%v", bfA, bfB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixInflationPause):
+			var ipA, ipB types.InflationPause
+			cdc.MustUnmarshal(kvA.Value, &ipA)
+			cdc.MustUnmarshal(kvB.Value, &ipB)
+			return fmt.Sprintf("%v\n%v", ipA, ipB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixVestingAccount):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Key[1:]), sdk.AccAddress(kvB.Key[1:]))
+
 		default:
 			panic(fmt.Sprintf("invalid farming key prefix %X", kvA.Key[:1]))
 		}
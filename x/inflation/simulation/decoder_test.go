@@ -25,8 +25,12 @@ func TestInflationStore(t *testing.T) {
 	epochIdentifier := "epochIdentifier"
 	epochPerPeriod := uint64(3)
 	skippedEpoch := uint64(4)
+	bondingFactor := sdkmath.LegacyNewDecWithPrec(12, 1)
 
 	marshaled, _ := epochMintProvision.Marshal()
+	marshaledBondingFactor, _ := bondingFactor.Marshal()
+	inflationPause := types.InflationPause{Paused: true, PauseHeight: 5, SkippedEpochsAtPause: 2}
+	vestingAccount := sdk.AccAddress([]byte("vesting_account_____"))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -35,6 +39,10 @@ func TestInflationStore(t *testing.T) {
 			{Key: types.KeyPrefixEpochIdentifier, Value: []byte(epochIdentifier)},
 			{Key: types.KeyPrefixEpochsPerPeriod, Value: sdk.Uint64ToBigEndian(epochPerPeriod)},
 			{Key: types.KeyPrefixSkippedEpochs, Value: sdk.Uint64ToBigEndian(skippedEpoch)},
+			{Key: types.KeyPrefixMaxSupplyReached, Value: []byte{1}},
+			{Key: types.KeyPrefixBondingFactor, Value: marshaledBondingFactor},
+			{Key: types.KeyPrefixInflationPause, Value: cdc.MustMarshal(&inflationPause)},
+			{Key: append(types.KeyPrefixVestingAccount, vestingAccount...), Value: []byte{1}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -48,6 +56,10 @@ func TestInflationStore(t *testing.T) {
 		{"EpochIdentifier", fmt.Sprintf("%v\n%v", epochIdentifier, epochIdentifier)},
 		{"EpochsPerPeriod", fmt.Sprintf("%v\n%v", epochPerPeriod, epochPerPeriod)},
 		{"SkippedEpochs", fmt.Sprintf("%v\n%v", skippedEpoch, skippedEpoch)},
+		{"MaxSupplyReached", fmt.Sprintf("%v\n%v", true, true)},
+		{"BondingFactor", fmt.Sprintf("%v\n%v", bondingFactor, bondingFactor)},
+		{"InflationPause", fmt.Sprintf("%v\n%v", inflationPause, inflationPause)},
+		{"VestingAccount", fmt.Sprintf("%v\n%v", vestingAccount, vestingAccount)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
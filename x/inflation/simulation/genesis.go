@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
 )
@@ -17,14 +18,14 @@ import (
 
 // simulation parameter constants
 const (
-	mintDenom              = "mint_denom"
-	exponentialCalculation = "exponential_calculation"
-	inflationDistribution  = "inflation_distribution"
-	enableInflation        = "enable_inflation"
-	period                 = "period"
-	epochIdentifier        = "epoch_identifier"
-	epochsPerPeriod        = "epochs_per_period"
-	skippedEpochs          = "skipped_epochs"
+	mintDenom             = "mint_denom"
+	inflationSchedule     = "inflation_schedule"
+	inflationDistribution = "inflation_distribution"
+	enableInflation       = "enable_inflation"
+	period                = "period"
+	epochIdentifier       = "epoch_identifier"
+	epochsPerPeriod       = "epochs_per_period"
+	skippedEpochs         = "skipped_epochs"
 )
 
 func generateRandomBool(r *rand.Rand) bool {
@@ -45,15 +46,45 @@ func generateExponentialCalculation(r *rand.Rand) types.ExponentialCalculation {
 	}
 }
 
+func generateStepSchedule(r *rand.Rand) types.InflationSchedule {
+	steps := make([]types.ProvisionStep, simtypes.RandIntBetween(r, 1, 5))
+	startPeriod := uint64(0)
+	for i := range steps {
+		steps[i] = types.NewProvisionStep(startPeriod, sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 0, 10000000))))
+		startPeriod += uint64(simtypes.RandIntBetween(r, 1, 10))
+	}
+	return types.NewStepSchedule(steps...)
+}
+
+func generateLinearSchedule(r *rand.Rand) types.InflationSchedule {
+	minProvision := sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 0, 1000000)))
+	return types.NewLinearSchedule(
+		minProvision.Add(sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 0, 10000000)))),
+		sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 0, 1000000))),
+		minProvision,
+	)
+}
+
+func generateInflationSchedule(r *rand.Rand) types.InflationSchedule {
+	switch r.Intn(3) {
+	case 0:
+		return generateStepSchedule(r)
+	case 1:
+		return generateLinearSchedule(r)
+	default:
+		return types.NewExponentialSchedule(generateExponentialCalculation(r))
+	}
+}
+
 func generateInflationDistribution(r *rand.Rand) types.InflationDistribution {
 
 	stakingRewards := sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
 	communityPool := sdkmath.LegacyNewDec(1).Sub(stakingRewards)
 
-	return types.InflationDistribution{
-		StakingRewards: stakingRewards,
-		CommunityPool:  communityPool,
-	}
+	return types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, stakingRewards),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", communityPool),
+	)
 }
 
 func generateEnableInflation(r *rand.Rand) bool {
@@ -87,8 +118,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	simState.AppParams.GetOrGenerate(
-		exponentialCalculation, &genesis.Params.ExponentialCalculation, simState.Rand,
-		func(r *rand.Rand) { genesis.Params.ExponentialCalculation = generateExponentialCalculation(r) },
+		inflationSchedule, &genesis.Params.Schedule, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.Schedule = generateInflationSchedule(r) },
 	)
 
 	simState.AppParams.GetOrGenerate(
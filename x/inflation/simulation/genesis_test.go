@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/simulation"
 	"github.com/TucanaProtocol/Tucana/v8/x/inflation/types"
@@ -40,22 +41,21 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
 
 	require.Equal(t, "stake", genState.Params.MintDenom)
-	require.Equal(t, types.ExponentialCalculation{
-		A:             sdkmath.LegacyNewDec(2712964),
-		R:             sdkmath.LegacyNewDecWithPrec(11, 2),
-		C:             sdkmath.LegacyZeroDec(),
-		BondingTarget: sdkmath.LegacyNewDecWithPrec(94, 2),
-		MaxVariance:   sdkmath.LegacyZeroDec(),
-	}, genState.Params.ExponentialCalculation)
-	require.Equal(t, types.InflationDistribution{
-		StakingRewards: sdkmath.LegacyNewDecWithPrec(1, 1),
-		CommunityPool:  sdkmath.LegacyNewDecWithPrec(9, 1),
-	}, genState.Params.InflationDistribution)
-	require.Equal(t, false, genState.Params.EnableInflation)
-	require.Equal(t, uint64(1654145), genState.Period)
+	require.Equal(t, types.NewStepSchedule(
+		types.NewProvisionStep(0, sdkmath.LegacyNewDec(2509176)),
+		types.NewProvisionStep(3, sdkmath.LegacyNewDec(1617859)),
+		types.NewProvisionStep(10, sdkmath.LegacyNewDec(6634432)),
+		types.NewProvisionStep(13, sdkmath.LegacyNewDec(5100827)),
+	), genState.Params.Schedule)
+	require.Equal(t, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, sdkmath.LegacyNewDecWithPrec(64, 2)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(36, 2)),
+	), genState.Params.InflationDistribution)
+	require.Equal(t, true, genState.Params.EnableInflation)
+	require.Equal(t, uint64(2037157), genState.Period)
 	require.Equal(t, "day", genState.EpochIdentifier)
-	require.Equal(t, int64(6634432), genState.EpochsPerPeriod)
-	require.Equal(t, uint64(5142676), genState.SkippedEpochs)
+	require.Equal(t, int64(7360966), genState.EpochsPerPeriod)
+	require.Equal(t, uint64(339578), genState.SkippedEpochs)
 
 }
 
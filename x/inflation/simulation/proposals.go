@@ -36,7 +36,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params := types.DefaultParams()
 
 	params.MintDenom = generateMintDenom(r)
-	params.ExponentialCalculation = generateExponentialCalculation(r)
+	params.Schedule = generateInflationSchedule(r)
 	params.InflationDistribution = generateInflationDistribution(r)
 	params.EnableInflation = generateRandomBool(r)
 
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,16 +39,16 @@ func TestProposalMsgs(t *testing.T) {
 
 	require.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgUpdateParams.Authority)
 	require.Equal(t, sdk.DefaultBondDenom, msgUpdateParams.Params.MintDenom) //nolint:staticcheck // we're testing deprecated code here
-	require.Equal(t, types.ExponentialCalculation{
-		A:             sdkmath.LegacyNewDec(6122540),
-		R:             sdkmath.LegacyNewDecWithPrec(56, 2),
+	require.Equal(t, types.NewExponentialSchedule(types.ExponentialCalculation{
+		A:             sdkmath.LegacyNewDec(8240456),
+		R:             sdkmath.LegacyZeroDec(),
 		C:             sdkmath.LegacyZeroDec(),
-		BondingTarget: sdkmath.LegacyNewDecWithPrec(7, 2),
+		BondingTarget: sdkmath.LegacyNewDecWithPrec(60, 2),
 		MaxVariance:   sdkmath.LegacyZeroDec(),
-	}, msgUpdateParams.Params.ExponentialCalculation)
-	require.Equal(t, types.InflationDistribution{
-		StakingRewards: sdkmath.LegacyNewDecWithPrec(94, 2),
-		CommunityPool:  sdkmath.LegacyNewDecWithPrec(6, 2),
-	}, msgUpdateParams.Params.InflationDistribution)
-	require.Equal(t, false, msgUpdateParams.Params.EnableInflation)
+	}), msgUpdateParams.Params.Schedule)
+	require.Equal(t, types.NewInflationDistribution(
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, sdkmath.LegacyNewDecWithPrec(11, 2)),
+		types.NewInflationRecipient(types.RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(89, 2)),
+	), msgUpdateParams.Params.InflationDistribution)
+	require.Equal(t, true, msgUpdateParams.Params.EnableInflation)
 }
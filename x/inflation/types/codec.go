@@ -28,6 +28,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgPauseInflation{},
+		&MsgResumeInflation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -36,5 +38,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // register inflation msg types for Amino Codec in adherence to EIP-712 signing conventions
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "canto/x/inflation/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgPauseInflation{}, "canto/x/inflation/MsgPauseInflation", nil)
+	cdc.RegisterConcrete(&MsgResumeInflation{}, "canto/x/inflation/MsgResumeInflation", nil)
 	cdc.RegisterConcrete(&Params{}, "canto/x/inflation/Params", nil)
+
+	cdc.RegisterInterface((*isInflationSchedule_Schedule)(nil), nil)
+	cdc.RegisterConcrete(&InflationSchedule_Exponential{}, "canto/x/inflation/ExponentialSchedule", nil)
+	cdc.RegisterConcrete(&InflationSchedule_Step{}, "canto/x/inflation/StepSchedule", nil)
+	cdc.RegisterConcrete(&InflationSchedule_Linear{}, "canto/x/inflation/LinearSchedule", nil)
 }
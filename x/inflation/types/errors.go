@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInflationDisabled          = errorsmod.Register(ModuleName, 2, "inflation is already paused or disabled")
+	ErrInflationNotPaused         = errorsmod.Register(ModuleName, 3, "inflation is not paused")
+	ErrEpochMintProvisionNotFound = errorsmod.Register(ModuleName, 4, "the epochMintProvision was not found")
+)
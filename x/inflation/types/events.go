@@ -2,8 +2,20 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMaxSupplyReached = "max_supply_reached"
+	EventTypeSwitchSchedule   = "switch_inflation_schedule"
+	EventTypePauseInflation   = "pause_inflation"
+	EventTypeResumeInflation  = "resume_inflation"
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyAllocation      = "allocation"
+	AttributeKeyMaxSupply       = "max_supply"
+	AttributeKeyPrevSchedule    = "previous_schedule"
+	AttributeKeySchedule        = "schedule"
+	AttributeKeyBondingFactor   = "bonding_factor"
+	AttributeKeyHeight          = "height"
+	AttributeKeySkippedEpochs   = "skipped_epochs"
+	AttributeKeyCatchUpAmount   = "catch_up_amount"
 )
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -36,6 +38,10 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// true once minting stopped because the supply reached max_supply
+	MaxSupplyReached bool `protobuf:"varint,6,opt,name=max_supply_reached,json=maxSupplyReached,proto3" json:"max_supply_reached,omitempty"`
+	// latest pause and resume of the inflation
+	InflationPause InflationPause `protobuf:"bytes,7,opt,name=inflation_pause,json=inflationPause,proto3" json:"inflation_pause"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,16 +112,41 @@ func (m *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetMaxSupplyReached() bool {
+	if m != nil {
+		return m.MaxSupplyReached
+	}
+	return false
+}
+
+func (m *GenesisState) GetInflationPause() InflationPause {
+	if m != nil {
+		return m.InflationPause
+	}
+	return InflationPause{}
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// variables to calculate exponential inflation
-	ExponentialCalculation ExponentialCalculation `protobuf:"bytes,2,opt,name=exponential_calculation,json=exponentialCalculation,proto3" json:"exponential_calculation"`
+	// Deprecated: the exponential calculation is set through the schedule.
+	ExponentialCalculation *ExponentialCalculation `protobuf:"bytes,2,opt,name=exponential_calculation,json=exponentialCalculation,proto3" json:"exponential_calculation,omitempty"` // Deprecated: Do not use.
 	// inflation distribution of the minted denom
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// parameter to enable inflation and halt increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// maximum bank supply of the mint denom. Minting stops permanently once the
+	// supply reaches it. A zero value disables the cap.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// schedule that calculates the annual provision of each period
+	Schedule InflationSchedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule"`
+	// addresses whose mint denom balances are excluded from the circulating
+	// supply, in addition to module accounts and locked vesting coins
+	ExcludedAddresses []string `protobuf:"bytes,7,rep,name=excluded_addresses,json=excludedAddresses,proto3" json:"excluded_addresses,omitempty"`
+	// recalculation of the bonding incentive on every epoch
+	EpochBondingAdjustment EpochBondingAdjustment `protobuf:"bytes,8,opt,name=epoch_bonding_adjustment,json=epochBondingAdjustment,proto3" json:"epoch_bonding_adjustment"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -158,11 +189,12 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
-func (m *Params) GetExponentialCalculation() ExponentialCalculation {
+// Deprecated: Do not use.
+func (m *Params) GetExponentialCalculation() *ExponentialCalculation {
 	if m != nil {
 		return m.ExponentialCalculation
 	}
-	return ExponentialCalculation{}
+	return nil
 }
 
 func (m *Params) GetInflationDistribution() InflationDistribution {
@@ -179,6 +211,27 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetSchedule() InflationSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return InflationSchedule{}
+}
+
+func (m *Params) GetExcludedAddresses() []string {
+	if m != nil {
+		return m.ExcludedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetEpochBondingAdjustment() EpochBondingAdjustment {
+	if m != nil {
+		return m.EpochBondingAdjustment
+	}
+	return EpochBondingAdjustment{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "canto.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "canto.inflation.v1.Params")
@@ -187,36 +240,49 @@ func init() {
 func init() { proto.RegisterFile("canto/inflation/v1/genesis.proto", fileDescriptor_5da850aabf0c3ac5) }
 
 var fileDescriptor_5da850aabf0c3ac5 = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x36, 0x21, 0x22, 0x5b, 0xa0, 0x74, 0x05, 0xc1, 0x8a, 0x54, 0x63, 0x45, 0x42, 0x4a,
-	0x23, 0x61, 0xab, 0xed, 0xa5, 0xe2, 0xd8, 0x1f, 0xa1, 0x1e, 0x40, 0x91, 0xb9, 0x71, 0xb1, 0x36,
-	0xf6, 0x34, 0x5d, 0x25, 0xde, 0x5d, 0xd9, 0x9b, 0x50, 0x5e, 0x81, 0x13, 0x8f, 0xc1, 0xb1, 0x8f,
-	0xd1, 0x13, 0xea, 0x91, 0x53, 0x85, 0x92, 0x43, 0x5f, 0x03, 0x79, 0xd6, 0x38, 0x41, 0xf8, 0x62,
-	0x79, 0xbe, 0xf9, 0xe6, 0xfb, 0x66, 0x66, 0x87, 0x7a, 0x31, 0x97, 0x46, 0x05, 0x42, 0x5e, 0xce,
-	0xb8, 0x11, 0x4a, 0x06, 0x8b, 0x83, 0x60, 0x02, 0x12, 0x72, 0x91, 0xfb, 0x3a, 0x53, 0x46, 0x31,
-	0x86, 0x0c, 0xbf, 0x62, 0xf8, 0x8b, 0x83, 0xde, 0x8b, 0x89, 0x9a, 0x28, 0x4c, 0x07, 0xc5, 0x9f,
-	0x65, 0xf6, 0x76, 0x79, 0x2a, 0xa4, 0x0a, 0xf0, 0x5b, 0x42, 0xfd, 0x1a, 0xf9, 0xb5, 0x12, 0x72,
-	0xfa, 0xf7, 0x84, 0x3e, 0x79, 0x6f, 0x2d, 0x3f, 0x19, 0x6e, 0x80, 0x1d, 0xd3, 0xb6, 0xe6, 0x19,
-	0x4f, 0x73, 0x87, 0x78, 0x64, 0xb0, 0x7d, 0xd8, 0xf3, 0xff, 0x6f, 0xc1, 0x1f, 0x21, 0xe3, 0xa4,
-	0x75, 0x7b, 0xff, 0xba, 0x11, 0x96, 0x7c, 0xd6, 0xa5, 0x6d, 0x0d, 0x99, 0x50, 0x89, 0xb3, 0xe5,
-	0x91, 0x41, 0x2b, 0x2c, 0x23, 0xb6, 0x4f, 0x9f, 0x83, 0x56, 0xf1, 0x55, 0x24, 0x12, 0x90, 0x46,
-	0x5c, 0x0a, 0xc8, 0x9c, 0xa6, 0x47, 0x06, 0x9d, 0x70, 0x07, 0xf1, 0x8b, 0x0a, 0x66, 0x43, 0xba,
-	0x8b, 0x50, 0x1e, 0x69, 0xc8, 0xa2, 0x52, 0xad, 0xe5, 0x91, 0x41, 0xb3, 0xe4, 0xe6, 0x23, 0xc8,
-	0x46, 0x56, 0xf6, 0x0d, 0x7d, 0x96, 0x4f, 0x85, 0xd6, 0x90, 0x44, 0x36, 0xe5, 0x3c, 0x42, 0xdb,
-	0xa7, 0x25, 0x7a, 0x8e, 0x60, 0xff, 0xe7, 0x16, 0x6d, 0xdb, 0x76, 0xd9, 0x1e, 0xa5, 0xa9, 0x90,
-	0x26, 0x4a, 0x40, 0xaa, 0x14, 0xc7, 0xeb, 0x84, 0x9d, 0x02, 0x39, 0x2b, 0x00, 0x26, 0xe9, 0x2b,
-	0xb8, 0xd6, 0x4a, 0x16, 0xdd, 0xf0, 0x59, 0x14, 0xf3, 0x59, 0x3c, 0xb7, 0x23, 0xe3, 0x40, 0xdb,
-	0x87, 0xc3, 0xba, 0x55, 0x9c, 0xaf, 0x4b, 0x4e, 0xd7, 0x15, 0x27, 0x9d, 0x62, 0x35, 0x3f, 0x1e,
-	0x6e, 0x86, 0x24, 0xec, 0x42, 0x2d, 0x85, 0x4d, 0x69, 0xb7, 0x52, 0x8a, 0x12, 0x91, 0x9b, 0x4c,
-	0x8c, 0xe7, 0x68, 0xd7, 0x44, 0xbb, 0xfd, 0x3a, 0xbb, 0x8b, 0xbf, 0xc1, 0xd9, 0x46, 0xc1, 0xa6,
-	0xdb, 0x4b, 0x51, 0xc7, 0xc0, 0x47, 0x90, 0x7c, 0x3c, 0x83, 0xa8, 0xca, 0xe3, 0x62, 0x1f, 0x87,
-	0x3b, 0x16, 0xaf, 0x84, 0xdf, 0xed, 0x7d, 0x7b, 0xb8, 0x19, 0x3a, 0xf6, 0x76, 0xae, 0x37, 0xae,
-	0xa7, 0x7c, 0xf4, 0x0f, 0xb7, 0x4b, 0x97, 0xdc, 0x2d, 0x5d, 0xf2, 0x7b, 0xe9, 0x92, 0xef, 0x2b,
-	0xb7, 0x71, 0xb7, 0x72, 0x1b, 0xbf, 0x56, 0x6e, 0xe3, 0xf3, 0xd1, 0x44, 0x98, 0xab, 0xf9, 0xd8,
-	0x8f, 0x55, 0x1a, 0x9c, 0x16, 0xe5, 0x6f, 0x3f, 0x82, 0xf9, 0xa2, 0xb2, 0xa9, 0x8d, 0x82, 0xc5,
-	0xf1, 0x3f, 0x7a, 0xe6, 0xab, 0x86, 0x7c, 0xdc, 0xc6, 0x3b, 0x3c, 0xfa, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0xb4, 0x6e, 0xff, 0x54, 0x0c, 0x03, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x18, 0x8d, 0x09, 0x84, 0x78, 0xf8, 0x7f, 0x2e, 0x23, 0x48, 0x5d, 0x24, 0x82, 0x15, 0x09, 0x29,
+	0x44, 0xc5, 0x2e, 0xb0, 0x41, 0xdd, 0x11, 0x40, 0x08, 0xa9, 0x97, 0xd4, 0xd9, 0x75, 0x63, 0x4d,
+	0xec, 0xc1, 0x99, 0xc6, 0x9e, 0xb1, 0x3c, 0x63, 0x1a, 0x5e, 0xa1, 0xab, 0x3e, 0x42, 0x97, 0x5d,
+	0xb2, 0xe0, 0x21, 0x58, 0x22, 0x56, 0x55, 0x17, 0xb4, 0x82, 0x05, 0xaf, 0x51, 0x79, 0xc6, 0x38,
+	0x41, 0x8d, 0xd8, 0x20, 0xbe, 0xf3, 0x9d, 0xf3, 0xdd, 0x4e, 0x3c, 0xc0, 0xf4, 0x10, 0x15, 0xcc,
+	0x26, 0xf4, 0x34, 0x44, 0x82, 0x30, 0x6a, 0x9f, 0x6d, 0xdb, 0x01, 0xa6, 0x98, 0x13, 0x6e, 0xc5,
+	0x09, 0x13, 0x0c, 0x42, 0xc9, 0xb0, 0x0a, 0x86, 0x75, 0xb6, 0xbd, 0xba, 0x1c, 0xb0, 0x80, 0xc9,
+	0xb4, 0x9d, 0xfd, 0xa7, 0x98, 0xab, 0x4b, 0x28, 0x22, 0x94, 0xd9, 0xf2, 0x6f, 0x0e, 0xbd, 0xf4,
+	0x18, 0x8f, 0x18, 0x77, 0x15, 0x57, 0x05, 0x79, 0xaa, 0x31, 0xa1, 0xf3, 0xa8, 0x89, 0xe4, 0x34,
+	0x7e, 0x4f, 0x81, 0xff, 0x8e, 0xd5, 0x34, 0x5d, 0x81, 0x04, 0x86, 0x7b, 0xa0, 0x12, 0xa3, 0x04,
+	0x45, 0xdc, 0xd0, 0x4c, 0xad, 0x39, 0xb7, 0xb3, 0x6a, 0xfd, 0x3b, 0x9d, 0xd5, 0x91, 0x8c, 0xf6,
+	0xf4, 0xd5, 0xed, 0x7a, 0xc9, 0xc9, 0xf9, 0xb0, 0x06, 0x2a, 0x31, 0x4e, 0x08, 0xf3, 0x8d, 0x29,
+	0x53, 0x6b, 0x4e, 0x3b, 0x79, 0x04, 0x37, 0xc1, 0x22, 0x8e, 0x99, 0xd7, 0x77, 0x89, 0x8f, 0xa9,
+	0x20, 0xa7, 0x04, 0x27, 0x46, 0xd9, 0xd4, 0x9a, 0xba, 0xb3, 0x20, 0xf1, 0x93, 0x02, 0x86, 0x2d,
+	0xb0, 0x24, 0x21, 0xee, 0xc6, 0x38, 0x71, 0xf3, 0x6a, 0xd3, 0xa6, 0xd6, 0x2c, 0xe7, 0x5c, 0xde,
+	0xc1, 0x49, 0x47, 0x95, 0xdd, 0x00, 0xf3, 0x7c, 0x40, 0xe2, 0x18, 0xfb, 0xae, 0x4a, 0x19, 0x33,
+	0xb2, 0xed, 0xff, 0x39, 0x7a, 0x24, 0x41, 0xf8, 0x0a, 0xc0, 0x08, 0x0d, 0x5d, 0x9e, 0xc6, 0x71,
+	0x78, 0xee, 0x26, 0x18, 0x79, 0x7d, 0xec, 0x1b, 0x15, 0x53, 0x6b, 0x56, 0x9d, 0xc5, 0x08, 0x0d,
+	0xbb, 0x32, 0xe1, 0x28, 0x1c, 0x7e, 0x04, 0x0b, 0xc5, 0xa2, 0x6e, 0x8c, 0x52, 0x8e, 0x8d, 0x59,
+	0x79, 0x86, 0xc6, 0xa4, 0x33, 0x9c, 0x3c, 0x06, 0x9d, 0x8c, 0x99, 0x9f, 0x63, 0x9e, 0x3c, 0x41,
+	0x1b, 0xdf, 0x67, 0x40, 0x45, 0xdd, 0x0b, 0xae, 0x01, 0x10, 0x11, 0x2a, 0x5c, 0x1f, 0x53, 0x16,
+	0xc9, 0xfb, 0xea, 0x8e, 0x9e, 0x21, 0x87, 0x19, 0x00, 0x03, 0xf0, 0x02, 0x0f, 0x63, 0x46, 0xb3,
+	0x73, 0xa0, 0xd0, 0xf5, 0x50, 0xe8, 0xa5, 0xaa, 0x92, 0xbc, 0xe8, 0xdc, 0x4e, 0x6b, 0xd2, 0x10,
+	0x47, 0x23, 0xc9, 0xc1, 0x48, 0xd1, 0x9e, 0x32, 0x34, 0xa7, 0x86, 0x27, 0xe6, 0xe0, 0x00, 0xd4,
+	0x46, 0x5b, 0xfa, 0x84, 0x8b, 0x84, 0xf4, 0x52, 0xd9, 0xa7, 0x2c, 0xfb, 0x6c, 0x3e, 0xbb, 0xec,
+	0xe1, 0x98, 0xa0, 0xad, 0x67, 0x3b, 0xff, 0x78, 0xb8, 0x68, 0x69, 0xce, 0x0a, 0x99, 0xc4, 0x90,
+	0xf6, 0x53, 0xd4, 0x0b, 0xb1, 0x5b, 0xe4, 0xa5, 0xa5, 0x55, 0x67, 0x41, 0xe1, 0x45, 0x61, 0xf8,
+	0x01, 0x80, 0x91, 0x57, 0xd2, 0x4e, 0xbd, 0xfd, 0x3a, 0x6b, 0xf0, 0xeb, 0x76, 0x7d, 0x45, 0xfd,
+	0xb4, 0xb9, 0x3f, 0xb0, 0x08, 0xb3, 0x23, 0x24, 0xfa, 0xd6, 0x09, 0x15, 0x37, 0x97, 0x5b, 0x40,
+	0x25, 0xb2, 0x48, 0xcd, 0xa1, 0x17, 0xae, 0xc2, 0xb7, 0xa0, 0xca, 0x33, 0x5f, 0xd3, 0x10, 0x4b,
+	0xcb, 0xe7, 0x76, 0x36, 0x9e, 0x5d, 0xad, 0x9b, 0x93, 0xc7, 0xd7, 0x2a, 0x2a, 0xc0, 0x63, 0x00,
+	0xf1, 0xd0, 0x0b, 0x53, 0x1f, 0xfb, 0x2e, 0xf2, 0xfd, 0x04, 0x73, 0x8e, 0xb9, 0x31, 0x6b, 0x96,
+	0x9b, 0x7a, 0xdb, 0xb8, 0xb9, 0xdc, 0x5a, 0xce, 0x27, 0xd9, 0x57, 0xb9, 0xae, 0x48, 0x08, 0x0d,
+	0x9c, 0xa5, 0x47, 0xcd, 0xfe, 0xa3, 0x04, 0x32, 0x60, 0xa8, 0x2f, 0xa2, 0xc7, 0xa8, 0x4f, 0x68,
+	0xe0, 0x22, 0xff, 0x73, 0xca, 0x45, 0x84, 0xa9, 0x30, 0xaa, 0xcf, 0x38, 0x9d, 0x69, 0xda, 0x4a,
+	0xb2, 0x5f, 0x28, 0xc6, 0x67, 0xad, 0xe1, 0x89, 0x94, 0x37, 0x6b, 0x5f, 0x1f, 0x2e, 0x5a, 0x86,
+	0x7a, 0x0e, 0x86, 0x63, 0x0f, 0x42, 0xfe, 0x1d, 0xbf, 0xbb, 0xba, 0xab, 0x6b, 0xd7, 0x77, 0x75,
+	0xed, 0xcf, 0x5d, 0x5d, 0xfb, 0x76, 0x5f, 0x2f, 0x5d, 0xdf, 0xd7, 0x4b, 0x3f, 0xef, 0xeb, 0xa5,
+	0x4f, 0xbb, 0x01, 0x11, 0xfd, 0xb4, 0x67, 0x79, 0x2c, 0xb2, 0x0f, 0x32, 0xf9, 0xd6, 0x7b, 0x2c,
+	0xbe, 0xb0, 0x64, 0xa0, 0x22, 0xfb, 0x6c, 0xef, 0x49, 0x3d, 0x71, 0x1e, 0x63, 0xde, 0xab, 0xc8,
+	0xa7, 0x65, 0xf7, 0xef, 0x00, 0x6f, 0x00, 0x89, 0x19, 0xfa, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -239,6 +305,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.InflationPause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxSupplyReached {
+		i--
+		if m.MaxSupplyReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SkippedEpochs != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkippedEpochs))
 		i--
@@ -294,6 +380,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.EpochBondingAdjustment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ExcludedAddresses) > 0 {
+		for iNdEx := len(m.ExcludedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExcludedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -314,16 +439,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size, err := m.ExponentialCalculation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ExponentialCalculation != nil {
+		{
+			size, err := m.ExponentialCalculation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.MintDenom) > 0 {
 		i -= len(m.MintDenom)
 		copy(dAtA[i:], m.MintDenom)
@@ -366,6 +493,11 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkippedEpochs != 0 {
 		n += 1 + sovGenesis(uint64(m.SkippedEpochs))
 	}
+	if m.MaxSupplyReached {
+		n += 2
+	}
+	l = m.InflationPause.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -379,13 +511,27 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.ExponentialCalculation.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	if m.ExponentialCalculation != nil {
+		l = m.ExponentialCalculation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	l = m.InflationDistribution.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	if m.EnableInflation {
 		n += 2
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExcludedAddresses) > 0 {
+		for _, s := range m.ExcludedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EpochBondingAdjustment.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -546,6 +692,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyReached = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationPause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -657,6 +856,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.ExponentialCalculation == nil {
+				m.ExponentialCalculation = &ExponentialCalculation{}
+			}
 			if err := m.ExponentialCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -714,6 +916,138 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedAddresses = append(m.ExcludedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBondingAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochBondingAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
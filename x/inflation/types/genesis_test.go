@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -81,6 +83,24 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"invalid genesis - module recipient not allowed",
+			&GenesisState{
+				Params: Params{
+					MintDenom: validParams.MintDenom,
+					Schedule:  validParams.Schedule,
+					InflationDistribution: NewInflationDistribution(
+						NewInflationRecipient(RECIPIENT_TYPE_MODULE, "distribution", sdkmath.LegacyOneDec()),
+					),
+					EnableInflation: true,
+					MaxSupply:       validParams.MaxSupply,
+				},
+				Period:          uint64(5),
+				EpochIdentifier: epochstypes.DayEpochID,
+				EpochsPerPeriod: 365,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -26,17 +26,117 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecipientType enumerates the destinations of minted inflation.
+type RecipientType int32
+
+const (
+	// RECIPIENT_TYPE_UNSPECIFIED defines an invalid/undefined recipient.
+	RECIPIENT_TYPE_UNSPECIFIED RecipientType = 0
+	// RECIPIENT_TYPE_COMMUNITY_POOL funds the distribution community pool.
+	RECIPIENT_TYPE_COMMUNITY_POOL RecipientType = 1
+	// RECIPIENT_TYPE_MODULE sends coins to a module account, e.g. the fee
+	// collector for staking rewards.
+	RECIPIENT_TYPE_MODULE RecipientType = 2
+	// RECIPIENT_TYPE_ADDRESS sends coins to a bech32 account address.
+	RECIPIENT_TYPE_ADDRESS RecipientType = 3
+	// RECIPIENT_TYPE_CONTRACT sends coins to a hex EVM contract address.
+	RECIPIENT_TYPE_CONTRACT RecipientType = 4
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_UNSPECIFIED",
+	1: "RECIPIENT_TYPE_COMMUNITY_POOL",
+	2: "RECIPIENT_TYPE_MODULE",
+	3: "RECIPIENT_TYPE_ADDRESS",
+	4: "RECIPIENT_TYPE_CONTRACT",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_UNSPECIFIED":    0,
+	"RECIPIENT_TYPE_COMMUNITY_POOL": 1,
+	"RECIPIENT_TYPE_MODULE":         2,
+	"RECIPIENT_TYPE_ADDRESS":        3,
+	"RECIPIENT_TYPE_CONTRACT":       4,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{0}
+}
+
+// InflationRecipient defines a destination of minted inflation and the
+// proportion of each epoch mint allocated to it.
+type InflationRecipient struct {
+	// type of the recipient
+	RecipientType RecipientType `protobuf:"varint,1,opt,name=recipient_type,json=recipientType,proto3,enum=canto.inflation.v1.RecipientType" json:"recipient_type,omitempty"`
+	// module name for module recipients, bech32 address for address recipients
+	// and hex address for contract recipients. It is empty for the community
+	// pool.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted mint_denom that is allocated
+	// to the recipient
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{0}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetRecipientType() RecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
-// excludes the team vesting distribution, as this is minted once at genesis.
-// The initial InflationDistribution can be calculated from the Evmos Token
-// Model like this:
-// mintDistribution1 = distribution1 / (1 - teamVestingDistribution)
-// 0.5333333         = 40%           / (1 - 25%)
+// allocated through minting on each epoch. It excludes the team vesting
+// distribution, as this is minted once at genesis.
 type InflationDistribution struct {
 	// staking_rewards defines the proportion of the minted minted_denom that is
-	// to be allocated as staking rewards
-	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards"`
+	// to be allocated as staking rewards.
+	// Deprecated: staking rewards are allocated through a module recipient
+	// for the fee collector.
+	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards"` // Deprecated: Do not use.
 	// // usage_incentives defines the proportion of the minted minted_denom that
 	// is
 	// // to be allocated to the incentives module address
@@ -45,15 +145,20 @@ type InflationDistribution struct {
 	//   (gogoproto.nullable) = false
 	// ];
 	// community_pool defines the proportion of the minted minted_denom that is to
-	// be allocated to the community pool
-	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// be allocated to the community pool.
+	// Deprecated: the community pool is allocated through a community pool
+	// recipient.
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"` // Deprecated: Do not use.
+	// recipients of the minted mint_denom. The weights of the recipients must
+	// sum to 1.
+	Recipients []InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
 func (m *InflationDistribution) String() string { return proto.CompactTextString(m) }
 func (*InflationDistribution) ProtoMessage()    {}
 func (*InflationDistribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa2aa1764b029465, []int{0}
+	return fileDescriptor_aa2aa1764b029465, []int{1}
 }
 func (m *InflationDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -82,6 +187,13 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetRecipients() []InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -104,7 +216,7 @@ func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{}
 func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
 func (*ExponentialCalculation) ProtoMessage()    {}
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa2aa1764b029465, []int{1}
+	return fileDescriptor_aa2aa1764b029465, []int{2}
 }
 func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,9 +245,385 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// InflationSchedule defines the curve that calculates the annual provision of
+// each period. Exactly one schedule must be set.
+type InflationSchedule struct {
+	// Types that are valid to be assigned to Schedule:
+	//	*InflationSchedule_Exponential
+	//	*InflationSchedule_Step
+	//	*InflationSchedule_Linear
+	Schedule isInflationSchedule_Schedule `protobuf_oneof:"schedule"`
+}
+
+func (m *InflationSchedule) Reset()         { *m = InflationSchedule{} }
+func (m *InflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedule) ProtoMessage()    {}
+func (*InflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{3}
+}
+func (m *InflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedule.Merge(m, src)
+}
+func (m *InflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedule proto.InternalMessageInfo
+
+type isInflationSchedule_Schedule interface {
+	isInflationSchedule_Schedule()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type InflationSchedule_Exponential struct {
+	Exponential *ExponentialCalculation `protobuf:"bytes,1,opt,name=exponential,proto3,oneof" json:"exponential,omitempty"`
+}
+type InflationSchedule_Step struct {
+	Step *StepSchedule `protobuf:"bytes,2,opt,name=step,proto3,oneof" json:"step,omitempty"`
+}
+type InflationSchedule_Linear struct {
+	Linear *LinearSchedule `protobuf:"bytes,3,opt,name=linear,proto3,oneof" json:"linear,omitempty"`
+}
+
+func (*InflationSchedule_Exponential) isInflationSchedule_Schedule() {}
+func (*InflationSchedule_Step) isInflationSchedule_Schedule()        {}
+func (*InflationSchedule_Linear) isInflationSchedule_Schedule()      {}
+
+func (m *InflationSchedule) GetSchedule() isInflationSchedule_Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *InflationSchedule) GetExponential() *ExponentialCalculation {
+	if x, ok := m.GetSchedule().(*InflationSchedule_Exponential); ok {
+		return x.Exponential
+	}
+	return nil
+}
+
+func (m *InflationSchedule) GetStep() *StepSchedule {
+	if x, ok := m.GetSchedule().(*InflationSchedule_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (m *InflationSchedule) GetLinear() *LinearSchedule {
+	if x, ok := m.GetSchedule().(*InflationSchedule_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InflationSchedule) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InflationSchedule_Exponential)(nil),
+		(*InflationSchedule_Step)(nil),
+		(*InflationSchedule_Linear)(nil),
+	}
+}
+
+// StepSchedule holds a table of annual provisions. The provision of a period
+// is the one of the last step starting at or before it.
+type StepSchedule struct {
+	// steps sorted by strictly increasing start period. The first step must
+	// start at period 0.
+	Steps []ProvisionStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *StepSchedule) Reset()         { *m = StepSchedule{} }
+func (m *StepSchedule) String() string { return proto.CompactTextString(m) }
+func (*StepSchedule) ProtoMessage()    {}
+func (*StepSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{4}
+}
+func (m *StepSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StepSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StepSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepSchedule.Merge(m, src)
+}
+func (m *StepSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepSchedule proto.InternalMessageInfo
+
+func (m *StepSchedule) GetSteps() []ProvisionStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// ProvisionStep defines the annual provision from a start period on.
+type ProvisionStep struct {
+	// first period of the step
+	StartPeriod uint64 `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// annual provision in whole tokens
+	AnnualProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=annual_provision,json=annualProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provision"`
+}
+
+func (m *ProvisionStep) Reset()         { *m = ProvisionStep{} }
+func (m *ProvisionStep) String() string { return proto.CompactTextString(m) }
+func (*ProvisionStep) ProtoMessage()    {}
+func (*ProvisionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{5}
+}
+func (m *ProvisionStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionStep.Merge(m, src)
+}
+func (m *ProvisionStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionStep proto.InternalMessageInfo
+
+func (m *ProvisionStep) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+// LinearSchedule holds factors to calculate a linearly decreasing inflation
+// on each period. Calculation reference:
+// periodProvision = max(initial_provision - period * decrease, min_provision)
+type LinearSchedule struct {
+	// annual provision of period 0 in whole tokens
+	InitialProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_provision"`
+	// decrease of the annual provision on each period
+	Decrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=decrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decrease"`
+	// long term annual provision the decrease stops at
+	MinProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_provision,json=minProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_provision"`
+}
+
+func (m *LinearSchedule) Reset()         { *m = LinearSchedule{} }
+func (m *LinearSchedule) String() string { return proto.CompactTextString(m) }
+func (*LinearSchedule) ProtoMessage()    {}
+func (*LinearSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{6}
+}
+func (m *LinearSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinearSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinearSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinearSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearSchedule.Merge(m, src)
+}
+func (m *LinearSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinearSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinearSchedule proto.InternalMessageInfo
+
+// EpochBondingAdjustment defines whether the bonding incentive of the
+// exponential schedule is recalculated on every epoch instead of once per
+// period. Calculation reference:
+// bondingFactor   = prevBondingFactor + clamp(targetBondingFactor -
+// prevBondingFactor, -max_change_per_epoch, max_change_per_epoch)
+// epochProvision  = exponentialDecay * bondingFactor / epochsPerPeriod
+type EpochBondingAdjustment struct {
+	// recalculate the bonding incentive on every epoch
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// maximum change of the bonding factor between two epochs
+	MaxChangePerEpoch cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_per_epoch"`
+}
+
+func (m *EpochBondingAdjustment) Reset()         { *m = EpochBondingAdjustment{} }
+func (m *EpochBondingAdjustment) String() string { return proto.CompactTextString(m) }
+func (*EpochBondingAdjustment) ProtoMessage()    {}
+func (*EpochBondingAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{7}
+}
+func (m *EpochBondingAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBondingAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBondingAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBondingAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBondingAdjustment.Merge(m, src)
+}
+func (m *EpochBondingAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBondingAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBondingAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBondingAdjustment proto.InternalMessageInfo
+
+func (m *EpochBondingAdjustment) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// InflationPause records the latest pause and resume of the inflation through
+// MsgPauseInflation and MsgResumeInflation.
+type InflationPause struct {
+	// true while the inflation is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// block height of the latest pause
+	PauseHeight int64 `protobuf:"varint,2,opt,name=pause_height,json=pauseHeight,proto3" json:"pause_height,omitempty"`
+	// block height of the latest resume
+	ResumeHeight int64 `protobuf:"varint,3,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
+	// number of skipped epochs when the inflation was paused
+	SkippedEpochsAtPause uint64 `protobuf:"varint,4,opt,name=skipped_epochs_at_pause,json=skippedEpochsAtPause,proto3" json:"skipped_epochs_at_pause,omitempty"`
+}
+
+func (m *InflationPause) Reset()         { *m = InflationPause{} }
+func (m *InflationPause) String() string { return proto.CompactTextString(m) }
+func (*InflationPause) ProtoMessage()    {}
+func (*InflationPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2aa1764b029465, []int{8}
+}
+func (m *InflationPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationPause.Merge(m, src)
+}
+func (m *InflationPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationPause proto.InternalMessageInfo
+
+func (m *InflationPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *InflationPause) GetPauseHeight() int64 {
+	if m != nil {
+		return m.PauseHeight
+	}
+	return 0
+}
+
+func (m *InflationPause) GetResumeHeight() int64 {
+	if m != nil {
+		return m.ResumeHeight
+	}
+	return 0
+}
+
+func (m *InflationPause) GetSkippedEpochsAtPause() uint64 {
+	if m != nil {
+		return m.SkippedEpochsAtPause
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("canto.inflation.v1.RecipientType", RecipientType_name, RecipientType_value)
+	proto.RegisterType((*InflationRecipient)(nil), "canto.inflation.v1.InflationRecipient")
 	proto.RegisterType((*InflationDistribution)(nil), "canto.inflation.v1.InflationDistribution")
 	proto.RegisterType((*ExponentialCalculation)(nil), "canto.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*InflationSchedule)(nil), "canto.inflation.v1.InflationSchedule")
+	proto.RegisterType((*StepSchedule)(nil), "canto.inflation.v1.StepSchedule")
+	proto.RegisterType((*ProvisionStep)(nil), "canto.inflation.v1.ProvisionStep")
+	proto.RegisterType((*LinearSchedule)(nil), "canto.inflation.v1.LinearSchedule")
+	proto.RegisterType((*EpochBondingAdjustment)(nil), "canto.inflation.v1.EpochBondingAdjustment")
+	proto.RegisterType((*InflationPause)(nil), "canto.inflation.v1.InflationPause")
 }
 
 func init() {
@@ -143,35 +631,104 @@ func init() {
 }
 
 var fileDescriptor_aa2aa1764b029465 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0x1b, 0x57, 0x05, 0xa3, 0xae, 0x58, 0x54, 0xea, 0x0a, 0x5d, 0x99, 0x93, 0x08, 0xdb,
-	0xb0, 0x2c, 0x88, 0xe7, 0x9d, 0x7a, 0x10, 0x54, 0x64, 0x10, 0x41, 0x41, 0xca, 0x6b, 0x26, 0x76,
-	0xc2, 0x34, 0x79, 0x25, 0x4d, 0x3b, 0x9d, 0x6f, 0xe1, 0x87, 0xf0, 0xe0, 0xd1, 0x83, 0x1f, 0x62,
-	0x8e, 0x83, 0x17, 0xc5, 0xc3, 0x20, 0x33, 0x07, 0xbf, 0x86, 0xb4, 0xa9, 0xce, 0xde, 0x7b, 0x09,
-	0xef, 0xbd, 0xff, 0xe3, 0x17, 0xfe, 0x2f, 0x2f, 0x74, 0xc4, 0x41, 0x5b, 0x64, 0x52, 0x7f, 0xcc,
-	0xc1, 0x4a, 0xd4, 0xac, 0x3e, 0xdd, 0x27, 0x51, 0x61, 0xd0, 0xa2, 0xef, 0x77, 0x3d, 0xd1, 0xbe,
-	0x5c, 0x9f, 0x1e, 0xdd, 0xc9, 0x30, 0xc3, 0x4e, 0x66, 0x6d, 0xe4, 0x3a, 0x8f, 0xee, 0x73, 0x2c,
-	0x15, 0x96, 0x89, 0x13, 0x5c, 0xd2, 0x4b, 0xb7, 0x41, 0x49, 0x8d, 0xac, 0x3b, 0x5d, 0x69, 0xf4,
-	0x83, 0xd0, 0xbb, 0xcf, 0xff, 0x41, 0x63, 0x59, 0x5a, 0x23, 0xd3, 0xaa, 0x8d, 0xfd, 0x84, 0xde,
-	0x2a, 0x2d, 0xcc, 0xa5, 0xce, 0x12, 0x23, 0x16, 0x60, 0xa6, 0x65, 0x40, 0x1e, 0x92, 0x47, 0xd7,
-	0xce, 0x9f, 0xac, 0x36, 0xc7, 0xde, 0xaf, 0xcd, 0xf1, 0x03, 0xc7, 0x2e, 0xa7, 0xf3, 0x48, 0x22,
-	0x53, 0x60, 0x67, 0xd1, 0x0b, 0x91, 0x01, 0x5f, 0xc6, 0x82, 0x7f, 0xff, 0x76, 0x42, 0xfb, 0xab,
-	0x63, 0xc1, 0xbf, 0xfc, 0xf9, 0xfa, 0x98, 0x4c, 0x0e, 0x7b, 0xdc, 0xc4, 0xd1, 0xfc, 0x0f, 0xf4,
-	0x90, 0xa3, 0x52, 0x95, 0x96, 0x76, 0x99, 0x14, 0x88, 0x79, 0x70, 0x30, 0x88, 0x7f, 0xf3, 0x3f,
-	0xed, 0x35, 0x62, 0x3e, 0xfa, 0x7c, 0x40, 0xef, 0x3d, 0x6b, 0x0a, 0xd4, 0x42, 0x5b, 0x09, 0xf9,
-	0x18, 0x72, 0x5e, 0x39, 0x9b, 0x7e, 0x4c, 0x09, 0x0c, 0x34, 0x43, 0xa0, 0xa5, 0x98, 0xe0, 0xd2,
-	0x30, 0x8a, 0x69, 0x29, 0x7c, 0xa0, 0x71, 0xc2, 0xdb, 0x59, 0xa6, 0xa8, 0xa7, 0xed, 0x63, 0x59,
-	0x30, 0x99, 0xb0, 0xc1, 0xe5, 0x61, 0xb3, 0xec, 0x69, 0x6f, 0x3a, 0x98, 0xff, 0x8e, 0xde, 0x50,
-	0xd0, 0x24, 0x35, 0x18, 0x09, 0x9a, 0x8b, 0xe0, 0xca, 0x20, 0xf8, 0x75, 0x05, 0xcd, 0xdb, 0x1e,
-	0x75, 0xfe, 0x72, 0xb5, 0x0d, 0xc9, 0x7a, 0x1b, 0x92, 0xdf, 0xdb, 0x90, 0x7c, 0xda, 0x85, 0xde,
-	0x7a, 0x17, 0x7a, 0x3f, 0x77, 0xa1, 0xf7, 0xfe, 0x2c, 0x93, 0x76, 0x56, 0xa5, 0x11, 0x47, 0xc5,
-	0xc6, 0xed, 0xf6, 0x9f, 0xbc, 0x12, 0x76, 0x81, 0x66, 0xee, 0x32, 0x56, 0x3f, 0x65, 0xcd, 0x85,
-	0x4f, 0x63, 0x97, 0x85, 0x28, 0xd3, 0xab, 0xdd, 0x5a, 0x9f, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0xd3, 0x11, 0x65, 0x54, 0x03, 0x00, 0x00,
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x6e, 0xda, 0x3c, 0xc7, 0xae, 0x33, 0x4a, 0xd3, 0x6d, 0x2a, 0x9c, 0x64, 0xab,
+	0xa2, 0x28, 0x52, 0x6d, 0x35, 0x15, 0xa8, 0xe2, 0x16, 0xff, 0x41, 0xb1, 0x94, 0x38, 0x66, 0xe3,
+	0x20, 0x85, 0x0a, 0xad, 0x26, 0xbb, 0x83, 0x3d, 0xc4, 0xbb, 0xb3, 0xda, 0x19, 0x3b, 0x0e, 0x9f,
+	0x80, 0x23, 0x17, 0x4e, 0x5c, 0x90, 0x40, 0xc0, 0x01, 0xa1, 0x0a, 0xf1, 0x21, 0x7a, 0x42, 0x15,
+	0x27, 0x84, 0x44, 0x85, 0x92, 0x43, 0xf9, 0x18, 0x68, 0x66, 0xd7, 0x1b, 0x3b, 0xf6, 0x89, 0xbd,
+	0x58, 0xf3, 0xfe, 0xcc, 0xef, 0xfd, 0xde, 0xfc, 0x66, 0x9e, 0x17, 0x0c, 0x1b, 0x7b, 0x82, 0x55,
+	0xa8, 0xf7, 0x59, 0x1f, 0x0b, 0xca, 0xbc, 0xca, 0xf0, 0xe9, 0xb5, 0x51, 0xf6, 0x03, 0x26, 0x18,
+	0x42, 0x2a, 0xa7, 0x7c, 0xed, 0x1e, 0x3e, 0x5d, 0x5b, 0xe9, 0xb2, 0x2e, 0x53, 0xe1, 0x8a, 0x5c,
+	0x85, 0x99, 0x6b, 0x0f, 0x6c, 0xc6, 0x5d, 0xc6, 0xad, 0x30, 0x10, 0x1a, 0x51, 0x68, 0x19, 0xbb,
+	0xd4, 0x63, 0x15, 0xf5, 0x1b, 0xba, 0x8c, 0xdf, 0x35, 0x40, 0xcd, 0x31, 0xa8, 0x49, 0x6c, 0xea,
+	0x53, 0xe2, 0x09, 0xb4, 0x07, 0x85, 0x60, 0x6c, 0x58, 0xe2, 0xc2, 0x27, 0xba, 0xb6, 0xa1, 0x6d,
+	0x15, 0x76, 0x36, 0xcb, 0xb3, 0x3c, 0xca, 0xf1, 0xb6, 0xce, 0x85, 0x4f, 0xcc, 0x7c, 0x30, 0x69,
+	0x22, 0x1d, 0x6e, 0x63, 0xc7, 0x09, 0x08, 0xe7, 0x7a, 0x7a, 0x43, 0xdb, 0x5a, 0x34, 0xc7, 0x26,
+	0x6a, 0xc1, 0xc2, 0x39, 0xa1, 0xdd, 0x9e, 0xd0, 0x33, 0x32, 0x50, 0x7d, 0xff, 0xd5, 0x9b, 0xf5,
+	0xd4, 0x5f, 0x6f, 0xd6, 0x1f, 0x86, 0x9c, 0xb9, 0x73, 0x56, 0xa6, 0xac, 0xe2, 0x62, 0xd1, 0x2b,
+	0xef, 0x93, 0x2e, 0xb6, 0x2f, 0xea, 0xc4, 0xfe, 0xe3, 0xb7, 0x27, 0x10, 0xb5, 0x54, 0x27, 0xf6,
+	0x4f, 0x6f, 0x5f, 0x6e, 0x6b, 0x66, 0x84, 0xf2, 0x41, 0xf6, 0xdf, 0x6f, 0xd7, 0x35, 0xe3, 0x97,
+	0x34, 0xdc, 0x8b, 0x1b, 0xaa, 0x53, 0x2e, 0x02, 0x7a, 0x3a, 0x90, 0x6b, 0x84, 0xe1, 0x2e, 0x17,
+	0xf8, 0x8c, 0x7a, 0x5d, 0x2b, 0x20, 0xe7, 0x38, 0x70, 0xb8, 0x6a, 0x6a, 0xb1, 0xfa, 0xfc, 0xff,
+	0x15, 0xd6, 0x35, 0xb3, 0x10, 0x01, 0x9a, 0x21, 0x1e, 0xb2, 0xa0, 0x60, 0x33, 0xd7, 0x1d, 0x78,
+	0x54, 0x5c, 0x58, 0x3e, 0x63, 0x7d, 0x3d, 0x93, 0xb0, 0x42, 0x3e, 0xc6, 0x6b, 0x33, 0xd6, 0x47,
+	0x1f, 0x01, 0xc4, 0xc7, 0xcb, 0xf5, 0xec, 0x46, 0x66, 0x2b, 0xb7, 0xf3, 0xee, 0x3c, 0x4d, 0x66,
+	0x35, 0xad, 0x2e, 0x4a, 0x12, 0xe1, 0x91, 0x4d, 0x80, 0x18, 0xdf, 0x67, 0x60, 0xb5, 0x31, 0xf2,
+	0x99, 0x47, 0x3c, 0x41, 0x71, 0xbf, 0x86, 0xfb, 0xf6, 0x20, 0xdc, 0x8a, 0xea, 0xa0, 0x61, 0x5d,
+	0x4b, 0x24, 0x8e, 0x86, 0x25, 0x4a, 0xa0, 0xa7, 0x93, 0xa1, 0x04, 0x12, 0xc5, 0x4e, 0x78, 0x51,
+	0x34, 0x1b, 0x7d, 0x0a, 0x85, 0x53, 0xe6, 0x39, 0xf2, 0x0e, 0x08, 0x1c, 0x74, 0x89, 0xd0, 0xb3,
+	0x89, 0x20, 0xf3, 0x11, 0x5a, 0x47, 0x81, 0xa1, 0x13, 0x58, 0x72, 0xf1, 0xc8, 0x1a, 0xe2, 0x80,
+	0x62, 0xcf, 0x26, 0xfa, 0xad, 0x44, 0xe0, 0x39, 0x17, 0x8f, 0x3e, 0x8e, 0xa0, 0x8c, 0xbf, 0xd3,
+	0xb0, 0x1c, 0x8b, 0x7a, 0x64, 0xf7, 0x88, 0x33, 0xe8, 0x13, 0xf4, 0x05, 0xe4, 0xc8, 0xb5, 0x76,
+	0x4a, 0xab, 0xdc, 0xce, 0xf6, 0xbc, 0x0b, 0x31, 0x5f, 0xe2, 0xea, 0xf6, 0xaf, 0x6f, 0x5f, 0x6e,
+	0x3f, 0x0e, 0x07, 0xd0, 0x68, 0x62, 0x04, 0x4d, 0xa4, 0x8f, 0x8b, 0xed, 0xa5, 0xcc, 0xc9, 0x62,
+	0xe8, 0x05, 0x64, 0xb9, 0x20, 0xbe, 0x92, 0x36, 0xb7, 0xb3, 0x31, 0xaf, 0xe8, 0x91, 0x20, 0xfe,
+	0x78, 0x7b, 0xf5, 0x91, 0x2c, 0x55, 0x9a, 0x2d, 0x35, 0x99, 0xb4, 0x97, 0x32, 0x15, 0x28, 0xb2,
+	0x61, 0xa1, 0x4f, 0x3d, 0x82, 0x03, 0xa5, 0x79, 0x6e, 0xc7, 0x98, 0x07, 0xbf, 0xaf, 0x32, 0xe2,
+	0x02, 0x8f, 0x65, 0x81, 0x8d, 0xd9, 0x02, 0xd3, 0x69, 0x7b, 0x29, 0x33, 0x82, 0xae, 0x02, 0xdc,
+	0xe1, 0x91, 0xd7, 0x30, 0x61, 0x69, 0x92, 0x08, 0xaa, 0xc2, 0x2d, 0x49, 0x44, 0xce, 0x08, 0xf9,
+	0xc8, 0xe6, 0x0e, 0xbe, 0x76, 0xc0, 0x86, 0x94, 0x4b, 0x3d, 0x04, 0xf1, 0x27, 0xdf, 0x57, 0xb8,
+	0xd5, 0xf8, 0x5a, 0x83, 0xfc, 0x54, 0x0e, 0xda, 0x84, 0x25, 0x2e, 0x70, 0x20, 0x2c, 0x9f, 0x04,
+	0x94, 0x39, 0x4a, 0xb0, 0xac, 0x99, 0x53, 0xbe, 0xb6, 0x72, 0x21, 0x0c, 0x45, 0xec, 0x79, 0x03,
+	0xdc, 0xb7, 0xfc, 0xf1, 0xd6, 0x84, 0xaf, 0xe7, 0x6e, 0x88, 0x17, 0x33, 0x31, 0x7e, 0x48, 0x43,
+	0x61, 0xfa, 0x50, 0x90, 0x0d, 0xcb, 0xd4, 0xa3, 0x82, 0x4e, 0x95, 0x4d, 0xf6, 0xf4, 0x8b, 0x11,
+	0x60, 0x5c, 0x17, 0x99, 0x70, 0xc7, 0x21, 0x76, 0x40, 0x30, 0x27, 0x09, 0x5b, 0x8a, 0x71, 0xd0,
+	0x0b, 0xc8, 0xbb, 0xd4, 0x9b, 0x20, 0x9d, 0x6c, 0x46, 0x2c, 0xb9, 0xd4, 0xbb, 0x3e, 0xa8, 0x6f,
+	0x34, 0x58, 0x6d, 0xf8, 0xcc, 0xee, 0x55, 0xc3, 0x67, 0xbe, 0xeb, 0x7c, 0x3e, 0xe0, 0xc2, 0x95,
+	0xff, 0x90, 0x3a, 0xdc, 0x26, 0x1e, 0x3e, 0xed, 0x93, 0x50, 0xc4, 0x3b, 0xe6, 0xd8, 0x44, 0x5d,
+	0x58, 0x91, 0x43, 0xc0, 0xee, 0x61, 0xaf, 0x4b, 0xa4, 0xd0, 0x16, 0x91, 0x10, 0x09, 0x3b, 0x5e,
+	0x76, 0xf1, 0xa8, 0xa6, 0x20, 0xdb, 0x24, 0x50, 0x9c, 0x8c, 0x1f, 0x35, 0x28, 0xc4, 0x23, 0xa1,
+	0x8d, 0x07, 0x9c, 0xa0, 0x55, 0x58, 0xf0, 0xe5, 0x62, 0x4c, 0x2a, 0xb2, 0xe4, 0xbd, 0x53, 0x2b,
+	0xab, 0x17, 0xfe, 0xe3, 0x4a, 0x2e, 0x19, 0x33, 0xa7, 0x7c, 0x7b, 0xca, 0x85, 0x1e, 0x41, 0x3e,
+	0x20, 0x7c, 0xe0, 0xc6, 0x39, 0x19, 0x95, 0xb3, 0x14, 0x3a, 0xa3, 0xa4, 0xf7, 0xe0, 0x3e, 0x3f,
+	0xa3, 0xbe, 0x4f, 0x9c, 0xb0, 0x29, 0x6e, 0x61, 0x61, 0x29, 0x14, 0x35, 0x48, 0xb3, 0xe6, 0x4a,
+	0x14, 0x56, 0x0c, 0xf9, 0xae, 0x50, 0xb4, 0xb6, 0x7f, 0xd6, 0x20, 0x3f, 0xf5, 0x95, 0x80, 0x4a,
+	0xb0, 0x66, 0x36, 0x6a, 0xcd, 0x76, 0xb3, 0xd1, 0xea, 0x58, 0x9d, 0x93, 0x76, 0xc3, 0x3a, 0x6e,
+	0x1d, 0xb5, 0x1b, 0xb5, 0xe6, 0x87, 0xcd, 0x46, 0xbd, 0x98, 0x42, 0x9b, 0xf0, 0xce, 0x8d, 0x78,
+	0xed, 0xf0, 0xe0, 0xe0, 0xb8, 0xd5, 0xec, 0x9c, 0x58, 0xed, 0xc3, 0xc3, 0xfd, 0xa2, 0x86, 0x1e,
+	0xc0, 0xbd, 0x1b, 0x29, 0x07, 0x87, 0xf5, 0xe3, 0xfd, 0x46, 0x31, 0x8d, 0xd6, 0x60, 0xf5, 0x46,
+	0x68, 0xb7, 0x5e, 0x37, 0x1b, 0x47, 0x47, 0xc5, 0x0c, 0x7a, 0x08, 0xf7, 0x67, 0x90, 0x5b, 0x1d,
+	0x73, 0xb7, 0xd6, 0x29, 0x66, 0xd7, 0xb2, 0x5f, 0x7e, 0x57, 0x4a, 0x55, 0x0f, 0x5e, 0x5d, 0x96,
+	0xb4, 0xd7, 0x97, 0x25, 0xed, 0x9f, 0xcb, 0x92, 0xf6, 0xd5, 0x55, 0x29, 0xf5, 0xfa, 0xaa, 0x94,
+	0xfa, 0xf3, 0xaa, 0x94, 0xfa, 0xe4, 0x59, 0x97, 0x8a, 0xde, 0xe0, 0xb4, 0x6c, 0x33, 0xb7, 0x52,
+	0x93, 0x03, 0xe1, 0x49, 0x8b, 0x88, 0x73, 0x16, 0x9c, 0x85, 0x56, 0x65, 0xf8, 0x7c, 0x6a, 0xf2,
+	0xc8, 0x2f, 0x27, 0x7e, 0xba, 0xa0, 0x3e, 0xb5, 0x9e, 0xfd, 0x37, 0x00, 0x56, 0xf1, 0x45, 0xc7,
+	0xe8, 0x09, 0x00, 0x00,
 }
 
-func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
+func (this *InflationRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InflationRecipient)
+	if !ok {
+		that2, ok := that.(InflationRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecipientType != that1.RecipientType {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -181,40 +738,42 @@ func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InflationDistribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.CommunityPool.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInflation(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size := m.StakingRewards.Size()
-		i -= size
-		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
+func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -224,18 +783,75 @@ func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExponentialCalculation) MarshalTo(dAtA []byte) (int, error) {
+func (m *InflationDistribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
-		size := m.MaxVariance.Size()
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakingRewards.Size()
+		i -= size
+		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExponentialCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVariance.Size()
 		i -= size
 		if _, err := m.MaxVariance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -287,6 +903,320 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size := m.Schedule.Size()
+			i -= size
+			if _, err := m.Schedule.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSchedule_Exponential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule_Exponential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Exponential != nil {
+		{
+			size, err := m.Exponential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInflation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InflationSchedule_Step) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule_Step) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Step != nil {
+		{
+			size, err := m.Step.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInflation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InflationSchedule_Linear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule_Linear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Linear != nil {
+		{
+			size, err := m.Linear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInflation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StepSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvisionStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvision.Size()
+		i -= size
+		if _, err := m.AnnualProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartPeriod != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinProvision.Size()
+		i -= size
+		if _, err := m.MinProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Decrease.Size()
+		i -= size
+		if _, err := m.Decrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialProvision.Size()
+		i -= size
+		if _, err := m.InitialProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochBondingAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBondingAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBondingAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkippedEpochsAtPause != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.SkippedEpochsAtPause))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResumeHeight != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.ResumeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PauseHeight != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.PauseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -298,16 +1228,40 @@ func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *InflationDistribution) Size() (n int) {
+func (m *InflationRecipient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.StakingRewards.Size()
+	if m.RecipientType != 0 {
+		n += 1 + sovInflation(uint64(m.RecipientType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *InflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
 	n += 1 + l + sovInflation(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -330,13 +1284,802 @@ func (m *ExponentialCalculation) Size() (n int) {
 	return n
 }
 
+func (m *InflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		n += m.Schedule.Size()
+	}
+	return n
+}
+
+func (m *InflationSchedule_Exponential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exponential != nil {
+		l = m.Exponential.Size()
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+func (m *InflationSchedule_Step) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Step != nil {
+		l = m.Step.Size()
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+func (m *InflationSchedule_Linear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Linear != nil {
+		l = m.Linear.Size()
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+func (m *StepSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProvisionStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartPeriod != 0 {
+		n += 1 + sovInflation(uint64(m.StartPeriod))
+	}
+	l = m.AnnualProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *LinearSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Decrease.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MinProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *EpochBondingAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MaxChangePerEpoch.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *InflationPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PauseHeight != 0 {
+		n += 1 + sovInflation(uint64(m.PauseHeight))
+	}
+	if m.ResumeHeight != 0 {
+		n += 1 + sovInflation(uint64(m.ResumeHeight))
+	}
+	if m.SkippedEpochsAtPause != 0 {
+		n += 1 + sovInflation(uint64(m.SkippedEpochsAtPause))
+	}
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozInflation(x uint64) (n int) {
 	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			m.RecipientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientType |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExponentialCalculation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &InflationSchedule_Exponential{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StepSchedule{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &InflationSchedule_Step{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linear", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LinearSchedule{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &InflationSchedule_Linear{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -359,17 +2102,17 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -379,29 +2122,98 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, ProvisionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +2241,7 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnnualProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -454,7 +2266,7 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+func (m *LinearSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -477,15 +2289,15 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinearSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinearSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -513,13 +2325,13 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrease", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -547,13 +2359,13 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Decrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProvision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -581,13 +2393,83 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochBondingAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochBondingAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochBondingAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -615,15 +2497,65 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -633,26 +2565,69 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInflation
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInflation
+			m.PauseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
 			}
-			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ResumeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochsAtPause", wireType)
+			}
+			m.SkippedEpochsAtPause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochsAtPause |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
@@ -13,28 +13,19 @@ func CalculateEpochMintProvision(
 	epochsPerPeriod int64,
 	bondedRatio sdkmath.LegacyDec,
 ) sdkmath.LegacyDec {
-	x := period                                              // period
-	a := params.ExponentialCalculation.A                     // initial value
-	r := params.ExponentialCalculation.R                     // reduction factor
-	c := params.ExponentialCalculation.C                     // long term inflation
-	bTarget := params.ExponentialCalculation.BondingTarget   // bonding target
-	maxVariance := params.ExponentialCalculation.MaxVariance // max percentage that inflation can be increased by
-
-	// exponentialDecay := a * (1 - r) ^ x + c
-	decay := sdkmath.LegacyOneDec().Sub(r)
-	exponentialDecay := a.Mul(decay.Power(x)).Add(c)
-
-	// bondingIncentive doesn't increase beyond bonding target (0 < b < bonding_target)
-	if bondedRatio.GTE(bTarget) {
-		bondedRatio = bTarget
-	}
-
-	// bondingIncentive = 1 + max_variance - bondingRatio * (max_variance / bonding_target)
-	sub := bondedRatio.Mul(maxVariance.Quo(bTarget))
-	bondingIncentive := sdkmath.LegacyOneDec().Add(maxVariance).Sub(sub)
+	bondingFactor := params.Schedule.BondingFactor(bondedRatio)
+	return CalculateAdjustedEpochMintProvision(params, period, epochsPerPeriod, bondingFactor)
+}
 
-	// periodProvision = exponentialDecay * bondingIncentive
-	periodProvision := exponentialDecay.Mul(bondingIncentive)
+// CalculateAdjustedEpochMintProvision returns mint provision per epoch for the
+// given bonding factor instead of the one of a bonded ratio
+func CalculateAdjustedEpochMintProvision(
+	params Params,
+	period uint64,
+	epochsPerPeriod int64,
+	bondingFactor sdkmath.LegacyDec,
+) sdkmath.LegacyDec {
+	periodProvision := params.Schedule.BaseProvision(period).Mul(bondingFactor)
 
 	// epochProvision = periodProvision / epochsPerPeriod
 	epochProvision := periodProvision.Quo(sdkmath.LegacyNewDec(epochsPerPeriod))
@@ -19,7 +19,20 @@ func TestInflationSuite(t *testing.T) {
 
 func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 	bondingParams := DefaultParams()
-	bondingParams.ExponentialCalculation.MaxVariance = sdkmath.LegacyNewDecWithPrec(0, 2)
+	bondingParams.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(0, 2)
+
+	stepParams := DefaultParams()
+	stepParams.Schedule = NewStepSchedule(
+		NewProvisionStep(0, sdkmath.LegacyNewDec(3_000_000)),
+		NewProvisionStep(2, sdkmath.LegacyNewDec(1_500_000)),
+	)
+
+	linearParams := DefaultParams()
+	linearParams.Schedule = NewLinearSchedule(
+		sdkmath.LegacyNewDec(3_000_000),
+		sdkmath.LegacyNewDec(600_000),
+		sdkmath.LegacyNewDec(1_200_000),
+	)
 	epochsPerPeriod := int64(30)
 
 	testCases := []struct {
@@ -126,6 +139,46 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 			sdkmath.LegacyMustNewDecFromStr("64026928909137053253.000000000000000000"),
 			true,
 		},
+		{
+			"pass - step schedule - initial period",
+			stepParams,
+			uint64(0),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyMustNewDecFromStr("100000000000000000000000.000000000000000000"),
+			true,
+		},
+		{
+			"pass - step schedule - period before step",
+			stepParams,
+			uint64(1),
+			sdkmath.LegacyZeroDec(),
+			sdkmath.LegacyMustNewDecFromStr("100000000000000000000000.000000000000000000"),
+			true,
+		},
+		{
+			"pass - step schedule - period after step",
+			stepParams,
+			uint64(3),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyMustNewDecFromStr("50000000000000000000000.000000000000000000"),
+			true,
+		},
+		{
+			"pass - linear schedule - period 1",
+			linearParams,
+			uint64(1),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyMustNewDecFromStr("80000000000000000000000.000000000000000000"),
+			true,
+		},
+		{
+			"pass - linear schedule - min provision",
+			linearParams,
+			uint64(5),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyMustNewDecFromStr("40000000000000000000000.000000000000000000"),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -140,3 +193,68 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculateAdjustedEpochMintProvision() {
+	params := DefaultParams()
+	params.Schedule.GetExponential().MaxVariance = sdkmath.LegacyNewDecWithPrec(40, 2)
+	epochsPerPeriod := int64(30)
+
+	// the adjusted provision equals the period provision for the factor of the
+	// bonded ratio
+	for _, bondedRatio := range []sdkmath.LegacyDec{
+		sdkmath.LegacyZeroDec(),
+		sdkmath.LegacyNewDecWithPrec(40, 2),
+		sdkmath.LegacyOneDec(),
+	} {
+		bondingFactor := params.Schedule.BondingFactor(bondedRatio)
+		suite.Require().Equal(
+			CalculateEpochMintProvision(params, 1, epochsPerPeriod, bondedRatio),
+			CalculateAdjustedEpochMintProvision(params, 1, epochsPerPeriod, bondingFactor),
+		)
+	}
+
+	// schedules without a bonding incentive have a constant factor of one
+	params.Schedule = NewLinearSchedule(sdkmath.LegacyNewDec(3_000_000), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec())
+	suite.Require().Equal(sdkmath.LegacyOneDec(), params.Schedule.BondingFactor(sdkmath.LegacyZeroDec()))
+}
+
+func (suite *InflationTestSuite) TestSmoothBondingFactor() {
+	adjustment := NewEpochBondingAdjustment(true, sdkmath.LegacyNewDecWithPrec(1, 2))
+
+	testCases := []struct {
+		name      string
+		prev      sdkmath.LegacyDec
+		target    sdkmath.LegacyDec
+		expFactor sdkmath.LegacyDec
+	}{
+		{
+			"within max change",
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyNewDecWithPrec(1005, 3),
+			sdkmath.LegacyNewDecWithPrec(1005, 3),
+		},
+		{
+			"increase bounded",
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyNewDecWithPrec(14, 1),
+			sdkmath.LegacyNewDecWithPrec(101, 2),
+		},
+		{
+			"decrease bounded",
+			sdkmath.LegacyNewDecWithPrec(14, 1),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyNewDecWithPrec(139, 2),
+		},
+		{
+			"unchanged",
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyOneDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().Equal(tc.expFactor, adjustment.SmoothBondingFactor(tc.prev, tc.target))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	coinswaptypes "github.com/TucanaProtocol/Tucana/v8/x/coinswap/types"
+	csrtypes "github.com/TucanaProtocol/Tucana/v8/x/csr/types"
+)
+
+// communityPoolLabel identifies the community pool recipient in events and
+// telemetry
+const communityPoolLabel = "community_pool"
+
+// AllowedModuleRecipients are the module accounts that can receive a share of
+// the inflation: the fee collector for staking rewards and the coinswap and
+// CSR incentive buckets
+var AllowedModuleRecipients = []string{
+	authtypes.FeeCollectorName,
+	coinswaptypes.ModuleName,
+	csrtypes.ModuleName,
+}
+
+// Allocation defines the amount of minted coins sent to an inflation recipient
+type Allocation struct {
+	Recipient InflationRecipient
+	Amount    sdk.Coin
+}
+
+// NewInflationRecipient returns an instance of InflationRecipient
+func NewInflationRecipient(recipientType RecipientType, address string, weight sdkmath.LegacyDec) InflationRecipient {
+	return InflationRecipient{
+		RecipientType: recipientType,
+		Address:       address,
+		Weight:        weight,
+	}
+}
+
+// NewInflationDistribution returns an InflationDistribution for the given
+// recipients
+func NewInflationDistribution(recipients ...InflationRecipient) InflationDistribution {
+	return InflationDistribution{
+		StakingRewards: sdkmath.LegacyZeroDec(),
+		CommunityPool:  sdkmath.LegacyZeroDec(),
+		Recipients:     recipients,
+	}
+}
+
+// DefaultInflationDistribution allocates the whole inflation as staking rewards
+// to the fee collector
+func DefaultInflationDistribution() InflationDistribution {
+	return NewInflationDistribution(
+		NewInflationRecipient(RECIPIENT_TYPE_MODULE, authtypes.FeeCollectorName, sdkmath.LegacyOneDec()),
+		NewInflationRecipient(RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyZeroDec()),
+	)
+}
+
+// Label returns the identifier of the recipient used in events and telemetry
+func (ir InflationRecipient) Label() string {
+	if ir.RecipientType == RECIPIENT_TYPE_COMMUNITY_POOL {
+		return communityPoolLabel
+	}
+	return ir.Address
+}
+
+// AccAddress returns the account address of an address or contract recipient
+func (ir InflationRecipient) AccAddress() (sdk.AccAddress, error) {
+	switch ir.RecipientType {
+	case RECIPIENT_TYPE_ADDRESS:
+		return sdk.AccAddressFromBech32(ir.Address)
+	case RECIPIENT_TYPE_CONTRACT:
+		if !common.IsHexAddress(ir.Address) {
+			return nil, fmt.Errorf("invalid contract hex address '%s'", ir.Address)
+		}
+		return common.HexToAddress(ir.Address).Bytes(), nil
+	default:
+		return nil, fmt.Errorf("recipient type %s has no account address", ir.RecipientType)
+	}
+}
+
+// Validate performs a stateless validation of an InflationRecipient
+func (ir InflationRecipient) Validate() error {
+	switch ir.RecipientType {
+	case RECIPIENT_TYPE_COMMUNITY_POOL:
+		if ir.Address != "" {
+			return errors.New("community pool recipient cannot have an address")
+		}
+	case RECIPIENT_TYPE_MODULE:
+		if strings.TrimSpace(ir.Address) == "" {
+			return errors.New("module recipient name cannot be blank")
+		}
+		if !slices.Contains(AllowedModuleRecipients, ir.Address) {
+			return fmt.Errorf(
+				"module %s cannot be an inflation recipient, allowed modules are %s",
+				ir.Address, strings.Join(AllowedModuleRecipients, ", "),
+			)
+		}
+	case RECIPIENT_TYPE_ADDRESS, RECIPIENT_TYPE_CONTRACT:
+		if _, err := ir.AccAddress(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid recipient type %s", ir.RecipientType)
+	}
+
+	if ir.Weight.IsNil() || ir.Weight.IsNegative() {
+		return fmt.Errorf("weight of recipient %s must not be nil or negative", ir.Label())
+	}
+
+	return nil
+}
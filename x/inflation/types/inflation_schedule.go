@@ -0,0 +1,266 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Inflation schedule names used in logs and events
+const (
+	ScheduleExponential = "exponential"
+	ScheduleStep        = "step"
+	ScheduleLinear      = "linear"
+)
+
+// MaxProjectedPeriods is the maximum number of periods returned by a schedule
+// projection
+const MaxProjectedPeriods = 100
+
+// NewExponentialSchedule returns an InflationSchedule for the given
+// exponential calculation
+func NewExponentialSchedule(calculation ExponentialCalculation) InflationSchedule {
+	return InflationSchedule{
+		Schedule: &InflationSchedule_Exponential{Exponential: &calculation},
+	}
+}
+
+// NewStepSchedule returns an InflationSchedule for the given provision steps
+func NewStepSchedule(steps ...ProvisionStep) InflationSchedule {
+	return InflationSchedule{
+		Schedule: &InflationSchedule_Step{Step: &StepSchedule{Steps: steps}},
+	}
+}
+
+// NewProvisionStep returns an instance of ProvisionStep
+func NewProvisionStep(startPeriod uint64, annualProvision sdkmath.LegacyDec) ProvisionStep {
+	return ProvisionStep{
+		StartPeriod:     startPeriod,
+		AnnualProvision: annualProvision,
+	}
+}
+
+// NewLinearSchedule returns an InflationSchedule for a linear decay
+func NewLinearSchedule(initialProvision, decrease, minProvision sdkmath.LegacyDec) InflationSchedule {
+	return InflationSchedule{
+		Schedule: &InflationSchedule_Linear{Linear: &LinearSchedule{
+			InitialProvision: initialProvision,
+			Decrease:         decrease,
+			MinProvision:     minProvision,
+		}},
+	}
+}
+
+// DefaultInflationSchedule returns the exponential schedule of the default
+// parameters
+func DefaultInflationSchedule() InflationSchedule {
+	return NewExponentialSchedule(ExponentialCalculation{
+		A:             sdkmath.LegacyNewDec(int64(16_304_348)),
+		R:             sdkmath.LegacyNewDecWithPrec(35, 2), // 35%
+		C:             sdkmath.LegacyZeroDec(),
+		BondingTarget: sdkmath.LegacyNewDecWithPrec(80, 2), // not relevant; max variance is 0
+		MaxVariance:   sdkmath.LegacyZeroDec(),             // 0%
+	})
+}
+
+// Name returns the name of the schedule type, or an empty string if no
+// schedule is set
+func (is InflationSchedule) Name() string {
+	switch is.Schedule.(type) {
+	case *InflationSchedule_Exponential:
+		return ScheduleExponential
+	case *InflationSchedule_Step:
+		return ScheduleStep
+	case *InflationSchedule_Linear:
+		return ScheduleLinear
+	default:
+		return ""
+	}
+}
+
+// PeriodProvision returns the annual provision in whole tokens of the given
+// period. The bonded ratio only adjusts the exponential schedule.
+func (is InflationSchedule) PeriodProvision(period uint64, bondedRatio sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return is.BaseProvision(period).Mul(is.BondingFactor(bondedRatio))
+}
+
+// BaseProvision returns the annual provision in whole tokens of the given
+// period before the bonding incentive is applied
+func (is InflationSchedule) BaseProvision(period uint64) sdkmath.LegacyDec {
+	switch schedule := is.Schedule.(type) {
+	case *InflationSchedule_Exponential:
+		return schedule.Exponential.ExponentialDecay(period)
+	case *InflationSchedule_Step:
+		return schedule.Step.PeriodProvision(period)
+	case *InflationSchedule_Linear:
+		return schedule.Linear.PeriodProvision(period)
+	default:
+		return sdkmath.LegacyZeroDec()
+	}
+}
+
+// BondingFactor returns the factor by which the bonded ratio increases the
+// base provision. It is one for schedules without a bonding incentive.
+func (is InflationSchedule) BondingFactor(bondedRatio sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if schedule, ok := is.Schedule.(*InflationSchedule_Exponential); ok {
+		return schedule.Exponential.BondingIncentive(bondedRatio)
+	}
+	return sdkmath.LegacyOneDec()
+}
+
+// Validate performs a stateless validation of the schedule that is set
+func (is InflationSchedule) Validate() error {
+	switch schedule := is.Schedule.(type) {
+	case *InflationSchedule_Exponential:
+		if schedule.Exponential == nil {
+			return errors.New("exponential schedule cannot be nil")
+		}
+		return validateExponentialCalculation(*schedule.Exponential)
+	case *InflationSchedule_Step:
+		if schedule.Step == nil {
+			return errors.New("step schedule cannot be nil")
+		}
+		return schedule.Step.Validate()
+	case *InflationSchedule_Linear:
+		if schedule.Linear == nil {
+			return errors.New("linear schedule cannot be nil")
+		}
+		return schedule.Linear.Validate()
+	default:
+		return errors.New("inflation schedule must be set")
+	}
+}
+
+// PeriodProvision returns the exponentially decayed annual provision of the
+// given period, increased by the bonding incentive
+func (ec ExponentialCalculation) PeriodProvision(period uint64, bondedRatio sdkmath.LegacyDec) sdkmath.LegacyDec {
+	// periodProvision = exponentialDecay * bondingIncentive
+	return ec.ExponentialDecay(period).Mul(ec.BondingIncentive(bondedRatio))
+}
+
+// ExponentialDecay returns the exponentially decayed annual provision of the
+// given period
+func (ec ExponentialCalculation) ExponentialDecay(period uint64) sdkmath.LegacyDec {
+	x := period // period
+	a := ec.A   // initial value
+	r := ec.R   // reduction factor
+	c := ec.C   // long term inflation
+
+	// exponentialDecay := a * (1 - r) ^ x + c
+	decay := sdkmath.LegacyOneDec().Sub(r)
+	return a.Mul(decay.Power(x)).Add(c)
+}
+
+// BondingIncentive returns the factor that increases the provision while the
+// bonded ratio is below the bonding target
+func (ec ExponentialCalculation) BondingIncentive(bondedRatio sdkmath.LegacyDec) sdkmath.LegacyDec {
+	bTarget := ec.BondingTarget   // bonding target
+	maxVariance := ec.MaxVariance // max percentage that inflation can be increased by
+
+	// bondingIncentive doesn't increase beyond bonding target (0 < b < bonding_target)
+	if bondedRatio.GTE(bTarget) {
+		bondedRatio = bTarget
+	}
+
+	// bondingIncentive = 1 + max_variance - bondingRatio * (max_variance / bonding_target)
+	sub := bondedRatio.Mul(maxVariance.Quo(bTarget))
+	return sdkmath.LegacyOneDec().Add(maxVariance).Sub(sub)
+}
+
+// PeriodProvision returns the annual provision of the last step starting at or
+// before the given period
+func (ss StepSchedule) PeriodProvision(period uint64) sdkmath.LegacyDec {
+	provision := sdkmath.LegacyZeroDec()
+	for _, step := range ss.Steps {
+		if step.StartPeriod > period {
+			break
+		}
+		provision = step.AnnualProvision
+	}
+	return provision
+}
+
+// Validate performs a stateless validation of a StepSchedule
+func (ss StepSchedule) Validate() error {
+	if len(ss.Steps) == 0 {
+		return errors.New("step schedule must have at least one step")
+	}
+
+	if ss.Steps[0].StartPeriod != 0 {
+		return fmt.Errorf("first step must start at period 0, got %d", ss.Steps[0].StartPeriod)
+	}
+
+	for i, step := range ss.Steps {
+		if step.AnnualProvision.IsNil() || step.AnnualProvision.IsNegative() {
+			return fmt.Errorf("annual provision of step %d cannot be nil or negative", i)
+		}
+
+		if i > 0 && step.StartPeriod <= ss.Steps[i-1].StartPeriod {
+			return fmt.Errorf("steps must have strictly increasing start periods, got %d after %d", step.StartPeriod, ss.Steps[i-1].StartPeriod)
+		}
+	}
+
+	return nil
+}
+
+// PeriodProvision returns the linearly decreased annual provision of the
+// given period, bounded by the min provision
+func (ls LinearSchedule) PeriodProvision(period uint64) sdkmath.LegacyDec {
+	// periodProvision = initial_provision - period * decrease
+	decrease := ls.Decrease.MulInt(sdkmath.NewIntFromUint64(period))
+	provision := ls.InitialProvision.Sub(decrease)
+
+	return sdkmath.LegacyMaxDec(provision, ls.MinProvision)
+}
+
+// Validate performs a stateless validation of a LinearSchedule
+func (ls LinearSchedule) Validate() error {
+	if ls.InitialProvision.IsNil() || ls.InitialProvision.IsNegative() {
+		return errors.New("initial provision cannot be nil or negative")
+	}
+
+	if ls.Decrease.IsNil() || ls.Decrease.IsNegative() {
+		return errors.New("decrease cannot be nil or negative")
+	}
+
+	if ls.MinProvision.IsNil() || ls.MinProvision.IsNegative() {
+		return errors.New("min provision cannot be nil or negative")
+	}
+
+	if ls.MinProvision.GT(ls.InitialProvision) {
+		return errors.New("min provision cannot be greater than the initial provision")
+	}
+
+	return nil
+}
+
+// DefaultEpochBondingAdjustment returns the disabled epoch bonding adjustment
+// that bounds the bonding factor change to 1% per epoch once enabled
+func DefaultEpochBondingAdjustment() EpochBondingAdjustment {
+	return NewEpochBondingAdjustment(false, sdkmath.LegacyNewDecWithPrec(1, 2))
+}
+
+// NewEpochBondingAdjustment returns an instance of EpochBondingAdjustment
+func NewEpochBondingAdjustment(enabled bool, maxChangePerEpoch sdkmath.LegacyDec) EpochBondingAdjustment {
+	return EpochBondingAdjustment{
+		Enabled:           enabled,
+		MaxChangePerEpoch: maxChangePerEpoch,
+	}
+}
+
+// SmoothBondingFactor moves the previous bonding factor towards the target by
+// at most the max change per epoch
+func (eba EpochBondingAdjustment) SmoothBondingFactor(prev, target sdkmath.LegacyDec) sdkmath.LegacyDec {
+	maxChange := eba.MaxChangePerEpoch
+	change := target.Sub(prev)
+
+	switch {
+	case change.GT(maxChange):
+		change = maxChange
+	case change.LT(maxChange.Neg()):
+		change = maxChange.Neg()
+	}
+
+	return prev.Add(change)
+}
@@ -5,6 +5,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/TucanaProtocol/Tucana/v8/x/epochs/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -13,6 +16,8 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
 	SetAccount(context.Context, sdk.AccountI)
+	GetModulePermissions() map[string]authtypes.PermissionsForAddress
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
 }
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
@@ -26,6 +31,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -40,3 +46,8 @@ type StakingKeeper interface {
 	StakingTokenSupply(ctx context.Context) (sdkmath.Int, error)
 	TotalBondedTokens(ctx context.Context) (sdkmath.Int, error)
 }
+
+// EpochsKeeper defines the expected epochs keeper
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
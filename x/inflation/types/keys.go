@@ -19,6 +19,10 @@ const (
 	prefixEpochIdentifier
 	prefixEpochsPerPeriod
 	prefixSkippedEpochs
+	prefixMaxSupplyReached
+	prefixBondingFactor
+	prefixInflationPause
+	prefixVestingAccount
 )
 
 // KVStore key prefixes
@@ -28,4 +32,8 @@ var (
 	KeyPrefixEpochIdentifier    = []byte{prefixEpochIdentifier}
 	KeyPrefixEpochsPerPeriod    = []byte{prefixEpochsPerPeriod}
 	KeyPrefixSkippedEpochs      = []byte{prefixSkippedEpochs}
+	KeyPrefixMaxSupplyReached   = []byte{prefixMaxSupplyReached}
+	KeyPrefixBondingFactor      = []byte{prefixBondingFactor}
+	KeyPrefixInflationPause     = []byte{prefixInflationPause}
+	KeyPrefixVestingAccount     = []byte{prefixVestingAccount}
 )
@@ -15,9 +15,12 @@ var DefaultInflationDenom = "atuc"
 // Parameter store keys
 var (
 	ParamStoreKeyMintDenom              = []byte("ParamStoreKeyMintDenom")
-	ParamStoreKeyExponentialCalculation = []byte("ParamStoreKeyExponentialCalculation")
 	ParamStoreKeyInflationDistribution  = []byte("ParamStoreKeyInflationDistribution")
 	ParamStoreKeyEnableInflation        = []byte("ParamStoreKeyEnableInflation")
+	ParamStoreKeyMaxSupply              = []byte("ParamStoreKeyMaxSupply")
+	ParamStoreKeyInflationSchedule      = []byte("ParamStoreKeyInflationSchedule")
+	ParamStoreKeyExcludedAddresses      = []byte("ParamStoreKeyExcludedAddresses")
+	ParamStoreKeyEpochBondingAdjustment = []byte("ParamStoreKeyEpochBondingAdjustment")
 )
 
 // ParamTable for inflation module
@@ -27,34 +30,33 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string,
-	exponentialCalculation ExponentialCalculation,
+	schedule InflationSchedule,
 	inflationDistribution InflationDistribution,
 	enableInflation bool,
+	maxSupply sdkmath.Int,
+	excludedAddresses []string,
+	epochBondingAdjustment EpochBondingAdjustment,
 ) Params {
 	return Params{
 		MintDenom:              mintDenom,
-		ExponentialCalculation: exponentialCalculation,
+		Schedule:               schedule,
 		InflationDistribution:  inflationDistribution,
 		EnableInflation:        enableInflation,
+		MaxSupply:              maxSupply,
+		ExcludedAddresses:      excludedAddresses,
+		EpochBondingAdjustment: epochBondingAdjustment,
 	}
 }
 
 // default minting module parameter
 func DefaultParams() Params {
 	return Params{
-		MintDenom: "atuc",
-		ExponentialCalculation: ExponentialCalculation{
-			A:             sdkmath.LegacyNewDec(int64(16_304_348)),
-			R:             sdkmath.LegacyNewDecWithPrec(35, 2), // 35%
-			C:             sdkmath.LegacyZeroDec(),
-			BondingTarget: sdkmath.LegacyNewDecWithPrec(80, 2), // not relevant; max variance is 0
-			MaxVariance:   sdkmath.LegacyZeroDec(),             // 0%
-		},
-		InflationDistribution: InflationDistribution{
-			StakingRewards: sdkmath.LegacyNewDecWithPrec(1000000000, 9), // 0.53 = 40% / (1 - 25%)
-			CommunityPool:  sdkmath.LegacyZeroDec(),                     // 0.13 = 10% / (1 - 25%)
-		},
-		EnableInflation: false,
+		MintDenom:              "atuc",
+		Schedule:               DefaultInflationSchedule(),
+		InflationDistribution:  DefaultInflationDistribution(),
+		EnableInflation:        false,
+		MaxSupply:              sdkmath.ZeroInt(), // no cap
+		EpochBondingAdjustment: DefaultEpochBondingAdjustment(),
 	}
 }
 
@@ -62,9 +64,12 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyMintDenom, &p.MintDenom, validateMintDenom),
-		paramtypes.NewParamSetPair(ParamStoreKeyExponentialCalculation, &p.ExponentialCalculation, validateExponentialCalculation),
 		paramtypes.NewParamSetPair(ParamStoreKeyInflationDistribution, &p.InflationDistribution, validateInflationDistribution),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableInflation, &p.EnableInflation, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(ParamStoreKeyInflationSchedule, &p.Schedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(ParamStoreKeyExcludedAddresses, &p.ExcludedAddresses, validateExcludedAddresses),
+		paramtypes.NewParamSetPair(ParamStoreKeyEpochBondingAdjustment, &p.EpochBondingAdjustment, validateEpochBondingAdjustment),
 	}
 }
 
@@ -126,28 +131,106 @@ func validateExponentialCalculation(i interface{}) error {
 	return nil
 }
 
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(InflationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
 func validateInflationDistribution(i interface{}) error {
 	v, ok := i.(InflationDistribution)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.StakingRewards.IsNegative() {
-		return errors.New("staking distribution ratio must not be negative")
+	//nolint:staticcheck // the deprecated ratios must stay unset
+	if !v.StakingRewards.IsNil() && !v.StakingRewards.IsZero() ||
+		!v.CommunityPool.IsNil() && !v.CommunityPool.IsZero() {
+		return errors.New("deprecated staking and community pool ratios must be zero, use recipients instead")
 	}
 
-	if v.CommunityPool.IsNegative() {
-		return errors.New("community pool distribution ratio must not be negative")
+	if len(v.Recipients) == 0 {
+		return errors.New("inflation distribution must have at least one recipient")
 	}
 
-	totalProportions := v.StakingRewards.Add(v.CommunityPool)
-	if !totalProportions.Equal(sdkmath.LegacyNewDec(1)) {
+	seenRecipients := make(map[string]bool)
+	totalWeights := sdkmath.LegacyZeroDec()
+	for _, recipient := range v.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", recipient.RecipientType, recipient.Label())
+		if seenRecipients[key] {
+			return fmt.Errorf("duplicated inflation recipient %s", recipient.Label())
+		}
+		seenRecipients[key] = true
+
+		totalWeights = totalWeights.Add(recipient.Weight)
+	}
+
+	if !totalWeights.Equal(sdkmath.LegacyNewDec(1)) {
 		return errors.New("total distributions ratio should be 1")
 	}
 
 	return nil
 }
 
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max supply cannot be nil or negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateExcludedAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, address := range v {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid excluded address %s: %w", address, err)
+		}
+
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicated excluded address %s", address)
+		}
+		seenAddresses[address] = true
+	}
+
+	return nil
+}
+
+func validateEpochBondingAdjustment(i interface{}) error {
+	v, ok := i.(EpochBondingAdjustment)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero max change is omitted by the param store and read back as nil
+	if !v.MaxChangePerEpoch.IsNil() && v.MaxChangePerEpoch.IsNegative() {
+		return errors.New("max change per epoch cannot be negative")
+	}
+
+	if v.Enabled && (v.MaxChangePerEpoch.IsNil() || !v.MaxChangePerEpoch.IsPositive()) {
+		return errors.New("max change per epoch must be positive when the epoch bonding adjustment is enabled")
+	}
+
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -161,12 +244,28 @@ func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
 		return err
 	}
-	if err := validateExponentialCalculation(p.ExponentialCalculation); err != nil {
+	//nolint:staticcheck // the deprecated exponential calculation must stay unset
+	if p.ExponentialCalculation != nil {
+		return errors.New("deprecated exponential calculation must be unset, use the schedule instead")
+	}
+	if err := validateInflationSchedule(p.Schedule); err != nil {
 		return err
 	}
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
 
-	return validateBool(p.EnableInflation)
+	if err := validateBool(p.EnableInflation); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	if err := validateExcludedAddresses(p.ExcludedAddresses); err != nil {
+		return err
+	}
+
+	return validateEpochBondingAdjustment(p.EpochBondingAdjustment)
 }
@@ -29,10 +29,12 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		MaxVariance:   sdkmath.LegacyZeroDec(),
 	}
 
-	validInflationDistribution := InflationDistribution{
-		StakingRewards: sdkmath.LegacyNewDecWithPrec(1000000, 6),
-		CommunityPool:  sdkmath.LegacyZeroDec(),
-	}
+	validInflationDistribution := NewInflationDistribution(
+		NewInflationRecipient(RECIPIENT_TYPE_MODULE, "fee_collector", sdkmath.LegacyNewDecWithPrec(7, 1)),
+		NewInflationRecipient(RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(1, 1)),
+		NewInflationRecipient(RECIPIENT_TYPE_ADDRESS, "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", sdkmath.LegacyNewDecWithPrec(1, 1)),
+		NewInflationRecipient(RECIPIENT_TYPE_CONTRACT, "0x0000000000000000000000000000000000000001", sdkmath.LegacyNewDecWithPrec(1, 1)),
+	)
 
 	testCases := []struct {
 		name     string
@@ -48,9 +50,12 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"valid",
 			NewParams(
 				"atuc",
-				validExponentialCalculation,
+				NewExponentialSchedule(validExponentialCalculation),
 				validInflationDistribution,
 				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
 			),
 			false,
 		},
@@ -58,9 +63,11 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"valid param literal",
 			Params{
 				MintDenom:              "atuc",
-				ExponentialCalculation: validExponentialCalculation,
+				Schedule:               NewExponentialSchedule(validExponentialCalculation),
 				InflationDistribution:  validInflationDistribution,
 				EnableInflation:        true,
+				MaxSupply:              sdkmath.ZeroInt(),
+				EpochBondingAdjustment: DefaultEpochBondingAdjustment(),
 			},
 			false,
 		},
@@ -68,19 +75,22 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - denom",
 			NewParams(
 				"/atuc",
-				validExponentialCalculation,
+				NewExponentialSchedule(validExponentialCalculation),
 				validInflationDistribution,
 				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
 			),
 			true,
 		},
 		{
 			"invalid - denom",
 			Params{
-				MintDenom:              "",
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution:  validInflationDistribution,
-				EnableInflation:        true,
+				MintDenom:             "",
+				Schedule:              NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: validInflationDistribution,
+				EnableInflation:       true,
 			},
 			true,
 		},
@@ -88,13 +98,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - negative A",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(-1)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 1),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -104,13 +114,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - R greater than 1",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 0),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -120,13 +130,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - negative R",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(-5, 1),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -136,13 +146,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - negative C",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 1),
 					C:             sdkmath.LegacyNewDec(int64(-9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -152,13 +162,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - BondingTarget greater than 1",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 1),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 1),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -168,13 +178,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - negative BondingTarget",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 1),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2).Neg(),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
@@ -184,70 +194,371 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"invalid - exponential calculation - negative max Variance",
 			Params{
 				MintDenom: "atuc",
-				ExponentialCalculation: ExponentialCalculation{
+				Schedule: NewExponentialSchedule(ExponentialCalculation{
 					A:             sdkmath.LegacyNewDec(int64(300_000_000)),
 					R:             sdkmath.LegacyNewDecWithPrec(5, 1),
 					C:             sdkmath.LegacyNewDec(int64(9_375_000)),
 					BondingTarget: sdkmath.LegacyNewDecWithPrec(50, 2),
 					MaxVariance:   sdkmath.LegacyNewDecWithPrec(20, 2).Neg(),
-				},
+				}),
 				InflationDistribution: validInflationDistribution,
 				EnableInflation:       true,
 			},
 			true,
 		},
 		{
-			"invalid - inflation distribution - negative staking rewards",
+			"invalid - inflation distribution - deprecated staking rewards",
 			Params{
-				MintDenom:              "atuc",
-				ExponentialCalculation: validExponentialCalculation,
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
 				InflationDistribution: InflationDistribution{
-					StakingRewards: sdkmath.LegacyOneDec().Neg(),
-					CommunityPool:  sdkmath.LegacyNewDecWithPrec(133333, 6),
+					StakingRewards: sdkmath.LegacyOneDec(),
+					CommunityPool:  sdkmath.LegacyZeroDec(),
 				},
 				EnableInflation: true,
 			},
 			true,
 		},
 		{
-			"invalid - inflation distribution - negative usage incentives",
+			"invalid - inflation distribution - no recipients",
 			Params{
-				MintDenom:              "atuc",
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution: InflationDistribution{
-					StakingRewards: sdkmath.LegacyNewDecWithPrec(533334, 6),
-					CommunityPool:  sdkmath.LegacyNewDecWithPrec(133333, 6),
-				},
-				EnableInflation: true,
+				MintDenom:             "atuc",
+				Schedule:              NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(),
+				EnableInflation:       true,
 			},
 			true,
 		},
 		{
-			"invalid - inflation distribution - negative community pool rewards",
+			"invalid - inflation distribution - negative weight",
 			Params{
-				MintDenom:              "atuc",
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution: InflationDistribution{
-					StakingRewards: sdkmath.LegacyNewDecWithPrec(533334, 6),
-					CommunityPool:  sdkmath.LegacyOneDec().Neg(),
-				},
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, "fee_collector", sdkmath.LegacyNewDec(2)),
+					NewInflationRecipient(RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyOneDec().Neg()),
+				),
 				EnableInflation: true,
 			},
 			true,
 		},
 		{
 			"invalid - inflation distribution - total distribution ratio unequal 1",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, "fee_collector", sdkmath.LegacyNewDecWithPrec(533333, 6)),
+					NewInflationRecipient(RECIPIENT_TYPE_COMMUNITY_POOL, "", sdkmath.LegacyNewDecWithPrec(133333, 6)),
+				),
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicated recipient",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, "fee_collector", sdkmath.LegacyNewDecWithPrec(5, 1)),
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, "fee_collector", sdkmath.LegacyNewDecWithPrec(5, 1)),
+				),
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - inflation module recipient",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, ModuleName, sdkmath.LegacyOneDec()),
+				),
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - module recipient not allowed",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_MODULE, "bonded_tokens_pool", sdkmath.LegacyOneDec()),
+				),
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - invalid contract address",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_CONTRACT, "0xinvalid", sdkmath.LegacyOneDec()),
+				),
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - deprecated exponential calculation",
 			Params{
 				MintDenom:              "atuc",
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution: InflationDistribution{
-					StakingRewards: sdkmath.LegacyNewDecWithPrec(533333, 6),
-					CommunityPool:  sdkmath.LegacyNewDecWithPrec(133333, 6),
-				},
+				ExponentialCalculation: &validExponentialCalculation,
+				Schedule:               NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				MaxSupply:              sdkmath.ZeroInt(),
+			},
+			true,
+		},
+		{
+			"invalid - schedule - unset",
+			Params{
+				MintDenom:             "atuc",
+				InflationDistribution: validInflationDistribution,
+				EnableInflation:       true,
+				MaxSupply:             sdkmath.ZeroInt(),
+			},
+			true,
+		},
+		{
+			"valid - step schedule",
+			NewParams(
+				"atuc",
+				NewStepSchedule(
+					NewProvisionStep(0, sdkmath.LegacyNewDec(16_000_000)),
+					NewProvisionStep(2, sdkmath.LegacyNewDec(8_000_000)),
+					NewProvisionStep(5, sdkmath.LegacyZeroDec()),
+				),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			false,
+		},
+		{
+			"invalid - step schedule - no steps",
+			NewParams("atuc", NewStepSchedule(), validInflationDistribution, true, sdkmath.ZeroInt(), nil, DefaultEpochBondingAdjustment()),
+			true,
+		},
+		{
+			"invalid - step schedule - first step after period 0",
+			NewParams(
+				"atuc",
+				NewStepSchedule(NewProvisionStep(1, sdkmath.LegacyNewDec(16_000_000))),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"invalid - step schedule - unsorted steps",
+			NewParams(
+				"atuc",
+				NewStepSchedule(
+					NewProvisionStep(0, sdkmath.LegacyNewDec(16_000_000)),
+					NewProvisionStep(5, sdkmath.LegacyNewDec(8_000_000)),
+					NewProvisionStep(5, sdkmath.LegacyNewDec(4_000_000)),
+				),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"invalid - step schedule - negative provision",
+			NewParams(
+				"atuc",
+				NewStepSchedule(NewProvisionStep(0, sdkmath.LegacyNewDec(-1))),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"valid - linear schedule",
+			NewParams(
+				"atuc",
+				NewLinearSchedule(sdkmath.LegacyNewDec(16_000_000), sdkmath.LegacyNewDec(1_000_000), sdkmath.LegacyNewDec(2_000_000)),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			false,
+		},
+		{
+			"invalid - linear schedule - negative decrease",
+			NewParams(
+				"atuc",
+				NewLinearSchedule(sdkmath.LegacyNewDec(16_000_000), sdkmath.LegacyNewDec(-1), sdkmath.LegacyZeroDec()),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"invalid - linear schedule - min provision greater than initial provision",
+			NewParams(
+				"atuc",
+				NewLinearSchedule(sdkmath.LegacyNewDec(1_000_000), sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(2_000_000)),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"valid - max supply",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.NewInt(1_000_000_000),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			false,
+		},
+		{
+			"invalid - max supply - negative",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.NewInt(-1),
+				nil,
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"invalid - max supply - nil",
+			Params{
+				MintDenom:             "atuc",
+				Schedule:              NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: validInflationDistribution,
+				EnableInflation:       true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - unspecified recipient type",
+			Params{
+				MintDenom: "atuc",
+				Schedule:  NewExponentialSchedule(validExponentialCalculation),
+				InflationDistribution: NewInflationDistribution(
+					NewInflationRecipient(RECIPIENT_TYPE_UNSPECIFIED, "", sdkmath.LegacyOneDec()),
+				),
 				EnableInflation: true,
 			},
 			true,
 		},
+		{
+			"valid - excluded addresses",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				[]string{"cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"},
+				DefaultEpochBondingAdjustment(),
+			),
+			false,
+		},
+		{
+			"invalid - excluded addresses - invalid address",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				[]string{"invalid"},
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"invalid - excluded addresses - duplicated address",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				[]string{
+					"cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+					"cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+				},
+				DefaultEpochBondingAdjustment(),
+			),
+			true,
+		},
+		{
+			"valid - epoch bonding adjustment enabled",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				NewEpochBondingAdjustment(true, sdkmath.LegacyNewDecWithPrec(5, 3)),
+			),
+			false,
+		},
+		{
+			"invalid - epoch bonding adjustment - enabled without max change",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				NewEpochBondingAdjustment(true, sdkmath.LegacyZeroDec()),
+			),
+			true,
+		},
+		{
+			"invalid - epoch bonding adjustment - negative max change",
+			NewParams(
+				"atuc",
+				NewExponentialSchedule(validExponentialCalculation),
+				validInflationDistribution,
+				true,
+				sdkmath.ZeroInt(),
+				nil,
+				NewEpochBondingAdjustment(false, sdkmath.LegacyNewDec(-1)),
+			),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -328,6 +328,8 @@ var xxx_messageInfo_QueryCirculatingSupplyRequest proto.InternalMessageInfo
 type QueryCirculatingSupplyResponse struct {
 	// total amount of coins in circulation
 	CirculatingSupply types.DecCoin `protobuf:"bytes,1,opt,name=circulating_supply,json=circulatingSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"circulating_supply"`
+	// total bank supply of the mint denom
+	TotalSupply types.DecCoin `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total_supply"`
 }
 
 func (m *QueryCirculatingSupplyResponse) Reset()         { *m = QueryCirculatingSupplyResponse{} }
@@ -370,6 +372,13 @@ func (m *QueryCirculatingSupplyResponse) GetCirculatingSupply() types.DecCoin {
 	return types.DecCoin{}
 }
 
+func (m *QueryCirculatingSupplyResponse) GetTotalSupply() types.DecCoin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.DecCoin{}
+}
+
 // QueryInflationRateRequest is the request type for the Query/InflationRate RPC
 // method.
 type QueryInflationRateRequest struct {
@@ -531,6 +540,466 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRemainingSupplyRequest is the request type for the
+// Query/RemainingSupply RPC method.
+type QueryRemainingSupplyRequest struct {
+}
+
+func (m *QueryRemainingSupplyRequest) Reset()         { *m = QueryRemainingSupplyRequest{} }
+func (m *QueryRemainingSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingSupplyRequest) ProtoMessage()    {}
+func (*QueryRemainingSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{12}
+}
+func (m *QueryRemainingSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingSupplyRequest.Merge(m, src)
+}
+func (m *QueryRemainingSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingSupplyRequest proto.InternalMessageInfo
+
+// QueryRemainingSupplyResponse is the response type for the
+// Query/RemainingSupply RPC method.
+type QueryRemainingSupplyResponse struct {
+	// amount of tokens that can still be minted. It is zero when the supply is
+	// not capped.
+	RemainingSupply types.Coin `protobuf:"bytes,1,opt,name=remaining_supply,json=remainingSupply,proto3" json:"remaining_supply"`
+	// true if max_supply is set
+	Capped bool `protobuf:"varint,2,opt,name=capped,proto3" json:"capped,omitempty"`
+	// true once minting stopped because the supply reached max_supply
+	MaxSupplyReached bool `protobuf:"varint,3,opt,name=max_supply_reached,json=maxSupplyReached,proto3" json:"max_supply_reached,omitempty"`
+}
+
+func (m *QueryRemainingSupplyResponse) Reset()         { *m = QueryRemainingSupplyResponse{} }
+func (m *QueryRemainingSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingSupplyResponse) ProtoMessage()    {}
+func (*QueryRemainingSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{13}
+}
+func (m *QueryRemainingSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingSupplyResponse.Merge(m, src)
+}
+func (m *QueryRemainingSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingSupplyResponse) GetRemainingSupply() types.Coin {
+	if m != nil {
+		return m.RemainingSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryRemainingSupplyResponse) GetCapped() bool {
+	if m != nil {
+		return m.Capped
+	}
+	return false
+}
+
+func (m *QueryRemainingSupplyResponse) GetMaxSupplyReached() bool {
+	if m != nil {
+		return m.MaxSupplyReached
+	}
+	return false
+}
+
+// QueryProjectedScheduleRequest is the request type for the
+// Query/ProjectedSchedule RPC method.
+type QueryProjectedScheduleRequest struct {
+	// number of periods to project, starting at the current period
+	Periods uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	// bonded ratio assumed for all projected periods, as a decimal string. The
+	// current bonded ratio is used if it is empty.
+	AssumedBondedRatio string `protobuf:"bytes,2,opt,name=assumed_bonded_ratio,json=assumedBondedRatio,proto3" json:"assumed_bonded_ratio,omitempty"`
+}
+
+func (m *QueryProjectedScheduleRequest) Reset()         { *m = QueryProjectedScheduleRequest{} }
+func (m *QueryProjectedScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedScheduleRequest) ProtoMessage()    {}
+func (*QueryProjectedScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{14}
+}
+func (m *QueryProjectedScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedScheduleRequest.Merge(m, src)
+}
+func (m *QueryProjectedScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedScheduleRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *QueryProjectedScheduleRequest) GetAssumedBondedRatio() string {
+	if m != nil {
+		return m.AssumedBondedRatio
+	}
+	return ""
+}
+
+// QueryProjectedScheduleResponse is the response type for the
+// Query/ProjectedSchedule RPC method.
+type QueryProjectedScheduleResponse struct {
+	// denom of the projected amounts
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// projection of each period
+	Periods []ProjectedPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+	// false if the inflation is disabled or paused. The projection then assumes
+	// that it is enabled again at the next epoch.
+	InflationEnabled bool `protobuf:"varint,3,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty"`
+}
+
+func (m *QueryProjectedScheduleResponse) Reset()         { *m = QueryProjectedScheduleResponse{} }
+func (m *QueryProjectedScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedScheduleResponse) ProtoMessage()    {}
+func (*QueryProjectedScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{15}
+}
+func (m *QueryProjectedScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedScheduleResponse.Merge(m, src)
+}
+func (m *QueryProjectedScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedScheduleResponse) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *QueryProjectedScheduleResponse) GetPeriods() []ProjectedPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *QueryProjectedScheduleResponse) GetInflationEnabled() bool {
+	if m != nil {
+		return m.InflationEnabled
+	}
+	return false
+}
+
+// ProjectedPeriod defines the projected inflation of a period. The projection
+// of the current period only covers its epochs that are not minted yet.
+type ProjectedPeriod struct {
+	// period number
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// amount minted on the first projected epoch of the period. It changes on
+	// every epoch while the epoch bonding adjustment moves the bonding factor.
+	EpochMintProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=epoch_mint_provision,json=epochMintProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_mint_provision"`
+	// total amount minted during the projected epochs of the period
+	PeriodProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=period_provision,json=periodProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"period_provision"`
+	// supply at the end of the period
+	Supply cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supply"`
+	// rate by which the supply increases within the projected epochs of the
+	// period, in percent
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// number of projected epochs of the period
+	Epochs uint64 `protobuf:"varint,6,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *ProjectedPeriod) Reset()         { *m = ProjectedPeriod{} }
+func (m *ProjectedPeriod) String() string { return proto.CompactTextString(m) }
+func (*ProjectedPeriod) ProtoMessage()    {}
+func (*ProjectedPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{16}
+}
+func (m *ProjectedPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedPeriod.Merge(m, src)
+}
+func (m *ProjectedPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedPeriod proto.InternalMessageInfo
+
+func (m *ProjectedPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ProjectedPeriod) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QueryBondingFactorRequest is the request type for the Query/BondingFactor RPC
+// method.
+type QueryBondingFactorRequest struct {
+}
+
+func (m *QueryBondingFactorRequest) Reset()         { *m = QueryBondingFactorRequest{} }
+func (m *QueryBondingFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBondingFactorRequest) ProtoMessage()    {}
+func (*QueryBondingFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{17}
+}
+func (m *QueryBondingFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondingFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondingFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondingFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondingFactorRequest.Merge(m, src)
+}
+func (m *QueryBondingFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondingFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondingFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondingFactorRequest proto.InternalMessageInfo
+
+// QueryBondingFactorResponse is the response type for the Query/BondingFactor
+// RPC method.
+type QueryBondingFactorResponse struct {
+	// bonding factor applied to the current epoch mint provision
+	BondingFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=bonding_factor,json=bondingFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonding_factor"`
+	// bonding factor of the current bonded ratio, without smoothing
+	TargetBondingFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=target_bonding_factor,json=targetBondingFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_bonding_factor"`
+	// current bonded ratio
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *QueryBondingFactorResponse) Reset()         { *m = QueryBondingFactorResponse{} }
+func (m *QueryBondingFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBondingFactorResponse) ProtoMessage()    {}
+func (*QueryBondingFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{18}
+}
+func (m *QueryBondingFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondingFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondingFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondingFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondingFactorResponse.Merge(m, src)
+}
+func (m *QueryBondingFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondingFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondingFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondingFactorResponse proto.InternalMessageInfo
+
+// QueryInflationPauseRequest is the request type for the Query/InflationPause
+// RPC method.
+type QueryInflationPauseRequest struct {
+}
+
+func (m *QueryInflationPauseRequest) Reset()         { *m = QueryInflationPauseRequest{} }
+func (m *QueryInflationPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationPauseRequest) ProtoMessage()    {}
+func (*QueryInflationPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{19}
+}
+func (m *QueryInflationPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationPauseRequest.Merge(m, src)
+}
+func (m *QueryInflationPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationPauseRequest proto.InternalMessageInfo
+
+// QueryInflationPauseResponse is the response type for the Query/InflationPause
+// RPC method.
+type QueryInflationPauseResponse struct {
+	// latest pause and resume of the inflation
+	InflationPause InflationPause `protobuf:"bytes,1,opt,name=inflation_pause,json=inflationPause,proto3" json:"inflation_pause"`
+}
+
+func (m *QueryInflationPauseResponse) Reset()         { *m = QueryInflationPauseResponse{} }
+func (m *QueryInflationPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationPauseResponse) ProtoMessage()    {}
+func (*QueryInflationPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7bc906141a59c4, []int{20}
+}
+func (m *QueryInflationPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationPauseResponse.Merge(m, src)
+}
+func (m *QueryInflationPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationPauseResponse proto.InternalMessageInfo
+
+func (m *QueryInflationPauseResponse) GetInflationPause() InflationPause {
+	if m != nil {
+		return m.InflationPause
+	}
+	return InflationPause{}
+}
+
 func init() {
 	proto.RegisterType((*QueryPeriodRequest)(nil), "canto.inflation.v1.QueryPeriodRequest")
 	proto.RegisterType((*QueryPeriodResponse)(nil), "canto.inflation.v1.QueryPeriodResponse")
@@ -544,58 +1013,98 @@ func init() {
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "canto.inflation.v1.QueryInflationRateResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "canto.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "canto.inflation.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRemainingSupplyRequest)(nil), "canto.inflation.v1.QueryRemainingSupplyRequest")
+	proto.RegisterType((*QueryRemainingSupplyResponse)(nil), "canto.inflation.v1.QueryRemainingSupplyResponse")
+	proto.RegisterType((*QueryProjectedScheduleRequest)(nil), "canto.inflation.v1.QueryProjectedScheduleRequest")
+	proto.RegisterType((*QueryProjectedScheduleResponse)(nil), "canto.inflation.v1.QueryProjectedScheduleResponse")
+	proto.RegisterType((*ProjectedPeriod)(nil), "canto.inflation.v1.ProjectedPeriod")
+	proto.RegisterType((*QueryBondingFactorRequest)(nil), "canto.inflation.v1.QueryBondingFactorRequest")
+	proto.RegisterType((*QueryBondingFactorResponse)(nil), "canto.inflation.v1.QueryBondingFactorResponse")
+	proto.RegisterType((*QueryInflationPauseRequest)(nil), "canto.inflation.v1.QueryInflationPauseRequest")
+	proto.RegisterType((*QueryInflationPauseResponse)(nil), "canto.inflation.v1.QueryInflationPauseResponse")
 }
 
 func init() { proto.RegisterFile("canto/inflation/v1/query.proto", fileDescriptor_bd7bc906141a59c4) }
 
 var fileDescriptor_bd7bc906141a59c4 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x31, 0x6f, 0x13, 0x3f,
-	0x14, 0xc0, 0x73, 0x7f, 0xf5, 0x1f, 0x84, 0x51, 0x2b, 0xd5, 0x54, 0x88, 0x5e, 0xcb, 0xa5, 0x3a,
-	0x41, 0xa9, 0x8a, 0x62, 0x93, 0x64, 0xe9, 0xdc, 0x94, 0x01, 0x89, 0x42, 0x49, 0x17, 0xc4, 0x12,
-	0x5d, 0x2e, 0xe6, 0x6a, 0xa5, 0xb1, 0xaf, 0x67, 0x27, 0x90, 0x01, 0x09, 0xc1, 0x17, 0x40, 0x62,
-	0x63, 0x60, 0x61, 0x40, 0xea, 0xc2, 0xc2, 0x87, 0xe8, 0x58, 0xc1, 0x82, 0x18, 0x0a, 0x6a, 0xf9,
-	0x20, 0xe8, 0x6c, 0x5f, 0x9a, 0x34, 0x4e, 0x4a, 0x07, 0xa6, 0xf6, 0xfc, 0x9e, 0xdf, 0xfb, 0xf9,
-	0x9d, 0x7f, 0x17, 0xe0, 0x85, 0x01, 0x93, 0x1c, 0x53, 0xf6, 0x6c, 0x37, 0x90, 0x94, 0x33, 0xdc,
-	0x2d, 0xe1, 0xbd, 0x0e, 0x49, 0x7a, 0x28, 0x4e, 0xb8, 0xe4, 0x10, 0xaa, 0x38, 0xea, 0xc7, 0x51,
-	0xb7, 0xe4, 0xce, 0x45, 0x3c, 0xe2, 0x2a, 0x8c, 0xd3, 0xff, 0x74, 0xa6, 0xbb, 0x18, 0x71, 0x1e,
-	0xed, 0x12, 0x1c, 0xc4, 0x14, 0x07, 0x8c, 0x71, 0xa9, 0xf2, 0x85, 0x89, 0x7a, 0x21, 0x17, 0x6d,
-	0x2e, 0x70, 0x23, 0x10, 0x04, 0x77, 0x4b, 0x0d, 0x22, 0x83, 0x12, 0x0e, 0x39, 0x65, 0x26, 0xbe,
-	0x64, 0xe1, 0x88, 0x08, 0x23, 0x82, 0x66, 0x15, 0xe6, 0x75, 0x85, 0xba, 0x6e, 0xac, 0x1f, 0x74,
-	0xc8, 0x9f, 0x03, 0xf0, 0x71, 0xca, 0xbc, 0x45, 0x12, 0xca, 0x9b, 0x35, 0xb2, 0xd7, 0x21, 0x42,
-	0xfa, 0x45, 0x70, 0x75, 0x68, 0x55, 0xc4, 0x9c, 0x09, 0x02, 0xaf, 0x81, 0x7c, 0xac, 0x56, 0xae,
-	0x3b, 0x4b, 0xce, 0xca, 0x54, 0xcd, 0x3c, 0xf9, 0x4b, 0xc0, 0x53, 0xe9, 0xf7, 0x62, 0x1e, 0xee,
-	0x6c, 0x52, 0x26, 0xb7, 0x12, 0xde, 0xa5, 0x82, 0x72, 0x96, 0x15, 0xfc, 0xe4, 0x80, 0xc2, 0xd8,
-	0x14, 0x53, 0xfd, 0x8d, 0x03, 0xe6, 0x48, 0x1a, 0xae, 0xb7, 0x29, 0x93, 0x29, 0xac, 0x4e, 0x50,
-	0xcd, 0xae, 0x94, 0x17, 0x91, 0x01, 0x4f, 0xe7, 0x80, 0xcc, 0x1c, 0xd0, 0x06, 0x09, 0xab, 0x9c,
-	0xb2, 0xf5, 0xca, 0xc1, 0x51, 0x21, 0xb7, 0xff, 0xb3, 0x70, 0x27, 0xa2, 0x72, 0xa7, 0xd3, 0x40,
-	0x21, 0x6f, 0x9b, 0x83, 0x9a, 0x3f, 0x45, 0xd1, 0x6c, 0x61, 0xd9, 0x8b, 0x89, 0xc8, 0xf6, 0x88,
-	0x1a, 0x24, 0x23, 0x34, 0xfe, 0x02, 0x98, 0x57, 0xa0, 0xdb, 0x2d, 0x1a, 0xc7, 0xa4, 0xa9, 0x78,
-	0x45, 0x76, 0x8c, 0x2a, 0x70, 0x6d, 0x41, 0x73, 0x80, 0x5b, 0x60, 0x46, 0xe8, 0x40, 0x5d, 0x15,
-	0x16, 0x66, 0x4c, 0xd3, 0x62, 0x30, 0xdd, 0x2f, 0x80, 0x1b, 0xaa, 0x48, 0x95, 0x26, 0x61, 0x27,
-	0x7d, 0x65, 0x2c, 0xda, 0xee, 0xc4, 0xf1, 0x6e, 0x2f, 0xeb, 0xf2, 0xd1, 0x31, 0xf3, 0xb4, 0x64,
-	0x98, 0x56, 0xaf, 0x1c, 0x00, 0xc3, 0xd3, 0x68, 0x5d, 0xa8, 0xf0, 0xbf, 0x9b, 0xd4, 0x6c, 0x78,
-	0x16, 0xa5, 0x3f, 0xa8, 0xfb, 0xd9, 0xbd, 0xab, 0x05, 0x92, 0x64, 0x47, 0xe8, 0x9a, 0x41, 0x9d,
-	0x09, 0x1a, 0xfa, 0x27, 0x60, 0xa6, 0x7f, 0x5b, 0xeb, 0x49, 0x20, 0x89, 0x02, 0xbf, 0xbc, 0x5e,
-	0x4a, 0xd1, 0x7e, 0x1c, 0x15, 0x16, 0x34, 0x88, 0x68, 0xb6, 0x10, 0xe5, 0xb8, 0x1d, 0xc8, 0x1d,
-	0xf4, 0x80, 0x44, 0x41, 0xd8, 0xdb, 0x20, 0xe1, 0xd7, 0x2f, 0x45, 0x60, 0x8e, 0xb7, 0x41, 0xc2,
-	0xda, 0x34, 0x1d, 0xec, 0x70, 0x7a, 0x9d, 0x83, 0x24, 0x68, 0xf7, 0x5f, 0xdb, 0xa3, 0xec, 0x3a,
-	0x9b, 0x55, 0x83, 0xb1, 0x06, 0xf2, 0xb1, 0x5a, 0x31, 0x73, 0x73, 0xd1, 0xa8, 0xb1, 0x48, 0xef,
-	0x59, 0x9f, 0x4a, 0xd1, 0x6a, 0x26, 0xbf, 0xfc, 0xe1, 0x12, 0xf8, 0x5f, 0x55, 0x84, 0x2f, 0x41,
-	0x5e, 0x4b, 0x02, 0x97, 0x6d, 0xbb, 0x47, 0xdd, 0x72, 0x6f, 0x9f, 0x9b, 0xa7, 0xf1, 0x7c, 0xff,
-	0xf5, 0xb7, 0xdf, 0xef, 0xfe, 0x5b, 0x84, 0x2e, 0xb6, 0x08, 0xae, 0xcd, 0x83, 0x9f, 0x1d, 0x00,
-	0x47, 0x95, 0x82, 0xe5, 0xb1, 0x3d, 0xc6, 0x2a, 0xea, 0x56, 0x2e, 0xb4, 0xc7, 0x30, 0xde, 0x55,
-	0x8c, 0xab, 0x70, 0xc5, 0xc6, 0x68, 0x93, 0x19, 0xbe, 0x77, 0xc0, 0xf4, 0x90, 0x3e, 0xb0, 0x38,
-	0xb6, 0xb1, 0xcd, 0x41, 0x17, 0xfd, 0x6d, 0xba, 0x41, 0x5c, 0x55, 0x88, 0x37, 0xa1, 0x6f, 0x43,
-	0x1c, 0xf6, 0x15, 0xee, 0x3b, 0x60, 0x76, 0x44, 0x3a, 0x58, 0x1a, 0xdb, 0x71, 0x9c, 0xc2, 0x6e,
-	0xf9, 0x22, 0x5b, 0x0c, 0x28, 0x52, 0xa0, 0x2b, 0x70, 0xd9, 0x06, 0x3a, 0x2a, 0xbb, 0x9a, 0xe4,
-	0x90, 0x5f, 0x13, 0x26, 0x69, 0x93, 0x74, 0xc2, 0x24, 0xad, 0xda, 0x4e, 0x9e, 0xe4, 0xb0, 0xd0,
-	0xca, 0x0b, 0xe5, 0xca, 0x24, 0x2f, 0x06, 0x25, 0x9d, 0xe4, 0xc5, 0x90, 0xb6, 0xe7, 0x78, 0xa1,
-	0x75, 0xdd, 0x3c, 0x38, 0xf6, 0x9c, 0xc3, 0x63, 0xcf, 0xf9, 0x75, 0xec, 0x39, 0x6f, 0x4f, 0xbc,
-	0xdc, 0xe1, 0x89, 0x97, 0xfb, 0x7e, 0xe2, 0xe5, 0x9e, 0x56, 0x06, 0x3e, 0x7b, 0xd5, 0x74, 0x7f,
-	0xf1, 0x21, 0x91, 0xcf, 0x79, 0xd2, 0xd2, 0x4f, 0xb8, 0xbb, 0x86, 0x5f, 0x0c, 0x94, 0x54, 0xdf,
-	0xc1, 0x46, 0x5e, 0xfd, 0x58, 0x56, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x33, 0x05, 0x21,
-	0xf3, 0x07, 0x00, 0x00,
+	// 1219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0xa9, 0x21, 0x2f, 0xff, 0x27, 0x01, 0x25, 0x9b, 0xc4, 0x89, 0xb6, 0x6d, 0x1a,
+	0x35, 0xcd, 0x6e, 0xed, 0x5c, 0x7a, 0x44, 0x4e, 0x8a, 0x54, 0x44, 0x21, 0x75, 0x38, 0x20, 0x84,
+	0xb4, 0x1a, 0xaf, 0xa7, 0xce, 0x12, 0x7b, 0x67, 0xbb, 0x33, 0x36, 0x89, 0x10, 0x12, 0x82, 0x2f,
+	0x80, 0xc4, 0x09, 0x0e, 0x1c, 0xb8, 0x20, 0x7a, 0xe0, 0x8f, 0xe0, 0x43, 0xf4, 0x58, 0xc1, 0x05,
+	0x71, 0x28, 0x28, 0xe1, 0x83, 0xa0, 0x9d, 0x99, 0xb5, 0xbd, 0xf6, 0x6c, 0xea, 0x84, 0xf6, 0x94,
+	0xcc, 0xbc, 0x37, 0xef, 0xf7, 0x9b, 0x37, 0xbf, 0xf7, 0xf6, 0x19, 0x0a, 0x1e, 0x0e, 0x38, 0x75,
+	0xfc, 0xe0, 0x61, 0x03, 0x73, 0x9f, 0x06, 0x4e, 0xbb, 0xe8, 0x3c, 0x6a, 0x91, 0xe8, 0xc4, 0x0e,
+	0x23, 0xca, 0x29, 0x42, 0xc2, 0x6e, 0x77, 0xec, 0x76, 0xbb, 0x68, 0x2e, 0xd4, 0x69, 0x9d, 0x0a,
+	0xb3, 0x13, 0xff, 0x27, 0x3d, 0xcd, 0x95, 0x3a, 0xa5, 0xf5, 0x06, 0x71, 0x70, 0xe8, 0x3b, 0x38,
+	0x08, 0x28, 0x17, 0xfe, 0x4c, 0x59, 0x0b, 0x1e, 0x65, 0x4d, 0xca, 0x9c, 0x2a, 0x66, 0xc4, 0x69,
+	0x17, 0xab, 0x84, 0xe3, 0xa2, 0xe3, 0x51, 0x3f, 0x50, 0xf6, 0x75, 0x0d, 0x8f, 0x3a, 0x09, 0x08,
+	0xf3, 0x93, 0x08, 0x96, 0xc6, 0xa3, 0x4b, 0x4b, 0xfa, 0x2c, 0x49, 0x14, 0x57, 0x92, 0x93, 0x0b,
+	0x69, 0xb2, 0x16, 0x00, 0x3d, 0x88, 0xef, 0xb5, 0x4f, 0x22, 0x9f, 0xd6, 0x2a, 0xe4, 0x51, 0x8b,
+	0x30, 0x6e, 0x6d, 0xc3, 0x7c, 0x6a, 0x97, 0x85, 0x34, 0x60, 0x04, 0xbd, 0x0e, 0xf9, 0x50, 0xec,
+	0x2c, 0x1a, 0xeb, 0xc6, 0xe6, 0x58, 0x45, 0xad, 0xac, 0x75, 0x28, 0x08, 0xf7, 0xbb, 0x21, 0xf5,
+	0x0e, 0xef, 0xfb, 0x01, 0xdf, 0x8f, 0x68, 0xdb, 0x67, 0x3e, 0x0d, 0x92, 0x80, 0xdf, 0x1b, 0xb0,
+	0x96, 0xe9, 0xa2, 0xa2, 0x7f, 0x61, 0xc0, 0x02, 0x89, 0xcd, 0x6e, 0xd3, 0x0f, 0xb8, 0x1b, 0x26,
+	0x0e, 0x02, 0x6c, 0xa2, 0xb4, 0x62, 0x2b, 0xe2, 0x71, 0xae, 0x6c, 0x95, 0x2b, 0x7b, 0x8f, 0x78,
+	0xbb, 0xd4, 0x0f, 0xca, 0x3b, 0x4f, 0x9e, 0xad, 0x8d, 0x3c, 0xfe, 0x7b, 0x6d, 0xab, 0xee, 0xf3,
+	0xc3, 0x56, 0xd5, 0xf6, 0x68, 0x53, 0x5d, 0x54, 0xfd, 0xd9, 0x66, 0xb5, 0x23, 0x87, 0x9f, 0x84,
+	0x84, 0x25, 0x67, 0x58, 0x05, 0x91, 0x01, 0x36, 0xd6, 0x32, 0x2c, 0x09, 0xa2, 0x07, 0x47, 0x7e,
+	0x18, 0x92, 0x9a, 0xe0, 0xcb, 0x92, 0x6b, 0xec, 0x82, 0xa9, 0x33, 0xaa, 0x0b, 0x5c, 0x87, 0x69,
+	0x26, 0x0d, 0xae, 0x08, 0xcc, 0x54, 0x9a, 0xa6, 0x58, 0xaf, 0xbb, 0xb5, 0x06, 0xab, 0x22, 0xc8,
+	0xae, 0x1f, 0x79, 0xad, 0xf8, 0x9d, 0x82, 0xfa, 0x41, 0x2b, 0x0c, 0x1b, 0x27, 0x09, 0xca, 0xd7,
+	0x39, 0x28, 0x64, 0x79, 0x28, 0xa8, 0xcf, 0x0c, 0x40, 0x5e, 0xd7, 0xea, 0x32, 0x61, 0x7e, 0x79,
+	0x99, 0x9a, 0xf3, 0xfa, 0xa9, 0x20, 0x0e, 0x93, 0x9c, 0x72, 0xdc, 0x48, 0xb0, 0x73, 0x2f, 0x0b,
+	0x7b, 0x42, 0xc0, 0x48, 0xd4, 0xce, 0xf3, 0xdc, 0x4b, 0x24, 0x5e, 0xc1, 0x9c, 0x24, 0x89, 0x6b,
+	0x83, 0xa9, 0x33, 0xaa, 0x9c, 0xbd, 0x0f, 0xd3, 0x9d, 0xc2, 0x70, 0x23, 0xcc, 0x89, 0x48, 0xd7,
+	0x78, 0xb9, 0x18, 0x93, 0xfa, 0xeb, 0xd9, 0xda, 0xb2, 0xa4, 0xc0, 0x6a, 0x47, 0xb6, 0x4f, 0x9d,
+	0x26, 0xe6, 0x87, 0xf6, 0xdb, 0xa4, 0x8e, 0xbd, 0x93, 0x3d, 0xe2, 0xfd, 0xfe, 0xdb, 0x36, 0xa8,
+	0x8b, 0xed, 0x11, 0xaf, 0x32, 0xe5, 0xf7, 0x22, 0x74, 0x8b, 0x08, 0x47, 0xb8, 0xd9, 0x11, 0xcb,
+	0xbb, 0x30, 0x9f, 0xda, 0x55, 0x34, 0xee, 0x40, 0x3e, 0x14, 0x3b, 0xea, 0xb5, 0x4c, 0x7b, 0xb0,
+	0x97, 0xd8, 0xf2, 0x4c, 0x79, 0x2c, 0xa6, 0x56, 0x51, 0xfe, 0xd6, 0x2a, 0x2c, 0x8b, 0x80, 0x15,
+	0xd2, 0xc4, 0x7e, 0x30, 0x20, 0x9b, 0x9f, 0x0d, 0x58, 0xd1, 0xdb, 0x15, 0xf2, 0x5b, 0x30, 0x1b,
+	0x25, 0xa6, 0xb4, 0x62, 0x96, 0xb4, 0xaf, 0x26, 0x9e, 0x4c, 0x52, 0x98, 0x89, 0xd2, 0x31, 0xe3,
+	0x56, 0xe0, 0xe1, 0x58, 0xd4, 0xe2, 0xdd, 0x5f, 0xad, 0xa8, 0x15, 0xba, 0x05, 0xa8, 0x89, 0x8f,
+	0x55, 0x74, 0x37, 0x22, 0xd8, 0x3b, 0x24, 0xb5, 0xc5, 0x51, 0xe1, 0x33, 0xdb, 0xc4, 0xc7, 0x09,
+	0x25, 0xb1, 0x6f, 0x7d, 0xa2, 0x4a, 0x61, 0x3f, 0xa2, 0x1f, 0x11, 0x8f, 0x93, 0xda, 0x41, 0xbc,
+	0xdd, 0x6a, 0x24, 0x2f, 0x8a, 0x16, 0xe1, 0x15, 0xd9, 0x63, 0x92, 0x5a, 0x4a, 0x96, 0xe8, 0x0d,
+	0x58, 0xc0, 0x8c, 0xb5, 0x9a, 0xa4, 0xe6, 0x56, 0x69, 0x50, 0x23, 0xb5, 0xf8, 0x49, 0x7d, 0x2a,
+	0xe8, 0x8c, 0x97, 0xa7, 0xfb, 0x1e, 0x0c, 0x29, 0xdf, 0xb2, 0x70, 0xad, 0xc4, 0x9e, 0xd6, 0x2f,
+	0x06, 0x14, 0xb2, 0xd0, 0x55, 0xc6, 0x56, 0x01, 0x44, 0x2f, 0xaa, 0x91, 0x80, 0x36, 0xa5, 0x5c,
+	0x2a, 0xe3, 0xf1, 0xce, 0x5e, 0xbc, 0x81, 0x76, 0xbb, 0xec, 0x72, 0xeb, 0xa3, 0x9b, 0x13, 0xa5,
+	0xab, 0xda, 0xb7, 0x4c, 0xc2, 0xcb, 0x6e, 0xaa, 0x32, 0xda, 0xb9, 0xc8, 0x16, 0xcc, 0x75, 0x65,
+	0x49, 0x02, 0x5c, 0x6d, 0x74, 0x13, 0xd6, 0x31, 0xdc, 0x95, 0xfb, 0xd6, 0x0f, 0xa3, 0x30, 0xd3,
+	0x17, 0x2f, 0xab, 0x2b, 0x23, 0x2f, 0xa3, 0x9d, 0xe6, 0x2e, 0xab, 0x7a, 0x4d, 0xbb, 0x44, 0x1f,
+	0xc2, 0xac, 0x84, 0xeb, 0x01, 0x18, 0xbd, 0x2c, 0xc0, 0x8c, 0x0c, 0xd5, 0x8d, 0x7e, 0x0f, 0xf2,
+	0x4a, 0xa7, 0x63, 0x97, 0x8d, 0xa9, 0x02, 0x68, 0xaa, 0xff, 0xca, 0x8b, 0xa9, 0xfe, 0x38, 0xff,
+	0xaa, 0xdd, 0xe7, 0x65, 0xfe, 0xe5, 0xaa, 0xd3, 0xaa, 0x62, 0xcd, 0xf9, 0x41, 0xfd, 0x4d, 0xec,
+	0x71, 0x1a, 0x25, 0xc5, 0xfa, 0x6b, 0x0e, 0x4c, 0x9d, 0xb5, 0xdb, 0xab, 0xaa, 0xd2, 0xe0, 0x3e,
+	0x14, 0x96, 0xff, 0xd1, 0xab, 0xaa, 0xbd, 0x08, 0x88, 0xc0, 0x6b, 0x1c, 0x47, 0x75, 0xc2, 0xdd,
+	0x3e, 0x80, 0x4b, 0xcb, 0x62, 0x5e, 0xc6, 0x4b, 0x5d, 0x04, 0xbd, 0x07, 0x93, 0xa9, 0xb2, 0xbc,
+	0xb4, 0x26, 0x26, 0xaa, 0x3d, 0x25, 0xbb, 0xd2, 0xdf, 0xe0, 0xf7, 0x71, 0x8b, 0x75, 0xda, 0x7f,
+	0x08, 0xcb, 0x5a, 0xab, 0xca, 0xe9, 0x03, 0x98, 0xe9, 0x2a, 0x20, 0x8c, 0x4d, 0xaa, 0xfb, 0x59,
+	0xba, 0xaa, 0x4d, 0x07, 0x51, 0x45, 0x3b, 0xed, 0xa7, 0x76, 0x4b, 0x3f, 0x4e, 0xc2, 0x15, 0x01,
+	0x89, 0x3e, 0x85, 0xbc, 0x2a, 0xc7, 0x0d, 0x5d, 0xb4, 0xc1, 0x19, 0xcb, 0xbc, 0xf1, 0x5c, 0x3f,
+	0xc9, 0xdb, 0xb2, 0x3e, 0xff, 0xe3, 0xdf, 0xaf, 0x72, 0x2b, 0xc8, 0x74, 0x34, 0xa3, 0x9e, 0xaa,
+	0xf5, 0x9f, 0x0c, 0x40, 0x83, 0xa3, 0x15, 0x2a, 0x65, 0x62, 0x64, 0x8e, 0x6a, 0xe6, 0xce, 0x85,
+	0xce, 0x28, 0x8e, 0xb7, 0x05, 0xc7, 0x9b, 0x68, 0x53, 0xc7, 0x51, 0xd7, 0x85, 0xd0, 0x37, 0x06,
+	0x4c, 0xa5, 0xc6, 0x28, 0xb4, 0x9d, 0x09, 0xac, 0x9b, 0xc5, 0x4c, 0x7b, 0x58, 0x77, 0x45, 0xf1,
+	0xa6, 0xa0, 0x78, 0x0d, 0x59, 0x3a, 0x8a, 0xe9, 0xb9, 0x0d, 0x3d, 0x36, 0x60, 0x6e, 0x60, 0xf8,
+	0x42, 0xc5, 0x4c, 0xc4, 0xac, 0x51, 0xce, 0x2c, 0x5d, 0xe4, 0x88, 0x22, 0x6a, 0x0b, 0xa2, 0x9b,
+	0x68, 0x43, 0x47, 0x74, 0x70, 0xe8, 0x13, 0x99, 0x4c, 0x4d, 0x3c, 0xe7, 0x64, 0x52, 0x37, 0x36,
+	0x99, 0xf6, 0xb0, 0xee, 0xc3, 0x64, 0x32, 0xdd, 0x64, 0x45, 0x5d, 0x88, 0xe9, 0xe5, 0xbc, 0xba,
+	0xe8, 0x1d, 0x9b, 0xcc, 0x1b, 0xcf, 0xf5, 0x1b, 0xaa, 0x2e, 0x24, 0xe8, 0x77, 0x06, 0xcc, 0xf4,
+	0x8d, 0x43, 0xc8, 0xc9, 0x04, 0xd0, 0x0f, 0x56, 0xe6, 0xed, 0xe1, 0x0f, 0x28, 0x6a, 0xb7, 0x04,
+	0xb5, 0x0d, 0x74, 0x4d, 0x47, 0xad, 0x7f, 0x06, 0x13, 0x6a, 0x1b, 0x98, 0x41, 0xce, 0x51, 0x5b,
+	0xd6, 0xb4, 0x64, 0x96, 0x2e, 0x72, 0x64, 0x18, 0xb5, 0x85, 0xc9, 0x31, 0x97, 0x25, 0xb4, 0x62,
+	0xb5, 0xa5, 0x5b, 0x7d, 0xb6, 0xda, 0x74, 0x5f, 0x3e, 0xd3, 0x1e, 0xd6, 0x7d, 0x18, 0xb5, 0xa5,
+	0xbf, 0x61, 0xe8, 0x5b, 0x03, 0xa6, 0xd3, 0x8d, 0x1b, 0x0d, 0x21, 0xee, 0xde, 0x8f, 0x88, 0xe9,
+	0x0c, 0xed, 0xaf, 0xf8, 0x6d, 0x09, 0x7e, 0xd7, 0xd1, 0xd5, 0xf3, 0xab, 0x41, 0x7c, 0x70, 0xca,
+	0xf7, 0x9f, 0x9c, 0x16, 0x8c, 0xa7, 0xa7, 0x05, 0xe3, 0x9f, 0xd3, 0x82, 0xf1, 0xe5, 0x59, 0x61,
+	0xe4, 0xe9, 0x59, 0x61, 0xe4, 0xcf, 0xb3, 0xc2, 0xc8, 0x07, 0x3b, 0x3d, 0x3f, 0x89, 0x76, 0xe3,
+	0x40, 0xdb, 0xef, 0x10, 0xfe, 0x31, 0x8d, 0x8e, 0xe4, 0xca, 0x69, 0xdf, 0x71, 0x8e, 0x7b, 0x62,
+	0x8b, 0xdf, 0x48, 0xd5, 0xbc, 0xf8, 0x11, 0xbf, 0xf3, 0xdf, 0x00, 0x31, 0x36, 0x2e, 0x82, 0xaf,
+	0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -616,13 +1125,27 @@ type QueryClient interface {
 	EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error)
 	// SkippedEpochs retrieves the total number of skipped epochs.
 	SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error)
-	// CirculatingSupply retrieves the total number of tokens that are in
-	// circulation (i.e. excluding unvested tokens).
+	// CirculatingSupply retrieves the total supply and the number of tokens that
+	// are in circulation (i.e. excluding module accounts, excluded addresses and
+	// unvested tokens).
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RemainingSupply retrieves the amount of tokens that can still be minted
+	// before the supply reaches max_supply.
+	RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error)
+	// ProjectedSchedule simulates the inflation schedule forward from the
+	// current period and returns the projected provisions, supply and inflation
+	// rate of each period.
+	ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error)
+	// BondingFactor retrieves the bonding incentive factor applied to the
+	// current epoch mint provision and the one targeted by the bonded ratio.
+	BondingFactor(ctx context.Context, in *QueryBondingFactorRequest, opts ...grpc.CallOption) (*QueryBondingFactorResponse, error)
+	// InflationPause retrieves whether the inflation is paused and the heights
+	// of its latest pause and resume.
+	InflationPause(ctx context.Context, in *QueryInflationPauseRequest, opts ...grpc.CallOption) (*QueryInflationPauseResponse, error)
 }
 
 type queryClient struct {
@@ -687,22 +1210,72 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Period retrieves current period.
-	Period(context.Context, *QueryPeriodRequest) (*QueryPeriodResponse, error)
-	// EpochMintProvision retrieves current minting epoch provision value.
-	EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error)
-	// SkippedEpochs retrieves the total number of skipped epochs.
-	SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error)
-	// CirculatingSupply retrieves the total number of tokens that are in
-	// circulation (i.e. excluding unvested tokens).
-	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
-	// InflationRate retrieves the inflation rate of the current period.
-	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
-	// Params retrieves the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-}
+func (c *queryClient) RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error) {
+	out := new(QueryRemainingSupplyResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Query/RemainingSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedSchedule(ctx context.Context, in *QueryProjectedScheduleRequest, opts ...grpc.CallOption) (*QueryProjectedScheduleResponse, error) {
+	out := new(QueryProjectedScheduleResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Query/ProjectedSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BondingFactor(ctx context.Context, in *QueryBondingFactorRequest, opts ...grpc.CallOption) (*QueryBondingFactorResponse, error) {
+	out := new(QueryBondingFactorResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Query/BondingFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationPause(ctx context.Context, in *QueryInflationPauseRequest, opts ...grpc.CallOption) (*QueryInflationPauseResponse, error) {
+	out := new(QueryInflationPauseResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Query/InflationPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Period retrieves current period.
+	Period(context.Context, *QueryPeriodRequest) (*QueryPeriodResponse, error)
+	// EpochMintProvision retrieves current minting epoch provision value.
+	EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error)
+	// SkippedEpochs retrieves the total number of skipped epochs.
+	SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error)
+	// CirculatingSupply retrieves the total supply and the number of tokens that
+	// are in circulation (i.e. excluding module accounts, excluded addresses and
+	// unvested tokens).
+	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// InflationRate retrieves the inflation rate of the current period.
+	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// Params retrieves the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RemainingSupply retrieves the amount of tokens that can still be minted
+	// before the supply reaches max_supply.
+	RemainingSupply(context.Context, *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error)
+	// ProjectedSchedule simulates the inflation schedule forward from the
+	// current period and returns the projected provisions, supply and inflation
+	// rate of each period.
+	ProjectedSchedule(context.Context, *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error)
+	// BondingFactor retrieves the bonding incentive factor applied to the
+	// current epoch mint provision and the one targeted by the bonded ratio.
+	BondingFactor(context.Context, *QueryBondingFactorRequest) (*QueryBondingFactorResponse, error)
+	// InflationPause retrieves whether the inflation is paused and the heights
+	// of its latest pause and resume.
+	InflationPause(context.Context, *QueryInflationPauseRequest) (*QueryInflationPauseResponse, error)
+}
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
@@ -726,6 +1299,18 @@ func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryIn
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RemainingSupply(ctx context.Context, req *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingSupply not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedSchedule(ctx context.Context, req *QueryProjectedScheduleRequest) (*QueryProjectedScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSchedule not implemented")
+}
+func (*UnimplementedQueryServer) BondingFactor(ctx context.Context, req *QueryBondingFactorRequest) (*QueryBondingFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondingFactor not implemented")
+}
+func (*UnimplementedQueryServer) InflationPause(ctx context.Context, req *QueryInflationPauseRequest) (*QueryInflationPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationPause not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -839,6 +1424,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RemainingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Query/RemainingSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingSupply(ctx, req.(*QueryRemainingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Query/ProjectedSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSchedule(ctx, req.(*QueryProjectedScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BondingFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondingFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondingFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Query/BondingFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondingFactor(ctx, req.(*QueryBondingFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Query/InflationPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationPause(ctx, req.(*QueryInflationPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "canto.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -867,6 +1524,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RemainingSupply",
+			Handler:    _Query_RemainingSupply_Handler,
+		},
+		{
+			MethodName: "ProjectedSchedule",
+			Handler:    _Query_ProjectedSchedule_Handler,
+		},
+		{
+			MethodName: "BondingFactor",
+			Handler:    _Query_BondingFactor_Handler,
+		},
+		{
+			MethodName: "InflationPause",
+			Handler:    _Query_InflationPause_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/inflation/v1/query.proto",
@@ -1073,6 +1746,16 @@ func (m *QueryCirculatingSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1198,146 +1881,1320 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRemainingSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
-	}
-	return n
+func (m *QueryRemainingSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochMintProvisionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRemainingSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochMintProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRemainingSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EpochMintProvision.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySkippedEpochsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryRemainingSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySkippedEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRemainingSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SkippedEpochs != 0 {
-		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	if m.MaxSupplyReached {
+		i--
+		if m.MaxSupplyReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.RemainingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCirculatingSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectedScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCirculatingSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CirculatingSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProjectedScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectedScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.AssumedBondedRatio) > 0 {
+		i -= len(m.AssumedBondedRatio)
+		copy(dAtA[i:], m.AssumedBondedRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssumedBondedRatio)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectedScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectedScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InflationEnabled {
+		i--
+		if m.InflationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PeriodProvision.Size()
+		i -= size
+		if _, err := m.PeriodProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EpochMintProvision.Size()
+		i -= size
+		if _, err := m.EpochMintProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondingFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondingFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondingFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondingFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondingFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondingFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetBondingFactor.Size()
+		i -= size
+		if _, err := m.TargetBondingFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BondingFactor.Size()
+		i -= size
+		if _, err := m.BondingFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InflationPause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *QueryEpochMintProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochMintProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySkippedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkippedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	return n
+}
+
+func (m *QueryCirculatingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemainingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Capped {
+		n += 2
+	}
+	if m.MaxSupplyReached {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProjectedScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	l = len(m.AssumedBondedRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InflationEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProjectedPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PeriodProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QueryBondingFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBondingFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondingFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetBondingFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationPause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			}
+			m.SkippedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,10 +3217,10 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1387,7 +3244,7 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1410,17 +3267,17 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1430,11 +3287,25 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1456,7 +3327,7 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,10 +3350,10 @@ func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1506,7 +3377,7 @@ func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1529,15 +3400,15 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSupply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1564,10 +3435,50 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyReached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1589,7 +3500,7 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1612,12 +3523,63 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssumedBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssumedBondedRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1639,7 +3601,7 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1662,17 +3624,17 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
 			}
-			m.SkippedEpochs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1682,61 +3644,78 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Periods = append(m.Periods, ProjectedPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.InflationEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1758,7 +3737,7 @@ func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *ProjectedPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1781,17 +3760,36 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectedPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectedPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1801,25 +3799,147 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1841,7 +3961,7 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBondingFactorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1864,10 +3984,10 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondingFactorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondingFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1891,7 +4011,7 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBondingFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1914,15 +4034,15 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondingFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondingFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1950,7 +4070,75 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBondingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBondingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1975,7 +4163,7 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1998,10 +4186,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2025,7 +4213,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2048,15 +4236,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationPause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2083,7 +4271,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
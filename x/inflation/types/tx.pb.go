@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -128,38 +129,242 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgPauseInflation defines a message to pause the inflation.
+type MsgPauseInflation struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgPauseInflation) Reset()         { *m = MsgPauseInflation{} }
+func (m *MsgPauseInflation) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseInflation) ProtoMessage()    {}
+func (*MsgPauseInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd435bd13fdf9eb, []int{2}
+}
+func (m *MsgPauseInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseInflation.Merge(m, src)
+}
+func (m *MsgPauseInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseInflation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseInflation proto.InternalMessageInfo
+
+func (m *MsgPauseInflation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgPauseInflationResponse defines the response structure for executing a
+// MsgPauseInflation message.
+type MsgPauseInflationResponse struct {
+}
+
+func (m *MsgPauseInflationResponse) Reset()         { *m = MsgPauseInflationResponse{} }
+func (m *MsgPauseInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseInflationResponse) ProtoMessage()    {}
+func (*MsgPauseInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd435bd13fdf9eb, []int{3}
+}
+func (m *MsgPauseInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseInflationResponse.Merge(m, src)
+}
+func (m *MsgPauseInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseInflationResponse proto.InternalMessageInfo
+
+// MsgResumeInflation defines a message to resume a paused inflation.
+type MsgResumeInflation struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// catch_up mints the current epoch mint provision for each epoch skipped
+	// while paused
+	CatchUp bool `protobuf:"varint,2,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+}
+
+func (m *MsgResumeInflation) Reset()         { *m = MsgResumeInflation{} }
+func (m *MsgResumeInflation) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeInflation) ProtoMessage()    {}
+func (*MsgResumeInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd435bd13fdf9eb, []int{4}
+}
+func (m *MsgResumeInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeInflation.Merge(m, src)
+}
+func (m *MsgResumeInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeInflation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeInflation proto.InternalMessageInfo
+
+func (m *MsgResumeInflation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeInflation) GetCatchUp() bool {
+	if m != nil {
+		return m.CatchUp
+	}
+	return false
+}
+
+// MsgResumeInflationResponse defines the response structure for executing a
+// MsgResumeInflation message.
+type MsgResumeInflationResponse struct {
+	// amount minted to catch up on the skipped epochs
+	CatchUpAmount types.Coin `protobuf:"bytes,1,opt,name=catch_up_amount,json=catchUpAmount,proto3" json:"catch_up_amount"`
+}
+
+func (m *MsgResumeInflationResponse) Reset()         { *m = MsgResumeInflationResponse{} }
+func (m *MsgResumeInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeInflationResponse) ProtoMessage()    {}
+func (*MsgResumeInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd435bd13fdf9eb, []int{5}
+}
+func (m *MsgResumeInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeInflationResponse.Merge(m, src)
+}
+func (m *MsgResumeInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeInflationResponse proto.InternalMessageInfo
+
+func (m *MsgResumeInflationResponse) GetCatchUpAmount() types.Coin {
+	if m != nil {
+		return m.CatchUpAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "canto.inflation.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "canto.inflation.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPauseInflation)(nil), "canto.inflation.v1.MsgPauseInflation")
+	proto.RegisterType((*MsgPauseInflationResponse)(nil), "canto.inflation.v1.MsgPauseInflationResponse")
+	proto.RegisterType((*MsgResumeInflation)(nil), "canto.inflation.v1.MsgResumeInflation")
+	proto.RegisterType((*MsgResumeInflationResponse)(nil), "canto.inflation.v1.MsgResumeInflationResponse")
 }
 
 func init() { proto.RegisterFile("canto/inflation/v1/tx.proto", fileDescriptor_3cd435bd13fdf9eb) }
 
 var fileDescriptor_3cd435bd13fdf9eb = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x4e, 0xcc, 0x2b,
-	0xc9, 0xd7, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0x4b, 0xea, 0xc1, 0x25, 0xf5, 0xca,
-	0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44, 0xa5, 0x94, 0x78,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0x3a, 0xc8, 0x84, 0xdc, 0xe2, 0x74, 0xa8,
-	0x84, 0x24, 0x44, 0x22, 0x1e, 0xa2, 0x03, 0xc2, 0x81, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5,
-	0xeb, 0x83, 0x49, 0xa8, 0x90, 0x02, 0x16, 0xd7, 0xa4, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x42, 0x35,
-	0x29, 0xed, 0x63, 0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c,
-	0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x9b,
-	0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9,
-	0x90, 0x2d, 0x17, 0x5b, 0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x29, 0x3d,
-	0x4c, 0xff, 0xea, 0x41, 0xec, 0x70, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a,
-	0x8c, 0x41, 0x50, 0x4d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x8c, 0xeb, 0x7a, 0xbe, 0x41,
-	0x4b, 0x11, 0xe2, 0xfe, 0x0a, 0x24, 0x1f, 0xa0, 0x39, 0x56, 0x49, 0x92, 0x4b, 0x1c, 0x4d, 0x28,
-	0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x28, 0x8f, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28,
-	0x81, 0x8b, 0x07, 0xc5, 0x7b, 0xca, 0xd8, 0x9c, 0x85, 0x66, 0x86, 0x94, 0x36, 0x11, 0x8a, 0x60,
-	0x16, 0x49, 0xb1, 0x36, 0x80, 0x3c, 0xe2, 0xe4, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xc6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce,
-	0x20, 0x73, 0x75, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0x21, 0x3c, 0xfd, 0x32, 0x0b, 0x14,
-	0x3f, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xc8, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x92, 0xe4, 0x23, 0x79, 0x53, 0x02, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x99, 0x1a, 0x6b, 0x99, 0xaa, 0xa4, 0x9b, 0x26, 0x85, 0x6d, 0xb2, 0x22, 0x55, 0xd3,
+	0x60, 0x98, 0x09, 0xd4, 0x54, 0x43, 0xe2, 0xa1, 0xf4, 0x64, 0x22, 0xa6, 0xc1, 0xf4, 0xe2, 0x05,
+	0x87, 0x65, 0x3a, 0x8c, 0xba, 0x33, 0x9b, 0x9d, 0x59, 0x6c, 0x6f, 0x46, 0x6f, 0x9e, 0xfc, 0x08,
+	0x1e, 0x3d, 0x72, 0xf0, 0x6c, 0xe2, 0xad, 0xc7, 0xc6, 0x93, 0x27, 0x63, 0xe0, 0xc0, 0xd7, 0x30,
+	0xec, 0x0e, 0xb4, 0x2c, 0x34, 0x21, 0xf6, 0x42, 0x98, 0xf7, 0xfe, 0xef, 0xff, 0x7e, 0x33, 0xef,
+	0x65, 0xe1, 0xa6, 0x4b, 0x84, 0x96, 0x98, 0x8b, 0xa3, 0x77, 0x44, 0x73, 0x29, 0x70, 0xb7, 0x8c,
+	0xf5, 0x31, 0xf2, 0x03, 0xa9, 0xa5, 0x65, 0x45, 0x49, 0x34, 0x49, 0xa2, 0x6e, 0xd9, 0x5e, 0x67,
+	0x92, 0xc9, 0x28, 0x8d, 0x47, 0xff, 0x62, 0xa5, 0xbd, 0xe1, 0x4a, 0xe5, 0x49, 0x85, 0x3d, 0xc5,
+	0x46, 0x0e, 0x9e, 0x62, 0x26, 0x91, 0x8b, 0x13, 0xcd, 0xb8, 0x22, 0x3e, 0x98, 0xd4, 0x1a, 0xf1,
+	0xb8, 0x90, 0x38, 0xfa, 0x35, 0x21, 0xc7, 0xd8, 0xb4, 0x88, 0xa2, 0xb8, 0x5b, 0x6e, 0x51, 0x4d,
+	0xca, 0xd8, 0x95, 0x5c, 0x98, 0x7c, 0x7e, 0x0e, 0x2d, 0xa3, 0x82, 0x2a, 0x6e, 0x4c, 0x0b, 0x3f,
+	0x00, 0xcc, 0xd4, 0x15, 0x3b, 0xf4, 0xdb, 0x44, 0xd3, 0x03, 0x12, 0x10, 0x4f, 0x59, 0xbb, 0x30,
+	0x4d, 0x42, 0xdd, 0x91, 0x01, 0xd7, 0x27, 0x59, 0x90, 0x07, 0xdb, 0xe9, 0x5a, 0xf6, 0xd7, 0xf7,
+	0xd2, 0xba, 0xa1, 0xd9, 0x6b, 0xb7, 0x03, 0xaa, 0xd4, 0x4b, 0x1d, 0x70, 0xc1, 0x1a, 0xe7, 0x52,
+	0xeb, 0x29, 0x5c, 0xf6, 0x23, 0x87, 0xec, 0x52, 0x1e, 0x6c, 0xaf, 0x56, 0x6c, 0x34, 0xfb, 0x1e,
+	0x28, 0xee, 0x51, 0x4b, 0x9f, 0xfe, 0xb9, 0x93, 0xfa, 0x36, 0xec, 0x15, 0x41, 0xc3, 0x14, 0x55,
+	0x1f, 0x7d, 0x1c, 0xf6, 0x8a, 0xe7, 0x76, 0x9f, 0x87, 0xbd, 0xe2, 0xdd, 0x98, 0xff, 0xf8, 0xc2,
+	0x0d, 0x12, 0xb0, 0x85, 0x1c, 0xdc, 0x48, 0x84, 0x1a, 0x54, 0xf9, 0x52, 0x28, 0x5a, 0xf8, 0x04,
+	0xe0, 0x5a, 0x5d, 0xb1, 0x03, 0x12, 0x2a, 0xfa, 0x6c, 0x6c, 0xf0, 0xbf, 0xb7, 0xab, 0xee, 0xce,
+	0xe2, 0x6d, 0xcd, 0xc5, 0x9b, 0xee, 0x57, 0xd8, 0x84, 0xb9, 0x99, 0xe0, 0x04, 0xf1, 0x2b, 0x80,
+	0x56, 0x5d, 0xb1, 0x06, 0x55, 0xa1, 0x77, 0x75, 0x46, 0x2b, 0x07, 0x57, 0x5c, 0xa2, 0xdd, 0x4e,
+	0x33, 0xf4, 0xa3, 0x19, 0xac, 0x34, 0x6e, 0x44, 0xe7, 0x43, 0xbf, 0xfa, 0x78, 0x16, 0xff, 0xde,
+	0x5c, 0xfc, 0x04, 0x4b, 0xe1, 0x0d, 0xb4, 0x67, 0xa3, 0xe3, 0x0b, 0x58, 0xcf, 0x61, 0x66, 0xdc,
+	0xb1, 0x49, 0x3c, 0x19, 0x0a, 0x1d, 0xf1, 0xae, 0x56, 0x72, 0xc8, 0xc0, 0x8e, 0x76, 0x13, 0x99,
+	0xdd, 0x44, 0xfb, 0x92, 0x8b, 0x8b, 0xb3, 0xbf, 0x65, 0xf0, 0xf6, 0xa2, 0xd2, 0xca, 0xcf, 0x25,
+	0x78, 0xad, 0xae, 0x98, 0xf5, 0x1a, 0xde, 0x9c, 0xda, 0xc8, 0xad, 0x79, 0x9b, 0x94, 0x18, 0xbb,
+	0xfd, 0x70, 0x01, 0xd1, 0x84, 0xfb, 0x08, 0xde, 0x4e, 0xec, 0xc5, 0xfd, 0x4b, 0xca, 0xa7, 0x65,
+	0x76, 0x69, 0x21, 0xd9, 0xa4, 0x0f, 0x87, 0x99, 0xe4, 0x70, 0x1f, 0x5c, 0xe2, 0x90, 0xd0, 0xd9,
+	0x68, 0x31, 0xdd, 0xb8, 0x95, 0x7d, 0xfd, 0xc3, 0xe8, 0x49, 0x6b, 0xf5, 0xd3, 0xbe, 0x03, 0xce,
+	0xfa, 0x0e, 0xf8, 0xdb, 0x77, 0xc0, 0x97, 0x81, 0x93, 0x3a, 0x1b, 0x38, 0xa9, 0xdf, 0x03, 0x27,
+	0xf5, 0x6a, 0x87, 0x71, 0xdd, 0x09, 0x5b, 0xc8, 0x95, 0x1e, 0xde, 0x1f, 0x59, 0x97, 0x5e, 0x50,
+	0xfd, 0x5e, 0x06, 0x6f, 0xe3, 0x13, 0xee, 0x3e, 0x99, 0xda, 0x05, 0x7d, 0xe2, 0x53, 0xd5, 0x5a,
+	0x8e, 0xbe, 0x13, 0x3b, 0xff, 0x06, 0x00, 0xd8, 0xbb, 0xb9, 0x2d, 0xf9, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +381,12 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the parameters of the x/inflation module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PauseInflation stops the inflation minting and counts the skipped epochs
+	// until it is resumed.
+	PauseInflation(ctx context.Context, in *MsgPauseInflation, opts ...grpc.CallOption) (*MsgPauseInflationResponse, error)
+	// ResumeInflation resumes the inflation minting and optionally mints the
+	// provisions of the epochs skipped while paused.
+	ResumeInflation(ctx context.Context, in *MsgResumeInflation, opts ...grpc.CallOption) (*MsgResumeInflationResponse, error)
 }
 
 type msgClient struct {
@@ -195,10 +406,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PauseInflation(ctx context.Context, in *MsgPauseInflation, opts ...grpc.CallOption) (*MsgPauseInflationResponse, error) {
+	out := new(MsgPauseInflationResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Msg/PauseInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeInflation(ctx context.Context, in *MsgResumeInflation, opts ...grpc.CallOption) (*MsgResumeInflationResponse, error) {
+	out := new(MsgResumeInflationResponse)
+	err := c.cc.Invoke(ctx, "/canto.inflation.v1.Msg/ResumeInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the parameters of the x/inflation module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PauseInflation stops the inflation minting and counts the skipped epochs
+	// until it is resumed.
+	PauseInflation(context.Context, *MsgPauseInflation) (*MsgPauseInflationResponse, error)
+	// ResumeInflation resumes the inflation minting and optionally mints the
+	// provisions of the epochs skipped while paused.
+	ResumeInflation(context.Context, *MsgResumeInflation) (*MsgResumeInflationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +443,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) PauseInflation(ctx context.Context, req *MsgPauseInflation) (*MsgPauseInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseInflation not implemented")
+}
+func (*UnimplementedMsgServer) ResumeInflation(ctx context.Context, req *MsgResumeInflation) (*MsgResumeInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInflation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +472,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseInflation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Msg/PauseInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseInflation(ctx, req.(*MsgPauseInflation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeInflation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/canto.inflation.v1.Msg/ResumeInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeInflation(ctx, req.(*MsgResumeInflation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "canto.inflation.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +516,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PauseInflation",
+			Handler:    _Msg_PauseInflation_Handler,
+		},
+		{
+			MethodName: "ResumeInflation",
+			Handler:    _Msg_ResumeInflation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canto/inflation/v1/tx.proto",
@@ -307,71 +592,246 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPauseInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPauseInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgResumeInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgResumeInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+
+func (m *MsgResumeInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CatchUp {
+		i--
+		if m.CatchUp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CatchUpAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CatchUp {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResumeInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CatchUpAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -513,6 +973,323 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPauseInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CatchUp = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CatchUpAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/onboarding/types"
+)
+
+// GetConvertedTransfer returns the amount converted from the sender's ERC20
+// balance for the outbound transfer sent with the given packet sequence
+func (k Keeper) GetConvertedTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (sdkmath.Int, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixConvertedTransfer)
+	bz := store.Get(types.ConvertedTransferKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return sdkmath.Int{}, false
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return sdkmath.Int{}, false
+	}
+	return amount, true
+}
+
+// SetConvertedTransfer records the amount converted from the sender's ERC20
+// balance for the outbound transfer sent with the given packet sequence
+func (k Keeper) SetConvertedTransfer(ctx sdk.Context, portID, channelID string, sequence uint64, amount sdkmath.Int) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixConvertedTransfer)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ConvertedTransferKey(portID, channelID, sequence), bz)
+}
+
+// DeleteConvertedTransfer removes the converted amount recorded for the
+// outbound transfer sent with the given packet sequence
+func (k Keeper) DeleteConvertedTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixConvertedTransfer)
+	store.Delete(types.ConvertedTransferKey(portID, channelID, sequence))
+}
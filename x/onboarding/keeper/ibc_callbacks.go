@@ -173,3 +173,100 @@ func (k Keeper) OnRecvPacket(
 	// return original acknowledgement
 	return ack
 }
+
+// OnAcknowledgementPacket performs an IBC acknowledgement callback.
+// If the acknowledgement is an error, the refunded coins that were converted
+// from the sender's ERC20 balance on send are converted back to ERC20 tokens.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.convertRefundedCoins(ctx, packet)
+	default:
+		// the transfer succeeded and nothing was refunded
+		k.DeleteConvertedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		return nil
+	}
+}
+
+// OnTimeoutPacket performs an IBC timeout callback.
+// The refunded coins that were converted from the sender's ERC20 balance on
+// send are converted back to ERC20 tokens.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.convertRefundedCoins(ctx, packet)
+}
+
+// convertRefundedCoins converts the coins refunded to the sender of an ICS20
+// transfer back to ERC20 tokens, if the transferred denom belongs to an enabled
+// native ERC20 token pair. Only the amount recorded as converted from the
+// sender's ERC20 balance on send is converted back: coins the sender already
+// held remain coins.
+// Like OnRecvPacket, it never reverts the IBC refund: a failed conversion leaves
+// the refunded coins on the sender account.
+func (k Keeper) convertRefundedCoins(ctx sdk.Context, packet channeltypes.Packet) error {
+	converted, found := k.GetConvertedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		// no-op: nothing was converted from the ERC20 balance on send
+		return nil
+	}
+	k.DeleteConvertedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS20 transfer logic
+		return errorsmod.Wrapf(types.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender")
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil
+	}
+	amount = sdkmath.MinInt(amount, converted)
+
+	// the refunded denom is the denom that was escrowed or burned on send
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	if len(pairID) == 0 {
+		// no-op: the denom is not registered
+		return nil
+	}
+
+	pair, _ := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !pair.Enabled || !pair.IsNativeERC20() {
+		// no-op: only ERC20-native tokens are sourced from the ERC20 balance
+		return nil
+	}
+
+	refundCoin := sdk.NewCoin(denom, amount)
+	convertMsg := erc20types.NewMsgConvertCoin(refundCoin, common.BytesToAddress(sender.Bytes()), sender)
+
+	// Use cached context to revert the state if the conversion fails
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.erc20Keeper.ConvertCoin(cacheCtx, convertMsg); err != nil {
+		k.Logger(ctx).Error("failed to convert refunded coins", "error", err)
+		return nil
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertRefund,
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.SourceChannel),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.SourcePort),
+			sdk.NewAttribute(types.AttributeKeyConvertAmount, refundCoin.String()),
+		),
+	)
+
+	return nil
+}
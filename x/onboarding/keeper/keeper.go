@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,6 +20,7 @@ var _ porttypes.ICS4Wrapper = Keeper{}
 
 // Keeper struct
 type Keeper struct {
+	storeService   store.KVStoreService
 	paramstore     paramtypes.Subspace
 	accountKeeper  types.AccountKeeper
 	bankKeeper     types.BankKeeper
@@ -33,6 +35,7 @@ type Keeper struct {
 
 // NewKeeper returns keeper
 func NewKeeper(
+	storeService store.KVStoreService,
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -48,6 +51,7 @@ func NewKeeper(
 	}
 
 	return &Keeper{
+		storeService:   storeService,
 		paramstore:     ps,
 		accountKeeper:  ak,
 		bankKeeper:     bk,
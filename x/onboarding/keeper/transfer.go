@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/onboarding/types"
+)
+
+var _ transfertypes.MsgServer = TransferMsgServer{}
+
+// TransferMsgServer wraps the ICS20 transfer MsgServer so that transfers of
+// ERC20-native tokens can be sourced directly from the sender's ERC20 balance.
+type TransferMsgServer struct {
+	transfertypes.MsgServer
+	keeper Keeper
+}
+
+// NewTransferMsgServer returns a TransferMsgServer given the onboarding keeper
+// and the underlying ICS20 transfer MsgServer
+func NewTransferMsgServer(k Keeper, server transfertypes.MsgServer) TransferMsgServer {
+	return TransferMsgServer{
+		MsgServer: server,
+		keeper:    k,
+	}
+}
+
+// Transfer defines a rpc handler method for MsgTransfer.
+// If the transferred denom belongs to an enabled native ERC20 token pair and
+// the sender doesn't hold enough coins, the difference is converted from the
+// sender's ERC20 balance before the transfer is executed. The converted
+// amount is recorded under the packet sequence, so that only this amount is
+// converted back to ERC20 if the transfer is refunded.
+func (s TransferMsgServer) Transfer(
+	goCtx context.Context,
+	msg *transfertypes.MsgTransfer,
+) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	converted, err := s.keeper.convertERC20ForTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.MsgServer.Transfer(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if converted.IsPositive() {
+		s.keeper.SetConvertedTransfer(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, converted)
+	}
+
+	return res, nil
+}
+
+// convertERC20ForTransfer converts the missing amount of an outbound transfer
+// from the sender's ERC20 balance to the Cosmos coin representation and
+// returns the converted amount.
+func (k Keeper) convertERC20ForTransfer(ctx sdk.Context, msg *transfertypes.MsgTransfer) (sdkmath.Int, error) {
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, msg.Token.Denom)
+	if len(pairID) == 0 {
+		// no-op: the denom is not registered
+		return sdkmath.ZeroInt(), nil
+	}
+
+	pair, _ := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !pair.Enabled || !pair.IsNativeERC20() {
+		// no-op: only ERC20-native tokens are sourced from the ERC20 balance
+		return sdkmath.ZeroInt(), nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	// if the sender holds enough coins there's nothing to convert
+	balance := k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(pair.Denom)
+	if balance.GTE(msg.Token.Amount) {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	// only convert the remaining difference
+	difference := msg.Token.Amount.Sub(balance)
+	convertMsg := erc20types.NewMsgConvertERC20(difference, sender, pair.GetERC20Contract(), common.BytesToAddress(sender.Bytes()))
+
+	if _, err := k.erc20Keeper.ConvertERC20(ctx, convertMsg); err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrap(err, "failed to convert ERC20 tokens for transfer")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyConvertAmount, sdk.NewCoin(pair.Denom, difference).String()),
+		),
+	)
+
+	return difference, nil
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/contracts"
+	erc20keeper "github.com/TucanaProtocol/Tucana/v8/x/erc20/keeper"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
+	"github.com/TucanaProtocol/Tucana/v8/x/onboarding/keeper"
+)
+
+// mockTransferMsgServer records the last MsgTransfer it received.
+type mockTransferMsgServer struct {
+	transfertypes.MsgServer
+	msg *transfertypes.MsgTransfer
+}
+
+func (m *mockTransferMsgServer) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	m.msg = msg
+	return &transfertypes.MsgTransferResponse{Sequence: 1}, nil
+}
+
+// setupNativeERC20Pair deploys an ERC20 contract, registers it as a native
+// ERC20 token pair and mints the given amount of tokens to the account.
+func (suite *KeeperTestSuite) setupNativeERC20Pair(account sdk.AccAddress, amount sdkmath.Int) erc20types.TokenPair {
+	metadata := banktypes.Metadata{
+		Name:   "Native Token",
+		Symbol: "NTK",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ntk", Exponent: 0},
+		},
+	}
+	contract, err := erc20keeper.DeployERC20Contract(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, metadata)
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	// the sender account must exist to sign ERC20 conversions
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account))
+
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, erc20types.ModuleAddress, contract, true, "mint", common.BytesToAddress(account.Bytes()), amount.BigInt())
+	suite.Require().NoError(err)
+
+	return *pair
+}
+
+func (suite *KeeperTestSuite) erc20Balance(pair erc20types.TokenPair, account sdk.AccAddress) *big.Int {
+	return suite.app.Erc20Keeper.BalanceOf(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(account.Bytes()))
+}
+
+func (suite *KeeperTestSuite) TestTransfer() {
+	testCases := []struct {
+		name         string
+		coinBalance  int64
+		transfer     int64
+		disable      bool
+		expPass      bool
+		expCoins     int64
+		expErc20     int64
+		expForwarded bool
+		expConverted int64
+	}{
+		{"ok - transfer sourced from ERC20 balance", 0, 100, false, true, 100, 900, true, 100},
+		{"ok - only the missing amount is converted", 40, 100, false, true, 100, 900, true, 60},
+		{"ok - no conversion with enough coins", 150, 100, false, true, 150, 850, true, 0},
+		{"ok - no conversion for disabled pair", 0, 100, true, true, 0, 1000, true, 0},
+		{"fail - insufficient ERC20 balance", 0, 2000, false, false, 0, 1000, false, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ethPk, err := ethsecp256k1.GenerateKey()
+			suite.Require().NoError(err)
+			sender := sdk.AccAddress(ethPk.PubKey().Address())
+
+			pair := suite.setupNativeERC20Pair(sender, sdkmath.NewInt(1000))
+			if tc.coinBalance > 0 {
+				_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, erc20types.NewMsgConvertERC20(sdkmath.NewInt(tc.coinBalance), sender, pair.GetERC20Contract(), common.BytesToAddress(sender.Bytes())))
+				suite.Require().NoError(err)
+			}
+			if tc.disable {
+				_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+				suite.Require().NoError(err)
+			}
+
+			server := &mockTransferMsgServer{}
+			msg := transfertypes.NewMsgTransfer(
+				transfertypes.PortID, "channel-0",
+				sdk.NewInt64Coin(pair.Denom, tc.transfer),
+				sender.String(), "cosmos1receiver",
+				clienttypes.NewHeight(0, 100), 0, "",
+			)
+			_, err = keeper.NewTransferMsgServer(*suite.app.OnboardingKeeper, server).Transfer(suite.ctx, msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			suite.Require().Equal(tc.expForwarded, server.msg != nil)
+
+			converted, found := suite.app.OnboardingKeeper.GetConvertedTransfer(suite.ctx, transfertypes.PortID, "channel-0", 1)
+			suite.Require().Equal(tc.expConverted > 0, found)
+			if found {
+				suite.Require().Equal(sdkmath.NewInt(tc.expConverted), converted)
+			}
+			suite.Require().Equal(sdkmath.NewInt(tc.expCoins), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount)
+			suite.Require().Equal(big.NewInt(tc.expErc20).String(), suite.erc20Balance(pair, sender).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertRefundedCoins() {
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	errAck := channeltypes.NewErrorAcknowledgement(transfertypes.ErrInvalidAmount)
+
+	testCases := []struct {
+		name      string
+		callback  func(packet channeltypes.Packet) error
+		converted int64
+		disable   bool
+		expCoins  int64
+		expErc20  int64
+	}{
+		{
+			"ok - timeout refund converted to ERC20",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			100, false, 0, 1000,
+		},
+		{
+			"ok - error acknowledgement refund converted to ERC20",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnAcknowledgementPacket(suite.ctx, packet, errAck)
+			},
+			100, false, 0, 1000,
+		},
+		{
+			"ok - only the converted amount is converted back",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			40, false, 60, 940,
+		},
+		{
+			"no-op - nothing converted on send",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			0, false, 100, 900,
+		},
+		{
+			"no-op - successful acknowledgement",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnAcknowledgementPacket(suite.ctx, packet, ack)
+			},
+			100, false, 100, 900,
+		},
+		{
+			"no-op - disabled pair",
+			func(packet channeltypes.Packet) error {
+				return suite.app.OnboardingKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			100, true, 100, 900,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ethPk, err := ethsecp256k1.GenerateKey()
+			suite.Require().NoError(err)
+			sender := sdk.AccAddress(ethPk.PubKey().Address())
+
+			// the refunded coins are already back on the sender account
+			pair := suite.setupNativeERC20Pair(sender, sdkmath.NewInt(1000))
+			_, err = suite.app.Erc20Keeper.ConvertERC20(suite.ctx, erc20types.NewMsgConvertERC20(sdkmath.NewInt(100), sender, pair.GetERC20Contract(), common.BytesToAddress(sender.Bytes())))
+			suite.Require().NoError(err)
+
+			if tc.converted > 0 {
+				suite.app.OnboardingKeeper.SetConvertedTransfer(suite.ctx, transfertypes.PortID, "channel-0", 1, sdkmath.NewInt(tc.converted))
+			}
+			if tc.disable {
+				_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+				suite.Require().NoError(err)
+			}
+
+			data := transfertypes.NewFungibleTokenPacketData(pair.Denom, "100", sender.String(), "cosmos1receiver", "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+
+			suite.Require().NoError(tc.callback(packet))
+			suite.Require().Equal(sdkmath.NewInt(tc.expCoins), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount)
+			suite.Require().Equal(big.NewInt(tc.expErc20).String(), suite.erc20Balance(pair, sender).String())
+
+			_, found := suite.app.OnboardingKeeper.GetConvertedTransfer(suite.ctx, transfertypes.PortID, "channel-0", 1)
+			suite.Require().False(found)
+		})
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
-	erc20keeper "github.com/Canto-Network/Canto/v8/x/erc20/keeper"
-	erc20types "github.com/Canto-Network/Canto/v8/x/erc20/types"
+	erc20keeper "github.com/TucanaProtocol/Tucana/v8/x/erc20/keeper"
+	erc20types "github.com/TucanaProtocol/Tucana/v8/x/erc20/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -84,6 +84,14 @@ func (m *MockErc20Keeper) ConvertCoin(
 	return nil, argsMock.Error(1)
 }
 
+func (m *MockErc20Keeper) ConvertERC20(
+	goCtx context.Context,
+	msg *erc20types.MsgConvertERC20,
+) (*erc20types.MsgConvertERC20Response, error) {
+	argsMock := m.Called(goCtx, msg)
+	return nil, argsMock.Error(1)
+}
+
 func (m *MockErc20Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
 	return m.erc20keeper.GetTokenPairID(ctx, token)
 }
@@ -0,0 +1,56 @@
+package onboarding
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/TucanaProtocol/Tucana/v8/x/onboarding/keeper"
+)
+
+// TransferAppModule wraps the ICS20 transfer AppModule so that MsgTransfer is
+// routed through the onboarding TransferMsgServer.
+type TransferAppModule struct {
+	transfer.AppModule
+	transferKeeper transferkeeper.Keeper
+	keeper         keeper.Keeper
+}
+
+// NewTransferAppModule creates a new TransferAppModule given the ICS20
+// transfer keeper and the onboarding keeper
+func NewTransferAppModule(tk transferkeeper.Keeper, k keeper.Keeper) TransferAppModule {
+	return TransferAppModule{
+		AppModule:      transfer.NewAppModule(tk),
+		transferKeeper: tk,
+		keeper:         k,
+	}
+}
+
+// RegisterServices registers the wrapped transfer MsgServer and delegates the
+// registration of the transfer QueryServer and migrations to the transfer
+// AppModule.
+func (am TransferAppModule) RegisterServices(cfg module.Configurator) {
+	transfertypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewTransferMsgServer(am.keeper, am.transferKeeper))
+	am.AppModule.RegisterServices(transferConfigurator{Configurator: cfg})
+}
+
+// transferConfigurator skips the registration of the ICS20 transfer
+// MsgServer, which is replaced by the onboarding TransferMsgServer.
+type transferConfigurator struct {
+	module.Configurator
+}
+
+// MsgServer implements the module.Configurator interface.
+func (c transferConfigurator) MsgServer() gogogrpc.Server {
+	return noopServer{}
+}
+
+// noopServer is a grpc server that discards every registered service.
+type noopServer struct{}
+
+// RegisterService implements the gogogrpc.Server interface.
+func (noopServer) RegisterService(*grpc.ServiceDesc, interface{}) {}
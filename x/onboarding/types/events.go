@@ -3,6 +3,8 @@ package types
 // onboarding events
 const (
 	EventTypeOnboarding       = "onboarding"
+	EventTypeConvertRefund    = "convert_refund"
+	EventTypeConvertTransfer  = "convert_transfer"
 	AttributeKeySwapAmount    = "swap_amount"
 	AttributeKeyConvertAmount = "convert_amount"
 )
@@ -26,6 +26,10 @@ type Erc20Keeper interface {
 		goCtx context.Context,
 		msg *erc20types.MsgConvertCoin,
 	) (*erc20types.MsgConvertCoinResponse, error)
+	ConvertERC20(
+		goCtx context.Context,
+		msg *erc20types.MsgConvertERC20,
+	) (*erc20types.MsgConvertERC20Response, error)
 	GetTokenPairID(ctx sdk.Context, token string) []byte
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
 	BalanceOf(
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // constants
 const (
 	// ModuleName defines the onboarding module name
@@ -11,3 +15,20 @@ const (
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the onboarding persistent store
+const (
+	prefixConvertedTransfer = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixConvertedTransfer = []byte{prefixConvertedTransfer}
+)
+
+// ConvertedTransferKey returns the key of the amount converted from the ERC20
+// balance for the outbound transfer sent with the given packet sequence,
+// without the prefix
+func ConvertedTransferKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(portID+"/"+channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}